@@ -0,0 +1,198 @@
+// Command allowlist genera el manifiesto de operaciones permitidas a partir de los
+// archivos .graphql del frontend. Cada operación se valida contra el esquema junto con los
+// fragmentos que usa (definidos en cualquiera de los archivos) y se imprime como la envían
+// los clientes de APQ: la operación seguida de sus fragmentos, con el formato de print de
+// graphql-js. Se indexa por el hash SHA-256 de ese texto, con y sin __typename agregado.
+//
+// Uso:
+//
+//	go run ./cmd/allowlist -schema graph/schema.graphqls -out allowlist.json ../web/src/graphql ../kiosk/src/graphql
+package main
+
+import (
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/persisted"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// sourceOperation es una operación junto con el archivo que la define
+type sourceOperation struct {
+	path      string
+	operation *ast.OperationDefinition
+}
+
+// sourceFragment es un fragmento junto con el archivo que lo define
+type sourceFragment struct {
+	path     string
+	fragment *ast.FragmentDefinition
+}
+
+func main() {
+	schemaPath := flag.String("schema", "graph/schema.graphqls", "archivo de esquema GraphQL del BFF")
+	outPath := flag.String("out", "allowlist.json", "archivo de manifiesto a generar")
+	typename := flag.Bool("typename", true, "registrar también cada operación con __typename agregado, como Apollo Client")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatal("usage: allowlist [-schema file] [-out file] [-typename=false] <dir|file>...")
+	}
+
+	schemaSource, err := os.ReadFile(*schemaPath)
+	if err != nil {
+		log.Fatalf("Failed to read schema: %v", err)
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: *schemaPath, Input: string(schemaSource)})
+	if err != nil {
+		log.Fatalf("Invalid schema: %v", err)
+	}
+
+	// Reunir primero todas las operaciones y fragmentos: un fragmento puede estar en otro archivo
+	var operations []sourceOperation
+	fragments := map[string]sourceFragment{}
+	for _, root := range flag.Args() {
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() || !isOperationFile(path) {
+				return nil
+			}
+
+			fileOperations, err := parseFile(path, fragments)
+			operations = append(operations, fileOperations...)
+			return err
+		})
+		if err != nil {
+			log.Fatalf("Failed to build allowlist: %v", err)
+		}
+	}
+
+	manifest := persisted.Manifest{}
+	for _, operation := range operations {
+		if err := addOperation(manifest, schema, operation, fragments, *typename); err != nil {
+			log.Fatalf("Failed to build allowlist: %v", err)
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode allowlist: %v", err)
+	}
+
+	if err := os.WriteFile(*outPath, append(data, '\n'), 0o644); err != nil {
+		log.Fatalf("Failed to write allowlist: %v", err)
+	}
+
+	log.Printf("✅ Allowlist written: file=%s, operations=%d, entries=%d", *outPath, len(operations), len(manifest))
+}
+
+// parseFile lee un documento y devuelve sus operaciones; sus fragmentos se agregan a fragments
+func parseFile(path string, fragments map[string]sourceFragment) ([]sourceOperation, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := parser.ParseQuery(&ast.Source{Name: path, Input: string(source)})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for _, fragment := range document.Fragments {
+		if existing, ok := fragments[fragment.Name]; ok {
+			return nil, fmt.Errorf("%s: fragment %q already defined in %s", path, fragment.Name, existing.path)
+		}
+		fragments[fragment.Name] = sourceFragment{path: path, fragment: fragment}
+	}
+
+	operations := make([]sourceOperation, 0, len(document.Operations))
+	for _, operation := range document.Operations {
+		operations = append(operations, sourceOperation{path: path, operation: operation})
+	}
+	return operations, nil
+}
+
+// addOperation valida la operación con sus fragmentos contra el esquema y la agrega al manifiesto
+func addOperation(manifest persisted.Manifest, schema *ast.Schema, operation sourceOperation, fragments map[string]sourceFragment, typename bool) error {
+	used, err := usedFragments(operation.operation.SelectionSet, fragments)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation.path, err)
+	}
+
+	document := &ast.QueryDocument{
+		Operations: ast.OperationList{operation.operation},
+		Fragments:  used,
+	}
+	if validationErrs := validator.ValidateWithRules(schema, document, nil); len(validationErrs) > 0 {
+		return fmt.Errorf("%s: %w", operation.path, validationErrs)
+	}
+
+	query := printDocument(operation.operation, used, false)
+	manifest[persisted.ComputeHash(query)] = query
+
+	if typename {
+		query = printDocument(operation.operation, used, true)
+		manifest[persisted.ComputeHash(query)] = query
+	}
+	return nil
+}
+
+// usedFragments devuelve los fragmentos que usa la selección, directa o indirectamente, en el
+// orden en que aparecen por primera vez
+func usedFragments(selectionSet ast.SelectionSet, fragments map[string]sourceFragment) ([]*ast.FragmentDefinition, error) {
+	var used []*ast.FragmentDefinition
+	seen := map[string]bool{}
+
+	var visit func(ast.SelectionSet) error
+	visit = func(selectionSet ast.SelectionSet) error {
+		for _, selection := range selectionSet {
+			switch s := selection.(type) {
+			case *ast.Field:
+				if err := visit(s.SelectionSet); err != nil {
+					return err
+				}
+			case *ast.InlineFragment:
+				if err := visit(s.SelectionSet); err != nil {
+					return err
+				}
+			case *ast.FragmentSpread:
+				if seen[s.Name] {
+					continue
+				}
+				fragment, ok := fragments[s.Name]
+				if !ok {
+					return fmt.Errorf("unknown fragment %q", s.Name)
+				}
+				seen[s.Name] = true
+				used = append(used, fragment.fragment)
+				if err := visit(fragment.fragment.SelectionSet); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	return used, visit(selectionSet)
+}
+
+// isOperationFile indica si el archivo contiene operaciones GraphQL del frontend
+func isOperationFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".graphql", ".gql":
+		return true
+	}
+	return false
+}
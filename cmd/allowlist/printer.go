@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// maxLineLength es el largo a partir del cual graphql-js imprime los argumentos de un campo en
+// varias líneas
+const maxLineLength = 80
+
+// printDocument imprime la operación seguida de sus fragmentos con el mismo formato que print de
+// graphql-js, que es lo que envían Apollo Client y los demás clientes de APQ. Con typename se
+// agrega __typename a cada selección salvo la raíz, como addTypenameToDocument de Apollo.
+func printDocument(operation *ast.OperationDefinition, fragments []*ast.FragmentDefinition, typename bool) string {
+	p := printer{typename: typename}
+
+	definitions := make([]string, 0, len(fragments)+1)
+	definitions = append(definitions, p.operation(operation))
+	for _, fragment := range fragments {
+		definitions = append(definitions, p.fragment(fragment))
+	}
+	return strings.Join(definitions, "\n\n")
+}
+
+type printer struct {
+	typename bool
+}
+
+func (p printer) operation(op *ast.OperationDefinition) string {
+	prefix := join([]string{
+		string(op.Operation),
+		op.Name + wrap("(", p.variableDefinitions(op.VariableDefinitions), ")"),
+		p.directives(op.Directives),
+	}, " ")
+
+	// La raíz de la operación nunca recibe __typename
+	selectionSet := p.selectionSet(op.SelectionSet, false)
+	if prefix == "query" {
+		return selectionSet
+	}
+	return prefix + " " + selectionSet
+}
+
+func (p printer) fragment(fragment *ast.FragmentDefinition) string {
+	return "fragment " + fragment.Name + wrap("(", p.variableDefinitions(fragment.VariableDefinition), ")") +
+		" on " + fragment.TypeCondition + " " + wrap("", p.directives(fragment.Directives), " ") +
+		p.selectionSet(fragment.SelectionSet, p.typename)
+}
+
+func (p printer) variableDefinitions(definitions ast.VariableDefinitionList) string {
+	printed := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		printed = append(printed, join([]string{
+			"$" + definition.Variable + ": " + printType(definition.Type) + wrap(" = ", printValue(definition.DefaultValue), ""),
+			p.directives(definition.Directives),
+		}, " "))
+	}
+	return strings.Join(printed, ", ")
+}
+
+func (p printer) selectionSet(selectionSet ast.SelectionSet, typename bool) string {
+	if len(selectionSet) == 0 {
+		return ""
+	}
+
+	selections := make([]string, 0, len(selectionSet)+1)
+	for _, selection := range selectionSet {
+		selections = append(selections, p.selection(selection))
+	}
+	if typename && !hasMetaField(selectionSet) {
+		selections = append(selections, "__typename")
+	}
+	return block(selections)
+}
+
+func (p printer) selection(selection ast.Selection) string {
+	switch s := selection.(type) {
+	case *ast.Field:
+		prefix := s.Name
+		if s.Alias != "" && s.Alias != s.Name {
+			prefix = s.Alias + ": " + s.Name
+		}
+
+		arguments := p.arguments(s.Arguments)
+		argsLine := prefix + wrap("(", strings.Join(arguments, ", "), ")")
+		if jsLength(argsLine) > maxLineLength {
+			argsLine = prefix + wrap("(\n", indent(strings.Join(arguments, "\n")), "\n)")
+		}
+
+		// Apollo no agrega __typename bajo un campo con @export
+		typename := p.typename && s.Directives.ForName("export") == nil
+		return join([]string{argsLine, p.directives(s.Directives), p.selectionSet(s.SelectionSet, typename)}, " ")
+	case *ast.FragmentSpread:
+		return "..." + s.Name + wrap(" ", p.directives(s.Directives), "")
+	case *ast.InlineFragment:
+		return join([]string{"...", wrap("on ", s.TypeCondition, ""), p.directives(s.Directives), p.selectionSet(s.SelectionSet, p.typename)}, " ")
+	}
+	panic(fmt.Sprintf("unsupported selection %T", selection))
+}
+
+func (p printer) arguments(arguments ast.ArgumentList) []string {
+	printed := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		printed = append(printed, argument.Name+": "+printValue(argument.Value))
+	}
+	return printed
+}
+
+func (p printer) directives(directives ast.DirectiveList) string {
+	printed := make([]string, 0, len(directives))
+	for _, directive := range directives {
+		printed = append(printed, "@"+directive.Name+wrap("(", strings.Join(p.arguments(directive.Arguments), ", "), ")"))
+	}
+	return strings.Join(printed, " ")
+}
+
+// hasMetaField indica si la selección ya tiene __typename u otro campo de introspección
+func hasMetaField(selectionSet ast.SelectionSet) bool {
+	for _, selection := range selectionSet {
+		if field, ok := selection.(*ast.Field); ok && strings.HasPrefix(field.Name, "__") {
+			return true
+		}
+	}
+	return false
+}
+
+func printType(t *ast.Type) string {
+	var printed string
+	if t.Elem != nil {
+		printed = "[" + printType(t.Elem) + "]"
+	} else {
+		printed = t.NamedType
+	}
+	if t.NonNull {
+		printed += "!"
+	}
+	return printed
+}
+
+func printValue(value *ast.Value) string {
+	if value == nil {
+		return ""
+	}
+
+	switch value.Kind {
+	case ast.Variable:
+		return "$" + value.Raw
+	case ast.StringValue:
+		return printString(value.Raw)
+	case ast.BlockValue:
+		return printBlockString(value.Raw)
+	case ast.ListValue:
+		items := make([]string, 0, len(value.Children))
+		for _, child := range value.Children {
+			items = append(items, printValue(child.Value))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case ast.ObjectValue:
+		fields := make([]string, 0, len(value.Children))
+		for _, child := range value.Children {
+			fields = append(fields, child.Name+": "+printValue(child.Value))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	// Enteros, decimales, booleanos, null y enums se imprimen tal como se escribieron
+	return value.Raw
+}
+
+// printString escapa un string como printString de graphql-js
+func printString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\b':
+			b.WriteString(`\b`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r < 0x20 || (r >= 0x7f && r <= 0x9f):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// printBlockString imprime un block string como printBlockString de graphql-js
+func printBlockString(value string) string {
+	escaped := strings.ReplaceAll(value, `"""`, `\"""`)
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(escaped), "\n")
+	singleLine := len(lines) == 1
+
+	forceLeadingNewLine := len(lines) > 1
+	for _, line := range lines[1:] {
+		if line != "" && !isWhiteSpace(line[0]) {
+			forceLeadingNewLine = false
+			break
+		}
+	}
+
+	hasTrailingTripleQuotes := strings.HasSuffix(escaped, `\"""`)
+	hasTrailingQuote := strings.HasSuffix(value, `"`) && !hasTrailingTripleQuotes
+	hasTrailingSlash := strings.HasSuffix(value, `\`)
+	forceTrailingNewLine := hasTrailingQuote || hasTrailingSlash
+	multipleLines := !singleLine || jsLength(value) > 70 || forceTrailingNewLine || forceLeadingNewLine || hasTrailingTripleQuotes
+	skipLeadingNewLine := singleLine && value != "" && isWhiteSpace(value[0])
+
+	var b strings.Builder
+	b.WriteString(`"""`)
+	if (multipleLines && !skipLeadingNewLine) || forceLeadingNewLine {
+		b.WriteByte('\n')
+	}
+	b.WriteString(escaped)
+	if multipleLines || forceTrailingNewLine {
+		b.WriteByte('\n')
+	}
+	b.WriteString(`"""`)
+	return b.String()
+}
+
+// jsLength es el largo de un string en JavaScript, en unidades UTF-16
+func jsLength(value string) int {
+	return len(utf16.Encode([]rune(value)))
+}
+
+func isWhiteSpace(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+// block, indent, wrap y join replican los auxiliares de print de graphql-js
+func block(items []string) string {
+	return wrap("{\n", indent(join(items, "\n")), "\n}")
+}
+
+func indent(value string) string {
+	return wrap("  ", strings.ReplaceAll(value, "\n", "\n  "), "")
+}
+
+func wrap(start, value, end string) string {
+	if value == "" {
+		return ""
+	}
+	return start + value + end
+}
+
+func join(items []string, separator string) string {
+	nonEmpty := make([]string, 0, len(items))
+	for _, item := range items {
+		if item != "" {
+			nonEmpty = append(nonEmpty, item)
+		}
+	}
+	return strings.Join(nonEmpty, separator)
+}
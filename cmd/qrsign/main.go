@@ -0,0 +1,79 @@
+// Command qrsign genera los QR firmados de los racks y las claves para firmarlos.
+//
+// Uso:
+//
+//	go run ./cmd/qrsign -genkey -alg EdDSA
+//	go run ./cmd/qrsign -kid k2 -alg EdDSA -key <privadaBase64> -qr QR123 -rack 1 -inst 1 -ttl 8760h
+package main
+
+import (
+	"bff-graphql-payment/internal/application/service"
+	"bff-graphql-payment/internal/domain/model"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+)
+
+func main() {
+	genKey := flag.Bool("genkey", false, "genera una clave nueva para -alg en lugar de firmar")
+	algorithm := flag.String("alg", string(model.QRSignatureHS256), "algoritmo de firma: HS256 o EdDSA")
+	keyID := flag.String("kid", "", "identificador de la clave con que se firma")
+	key := flag.String("key", "", "secreto HMAC o clave privada Ed25519, en base64")
+	qrValue := flag.String("qr", "", "valor QR con que el backend identifica al rack")
+	rackID := flag.Int("rack", 0, "ID del rack")
+	installationID := flag.Int("inst", 0, "ID de la instalación")
+	ttl := flag.Duration("ttl", 365*24*time.Hour, "vigencia del QR")
+	flag.Parse()
+
+	if *genKey {
+		generateKey(model.QRSignatureAlgorithm(*algorithm))
+		return
+	}
+
+	if *keyID == "" || *key == "" || *qrValue == "" || *rackID <= 0 {
+		log.Fatal("usage: qrsign -kid id -alg HS256|EdDSA -key base64 -qr value -rack id [-inst id] [-ttl duration]")
+	}
+
+	signingKey, err := base64.StdEncoding.DecodeString(*key)
+	if err != nil {
+		log.Fatalf("Invalid key: %v", err)
+	}
+
+	signed, err := service.SignQR(model.QRClaims{
+		KeyID:          *keyID,
+		QRValue:        *qrValue,
+		RackID:         *rackID,
+		InstallationID: *installationID,
+		ExpiresAt:      time.Now().Add(*ttl),
+	}, model.QRSignatureAlgorithm(*algorithm), signingKey)
+	if err != nil {
+		log.Fatalf("Failed to sign QR: %v", err)
+	}
+
+	fmt.Println(signed)
+}
+
+// generateKey imprime una clave nueva: el secreto HMAC, o la privada (para firmar) y la pública (para QR_KEYS)
+func generateKey(algorithm model.QRSignatureAlgorithm) {
+	switch algorithm {
+	case model.QRSignatureHS256:
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatalf("Failed to generate key: %v", err)
+		}
+		fmt.Printf("secret: %s\n", base64.StdEncoding.EncodeToString(secret))
+	case model.QRSignatureEdDSA:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			log.Fatalf("Failed to generate key: %v", err)
+		}
+		fmt.Printf("private: %s\n", base64.StdEncoding.EncodeToString(privateKey))
+		fmt.Printf("public:  %s\n", base64.StdEncoding.EncodeToString(publicKey))
+	default:
+		log.Fatalf("Unknown algorithm %q", algorithm)
+	}
+}
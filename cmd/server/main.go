@@ -3,18 +3,36 @@ package main
 import (
 	"bff-graphql-payment/config"
 	"bff-graphql-payment/graph/generated"
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/dataloader"
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/persisted"
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/presenter"
+	grpcServer "bff-graphql-payment/internal/infrastructure/inbound/grpc/server"
+	"bff-graphql-payment/internal/infrastructure/inbound/http/middleware"
+	"bff-graphql-payment/internal/infrastructure/inbound/ratelimit"
+	"bff-graphql-payment/internal/infrastructure/logging"
+	"bff-graphql-payment/internal/infrastructure/outbound/metrics"
 	"context"
+	"crypto/tls"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/joho/godotenv"
 	"github.com/rs/cors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -26,6 +44,11 @@ func main() {
 	// Obtener configuración
 	cfg := getConfig()
 
+	// Configurar logging con nivel ajustable en caliente
+	if err := logging.Setup(cfg.Log.Level); err != nil {
+		log.Fatalf("Invalid log level: %v", err)
+	}
+
 	// Inicializar contenedor de dependencias
 	container, err := config.NewContainer(cfg)
 	if err != nil {
@@ -40,30 +63,64 @@ func main() {
 		}
 	}()
 
+	// Recargar configuración en caliente (SIGHUP o cambios en CONFIG_FILE)
+	reloader := config.NewReloader(cfg.General.ConfigFile, cfg.General.ConfigPollInterval, container)
+	reloader.Start()
+	defer reloader.Stop()
+
 	// Crear servidor GraphQL
-	srv := handler.NewDefaultServer(
-		generated.NewExecutableSchema(
-			generated.Config{Resolvers: container.GraphQLResolver},
-		),
-	)
+	srv, err := newGraphQLServer(cfg.GraphQL, container)
+	if err != nil {
+		log.Fatalf("Failed to initialize GraphQL server: %v", err)
+	}
 
-	// Configurar CORS
+	// Configurar CORS (los orígenes permitidos se leen de la configuración vigente)
 	c := cors.New(cors.Options{
-		AllowedOrigins:   []string{"*"}, // Configure appropriately for production
-		AllowCredentials: true,
+		AllowOriginFunc: func(origin string) bool {
+			return container.Config().OriginAllowed(origin)
+		},
+		AllowCredentials: cfg.CORS.AllowCredentials,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{middleware.TraceIDHeader},
 	})
 
+	// IP del cliente: X-Forwarded-For solo se considera si viene de un proxy de confianza
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Invalid trusted proxies: %v", err)
+	}
+
+	// Limitar solicitudes por tenant y cliente (los límites se leen de la configuración vigente)
+	rateLimit := middleware.RateLimit(ratelimit.NewLimiter(
+		func(tenantID string) ratelimit.Settings {
+			rl := container.RateLimitFor(tenantID)
+			return ratelimit.Settings{RequestsPerSecond: rl.RequestsPerSecond, Burst: rl.Burst}
+		},
+		func() { container.Metrics.IncCounter("http_rate_limited_total") },
+	))
+
 	// Configurar rutas
 	mux := http.NewServeMux()
 
 	// Endpoint GraphQL
-	mux.Handle("/query", c.Handler(srv))
+	loaders := dataloader.Middleware(container.PaymentInfraService, dataloader.Options{
+		Wait:     cfg.GraphQL.DataLoaderWait,
+		MaxBatch: cfg.GraphQL.DataLoaderMaxBatch,
+	})
+	mux.Handle("/query", c.Handler(rateLimit(loaders(srv))))
 
 	// GraphQL Playground
 	mux.Handle("/", playground.Handler("GraphQL Playground", "/query"))
 
+	// Webhooks de confirmación de pago por pasarela
+	mux.Handle("POST /webhooks/payments/{gatewayName}", container.PaymentWebhookHandler)
+
+	// API REST/JSON para el firmware de los kioscos, QR del código de apertura y especificación OpenAPI
+	mux.Handle("/v1/", rateLimit(container.RESTHandler))
+	mux.Handle("GET /bookings/{code}/qr.png", rateLimit(container.RESTHandler))
+	mux.Handle("GET /openapi.json", container.RESTHandler)
+
 	// Endpoint de verificación de salud
 	mux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -71,10 +128,13 @@ func main() {
 		w.Write([]byte(`{"message":"pong"}`))
 	})
 
+	// Métricas operacionales (expvar)
+	mux.Handle("/metrics", metrics.Handler())
+
 	// Crear servidor HTTP
 	server := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
-		Handler:      mux,
+		Handler:      middleware.Trace(middleware.Caller(trustedProxies)(middleware.Locale(middleware.Tenant(container.TenantRegistry)(mux)))),
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
@@ -84,12 +144,54 @@ func main() {
 	go func() {
 		log.Printf("🚀 GraphQL Payment BFF Server ready at http://localhost:%s/", cfg.Server.Port)
 		log.Printf("❤️  Health check available at http://localhost:%s/ping", cfg.Server.Port)
+		log.Printf("🔌 REST API available at http://localhost:%s/v1/ (spec at /openapi.json)", cfg.Server.Port)
+		log.Printf("📊 Metrics available at http://localhost:%s/metrics", cfg.Server.Port)
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Failed to start server: %v", err)
 		}
 	}()
 
+	// Servidor gRPC para servicios internos, en su propio puerto y con su propio limitador
+	var bffGRPC *grpc.Server
+	if cfg.GRPCServer.Enabled {
+		grpcRateLimiter := ratelimit.NewLimiter(
+			func(tenantID string) ratelimit.Settings {
+				rl := container.RateLimitFor(tenantID)
+				return ratelimit.Settings{RequestsPerSecond: rl.RequestsPerSecond, Burst: rl.Burst}
+			},
+			func() { container.Metrics.IncCounter("grpc_server_rate_limited_total") },
+		)
+
+		// Los servicios internos se autentican con token o, con CA de clientes, con mTLS
+		var grpcTLS *tls.Config
+		if cfg.GRPCServer.TLSCertFile != "" {
+			grpcTLS, err = grpcServer.LoadTLSConfig(cfg.GRPCServer.TLSCertFile, cfg.GRPCServer.TLSKeyFile, cfg.GRPCServer.ClientCAFile)
+			if err != nil {
+				log.Fatalf("Invalid gRPC TLS configuration: %v", err)
+			}
+		}
+
+		bffGRPC = grpcServer.NewServer(container.BffGRPCServer, container.Metrics, container.TenantRegistry, grpcRateLimiter, grpcServer.Options{
+			Tokens:     cfg.GRPCServer.Tokens,
+			TLS:        grpcTLS,
+			Reflection: cfg.General.Environment == "development",
+		})
+
+		listener, err := net.Listen("tcp", ":"+cfg.GRPCServer.Port)
+		if err != nil {
+			log.Fatalf("Failed to listen for gRPC: %v", err)
+		}
+
+		go func() {
+			log.Printf("📡 gRPC BFF service ready at localhost:%s (tls=%v, reflection=%v)", cfg.GRPCServer.Port, grpcTLS != nil, cfg.General.Environment == "development")
+
+			if err := bffGRPC.Serve(listener); err != nil {
+				log.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
+	}
+
 	// Esperar señal de interrupción para apagar el servidor gracefully
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -105,9 +207,60 @@ func main() {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	if bffGRPC != nil {
+		bffGRPC.GracefulStop()
+	}
+
 	log.Println("✅ Server exited")
 }
 
+// newGraphQLServer crea el servidor GraphQL con sus transportes, APQ y allowlist de operaciones
+func newGraphQLServer(cfg config.GraphQLConfig, container *config.Container) (*handler.Server, error) {
+	srv := handler.New(
+		generated.NewExecutableSchema(
+			generated.Config{Resolvers: container.GraphQLResolver},
+		),
+	)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.SetErrorPresenter(presenter.ErrorPresenter)
+	srv.AroundRootFields(presenter.LocaleRootFieldMiddleware)
+
+	srv.Use(extension.Introspection{})
+
+	// El allowlist va antes de APQ para resolver los hashes del manifiesto sin pasar por la caché
+	var manifest persisted.Manifest
+	if cfg.AllowlistFile != "" {
+		var err error
+		manifest, err = persisted.LoadManifest(cfg.AllowlistFile)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("📜 Loaded GraphQL allowlist: file=%s, operations=%d, strict=%t", cfg.AllowlistFile, len(manifest), cfg.AllowlistStrict)
+	}
+
+	if manifest != nil || cfg.AllowlistStrict {
+		srv.Use(persisted.NewAllowlist(manifest, cfg.AllowlistStrict, func() {
+			container.Metrics.IncCounter("graphql_allowlist_rejected_total")
+		}))
+	}
+
+	if cfg.APQCacheSize > 0 {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: persisted.NewQueryCache(cfg.APQCacheSize),
+		})
+	}
+
+	return srv, nil
+}
+
 // getConfig carga la configuración desde variables de entorno
 func getConfig() config.Config {
 	cfg := config.DefaultConfig()
@@ -117,10 +270,67 @@ func getConfig() config.Config {
 		cfg.Server.Port = port
 	}
 
+	// Servidor gRPC para servicios internos
+	if grpcEnabled := os.Getenv("GRPC_SERVER_ENABLED"); grpcEnabled != "" {
+		cfg.GRPCServer.Enabled = grpcEnabled == "true"
+	}
+
+	if grpcPort := os.Getenv("GRPC_SERVER_PORT"); grpcPort != "" {
+		cfg.GRPCServer.Port = grpcPort
+	}
+
+	// Tokens de los servicios internos: GRPC_SERVER_TOKEN_<SERVICIO> (por ejemplo GRPC_SERVER_TOKEN_KIOSK)
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if service, ok := strings.CutPrefix(key, "GRPC_SERVER_TOKEN_"); ok && service != "" && value != "" {
+			cfg.GRPCServer.Tokens[strings.ToLower(service)] = value
+		}
+	}
+
+	cfg.GRPCServer.TLSCertFile = os.Getenv("GRPC_SERVER_TLS_CERT")
+	cfg.GRPCServer.TLSKeyFile = os.Getenv("GRPC_SERVER_TLS_KEY")
+	cfg.GRPCServer.ClientCAFile = os.Getenv("GRPC_SERVER_CLIENT_CA")
+
 	if env := os.Getenv("ENV"); env != "" {
 		cfg.General.Environment = env
 	}
 
+	// Operaciones GraphQL: en producción solo se aceptan las del allowlist
+	cfg.GraphQL.AllowlistStrict = cfg.General.Environment == "production"
+
+	if apqCacheSize := os.Getenv("GRAPHQL_APQ_CACHE_SIZE"); apqCacheSize != "" {
+		if size, err := strconv.Atoi(apqCacheSize); err == nil {
+			cfg.GraphQL.APQCacheSize = size
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "GRAPHQL_APQ_CACHE_SIZE", "value", apqCacheSize, "default", cfg.GraphQL.APQCacheSize)
+		}
+	}
+
+	if allowlistFile := os.Getenv("GRAPHQL_ALLOWLIST_FILE"); allowlistFile != "" {
+		cfg.GraphQL.AllowlistFile = allowlistFile
+	}
+
+	if allowlistStrict := os.Getenv("GRAPHQL_ALLOWLIST_STRICT"); allowlistStrict != "" {
+		cfg.GraphQL.AllowlistStrict = allowlistStrict == "true"
+	}
+
+	// Ventana y tamaño de lote de los dataloaders
+	if wait := os.Getenv("DATALOADER_WAIT"); wait != "" {
+		if d, err := time.ParseDuration(wait); err == nil {
+			cfg.GraphQL.DataLoaderWait = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "DATALOADER_WAIT", "value", wait, "default", cfg.GraphQL.DataLoaderWait)
+		}
+	}
+
+	if maxBatch := os.Getenv("DATALOADER_MAX_BATCH"); maxBatch != "" {
+		if size, err := strconv.Atoi(maxBatch); err == nil {
+			cfg.GraphQL.DataLoaderMaxBatch = size
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "DATALOADER_MAX_BATCH", "value", maxBatch, "default", cfg.GraphQL.DataLoaderMaxBatch)
+		}
+	}
+
 	// Mock configuration - default based on environment
 	// In deployed environments (dev/prod), default to false (real APIs)
 	// In local development, default to true (mocks)
@@ -145,13 +355,253 @@ func getConfig() config.Config {
 		cfg.GRPC.BookingServiceAddress = hostBooking + ":" + portBooking
 	}
 
+	// Secretos de webhooks: WEBHOOK_SECRET_<GATEWAY> (por ejemplo WEBHOOK_SECRET_WEBPAY)
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if gateway, ok := strings.CutPrefix(key, "WEBHOOK_SECRET_"); ok && gateway != "" && value != "" {
+			cfg.Webhook.Secrets[strings.ToLower(gateway)] = value
+		}
+	}
+	if mercadoPagoURL := os.Getenv("MERCADOPAGO_API_URL"); mercadoPagoURL != "" {
+		cfg.Webhook.MercadoPagoAPIURL = mercadoPagoURL
+	}
+	cfg.Webhook.MercadoPagoAccessToken = os.Getenv("MERCADOPAGO_ACCESS_TOKEN")
+
+	// Validación previa a la apertura de lockers (reglas por defecto)
+	if openGuard := os.Getenv("OPEN_GUARD_ENABLED"); openGuard != "" {
+		cfg.OpenGuard.Default.Enabled = openGuard == "true"
+	}
+
+	// Caché de GetPaymentInfraByQrValue
+	if cacheEnabled := os.Getenv("PAYMENT_INFRA_CACHE_ENABLED"); cacheEnabled != "" {
+		cfg.Cache.PaymentInfra.Enabled = cacheEnabled == "true"
+	}
+
+	if cacheTTL := os.Getenv("PAYMENT_INFRA_CACHE_TTL"); cacheTTL != "" {
+		if ttl, err := time.ParseDuration(cacheTTL); err == nil {
+			cfg.Cache.PaymentInfra.TTL = ttl
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "PAYMENT_INFRA_CACHE_TTL", "value", cacheTTL, "default", cfg.Cache.PaymentInfra.TTL)
+		}
+	}
+
+	// Deduplicación de lecturas concurrentes al backend
+	if coalescing := os.Getenv("REQUEST_COALESCING_ENABLED"); coalescing != "" {
+		cfg.Cache.Coalescing = coalescing == "true"
+	}
+
+	// QR firmados: modo y claves "kid:algoritmo:claveBase64" separadas por coma
+	if qrMode := os.Getenv("QR_VERIFICATION_MODE"); qrMode != "" {
+		cfg.QR.Mode = qrMode
+	}
+
+	if qrKeys := os.Getenv("QR_KEYS"); qrKeys != "" {
+		for _, entry := range splitAndTrim(qrKeys) {
+			parts := strings.SplitN(entry, ":", 3)
+			if len(parts) != 3 {
+				slog.Warn("⚠️ Invalid QR_KEYS entry, expected kid:algorithm:key", "entry", entry)
+				continue
+			}
+			cfg.QR.Keys = append(cfg.QR.Keys, config.QRKeyConfig{ID: parts[0], Algorithm: parts[1], Key: parts[2]})
+		}
+	}
+
+	// Comprobantes por correo y SMS tras una reserva o un pago confirmado
+	if notifications := os.Getenv("NOTIFICATIONS_ENABLED"); notifications != "" {
+		cfg.Notification.Enabled = notifications == "true"
+	}
+
+	if locale := os.Getenv("NOTIFICATION_LOCALE"); locale != "" {
+		cfg.Notification.Locale = locale
+	}
+
+	if maxAttempts := os.Getenv("NOTIFICATION_MAX_ATTEMPTS"); maxAttempts != "" {
+		if attempts, err := strconv.Atoi(maxAttempts); err == nil {
+			cfg.Notification.MaxAttempts = attempts
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "NOTIFICATION_MAX_ATTEMPTS", "value", maxAttempts, "default", cfg.Notification.MaxAttempts)
+		}
+	}
+
+	if backoff := os.Getenv("NOTIFICATION_RETRY_BACKOFF"); backoff != "" {
+		if d, err := time.ParseDuration(backoff); err == nil {
+			cfg.Notification.InitialBackoff = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "NOTIFICATION_RETRY_BACKOFF", "value", backoff, "default", cfg.Notification.InitialBackoff)
+		}
+	}
+
+	if notificationFile := os.Getenv("NOTIFICATION_FILE"); notificationFile != "" {
+		cfg.Notification.Email.FilePath = notificationFile
+		cfg.Notification.SMS.FilePath = notificationFile
+	}
+
+	if emailProvider := os.Getenv("NOTIFICATION_EMAIL_PROVIDER"); emailProvider != "" {
+		cfg.Notification.Email.Provider = emailProvider
+	}
+
+	if smtpHost := os.Getenv("SMTP_HOST"); smtpHost != "" {
+		cfg.Notification.Email.SMTPHost = smtpHost
+	}
+
+	if smtpPort := os.Getenv("SMTP_PORT"); smtpPort != "" {
+		cfg.Notification.Email.SMTPPort = smtpPort
+	}
+
+	cfg.Notification.Email.SMTPUsername = os.Getenv("SMTP_USERNAME")
+	cfg.Notification.Email.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+	cfg.Notification.Email.From = os.Getenv("SMTP_FROM")
+
+	if smsProvider := os.Getenv("NOTIFICATION_SMS_PROVIDER"); smsProvider != "" {
+		cfg.Notification.SMS.Provider = smsProvider
+	}
+
+	cfg.Notification.SMS.URL = os.Getenv("SMS_PROVIDER_URL")
+	cfg.Notification.SMS.Token = os.Getenv("SMS_PROVIDER_TOKEN")
+	cfg.Notification.SMS.From = os.Getenv("SMS_FROM")
+
+	// Outbox de eventos de dominio y sus destinos
+	if outboxEnabled := os.Getenv("OUTBOX_ENABLED"); outboxEnabled != "" {
+		cfg.Outbox.Enabled = outboxEnabled == "true"
+	}
+
+	if outboxStore := os.Getenv("OUTBOX_STORE"); outboxStore != "" {
+		cfg.Outbox.Store = outboxStore
+	}
+
+	if outboxFile := os.Getenv("OUTBOX_FILE"); outboxFile != "" {
+		cfg.Outbox.FilePath = outboxFile
+	}
+
+	if pollInterval := os.Getenv("OUTBOX_POLL_INTERVAL"); pollInterval != "" {
+		if d, err := time.ParseDuration(pollInterval); err == nil {
+			cfg.Outbox.PollInterval = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "OUTBOX_POLL_INTERVAL", "value", pollInterval, "default", cfg.Outbox.PollInterval)
+		}
+	}
+
+	if sinks := os.Getenv("OUTBOX_SINKS"); sinks != "" {
+		cfg.Outbox.Sinks = splitAndTrim(sinks)
+	}
+
+	cfg.Outbox.WebhookURL = os.Getenv("OUTBOX_WEBHOOK_URL")
+	cfg.Outbox.WebhookSecret = os.Getenv("OUTBOX_WEBHOOK_SECRET")
+
+	// Extensiones de reserva pendientes de pago
+	if extensionStore := os.Getenv("BOOKING_EXTENSION_STORE"); extensionStore != "" {
+		cfg.BookingExtension.Store = extensionStore
+	}
+
+	if extensionFile := os.Getenv("BOOKING_EXTENSION_FILE"); extensionFile != "" {
+		cfg.BookingExtension.FilePath = extensionFile
+	}
+
+	if reconcileInterval := os.Getenv("BOOKING_EXTENSION_RECONCILE_INTERVAL"); reconcileInterval != "" {
+		if d, err := time.ParseDuration(reconcileInterval); err == nil {
+			cfg.BookingExtension.ReconcileInterval = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "BOOKING_EXTENSION_RECONCILE_INTERVAL", "value", reconcileInterval, "default", cfg.BookingExtension.ReconcileInterval)
+		}
+	}
+
+	if pendingTTL := os.Getenv("BOOKING_EXTENSION_PENDING_TTL"); pendingTTL != "" {
+		if d, err := time.ParseDuration(pendingTTL); err == nil {
+			cfg.BookingExtension.PendingTTL = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "BOOKING_EXTENSION_PENDING_TTL", "value", pendingTTL, "default", cfg.BookingExtension.PendingTTL)
+		}
+	}
+
+	// Auditoría de aperturas de locker
+	if auditEnabled := os.Getenv("AUDIT_ENABLED"); auditEnabled != "" {
+		cfg.Audit.Enabled = auditEnabled == "true"
+	}
+
+	if auditFile := os.Getenv("AUDIT_FILE"); auditFile != "" {
+		cfg.Audit.FilePath = auditFile
+	}
+
+	cfg.Audit.AdminToken = os.Getenv("AUDIT_ADMIN_TOKEN")
+
+	// Token de las operaciones administrativas; la auditoría lo usa si no tiene uno propio
+	cfg.Admin.Token = os.Getenv("ADMIN_TOKEN")
+	if cfg.Audit.AdminToken == "" {
+		cfg.Audit.AdminToken = cfg.Admin.Token
+	}
+
+	// Tenant de las solicitudes que no indican uno (los tenants se definen en CONFIG_FILE)
+	cfg.Tenancy.DefaultTenant = os.Getenv("DEFAULT_TENANT")
+
+	// Archivo de feature flags recargable en caliente (opcional)
+	cfg.FeatureFlags.FilePath = os.Getenv("FEATURE_FLAGS_FILE")
+
+	if pollInterval := os.Getenv("FEATURE_FLAGS_POLL_INTERVAL"); pollInterval != "" {
+		if d, err := time.ParseDuration(pollInterval); err == nil {
+			cfg.FeatureFlags.PollInterval = d
+		} else {
+			slog.Warn("⚠️ Invalid environment variable, using default", "name", "FEATURE_FLAGS_POLL_INTERVAL", "value", pollInterval, "default", cfg.FeatureFlags.PollInterval)
+		}
+	}
+
+	if logLevel := os.Getenv("LOG_LEVEL"); logLevel != "" {
+		cfg.Log.Level = logLevel
+	}
+
+	// Orígenes CORS separados por coma
+	if origins := os.Getenv("CORS_ALLOWED_ORIGINS"); origins != "" {
+		cfg.CORS.AllowedOrigins = splitAndTrim(origins)
+	}
+
+	// Credenciales entre orígenes (requiere orígenes explícitos)
+	cfg.CORS.AllowCredentials = os.Getenv("CORS_ALLOW_CREDENTIALS") == "true"
+
+	// Proxies de confianza (IP o CIDR separados por coma) para X-Forwarded-For
+	if proxies := os.Getenv("TRUSTED_PROXIES"); proxies != "" {
+		cfg.Server.TrustedProxies = splitAndTrim(proxies)
+	}
+
+	// Archivo de configuración recargable en caliente (opcional)
+	if configFile := os.Getenv("CONFIG_FILE"); configFile != "" {
+		cfg.General.ConfigFile = configFile
+		fileCfg, err := config.LoadFile(configFile, cfg)
+		if err != nil {
+			log.Fatalf("Failed to load config file: %v", err)
+		}
+		cfg = fileCfg
+	}
+
 	// Log configuration
 	log.Printf("🔧 Configuration loaded:")
 	log.Printf("   Environment: %s", cfg.General.Environment)
 	log.Printf("   Use Mock: %v", cfg.General.UseMock)
 	log.Printf("   Server Port: %s", cfg.Server.Port)
+	log.Printf("   CORS: origins=%v, credentials=%v, trustedProxies=%v", cfg.CORS.AllowedOrigins, cfg.CORS.AllowCredentials, cfg.Server.TrustedProxies)
+	log.Printf("   gRPC Server: enabled=%v, port=%s, tokens=%d, tls=%v, clientCerts=%v", cfg.GRPCServer.Enabled, cfg.GRPCServer.Port, len(cfg.GRPCServer.Tokens), cfg.GRPCServer.TLSCertFile != "", cfg.GRPCServer.ClientCAFile != "")
 	log.Printf("   Payment Service: %s", cfg.GRPC.PaymentServiceAddress)
 	log.Printf("   Booking Service: %s", cfg.GRPC.BookingServiceAddress)
+	log.Printf("   Log Level: %s", cfg.Log.Level)
+	log.Printf("   Config File: %s", cfg.General.ConfigFile)
+	log.Printf("   Webhook Gateways: %d configured", len(cfg.Webhook.Secrets))
+	log.Printf("   QR Verification: mode=%s, keys=%d", cfg.QR.Mode, len(cfg.QR.Keys))
+	log.Printf("   Notifications: enabled=%v, locale=%s, email=%s, sms=%s", cfg.Notification.Enabled, cfg.Notification.Locale, cfg.Notification.Email.Provider, cfg.Notification.SMS.Provider)
+	log.Printf("   Outbox: enabled=%v, store=%s, sinks=%v", cfg.Outbox.Enabled, cfg.Outbox.Store, cfg.Outbox.Sinks)
+	log.Printf("   Booking Extensions: store=%s, reconcile=%s, pendingTtl=%s", cfg.BookingExtension.Store, cfg.BookingExtension.ReconcileInterval, cfg.BookingExtension.PendingTTL)
+	log.Printf("   Locker Open Audit: enabled=%v, file=%s, adminQuery=%v", cfg.Audit.Enabled, cfg.Audit.FilePath, cfg.Audit.AdminToken != "")
+	log.Printf("   Admin Operations: enabled=%v", cfg.Admin.Token != "")
+	log.Printf("   Tenancy: tenants=%d, default=%q", len(cfg.Tenancy.Tenants), cfg.Tenancy.DefaultTenant)
+	log.Printf("   Feature Flags: file=%q, pollInterval=%s, configFeatures=%d", cfg.FeatureFlags.FilePath, cfg.FeatureFlags.PollInterval, len(cfg.Features))
 
 	return cfg
 }
+
+// splitAndTrim separa una lista separada por comas descartando elementos vacíos
+func splitAndTrim(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -1,12 +1,44 @@
 package config
 
-import "time"
+import (
+	"bff-graphql-payment/internal/application/service"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/infrastructure/logging"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
 
 // Config contiene toda la configuración de la aplicación
 type Config struct {
-	Server  ServerConfig
-	GRPC    GRPCConfig
-	General GeneralConfig
+	Server       ServerConfig
+	GRPCServer   GRPCServerConfig
+	GRPC         GRPCConfig
+	General      GeneralConfig
+	Log          LogConfig
+	RateLimit    RateLimitConfig
+	CORS         CORSConfig
+	Features     map[string]bool
+	Webhook      WebhookConfig
+	Subscription SubscriptionConfig
+	Gateways     []PaymentGatewayConfig
+	OpenGuard    OpenGuardConfig
+	Cache        CacheConfig
+	GraphQL      GraphQLConfig
+	QR           QRConfig
+	Notification NotificationConfig
+	Outbox       OutboxConfig
+	// BookingExtension contiene el almacenamiento y la conciliación de las extensiones pendientes
+	BookingExtension BookingExtensionConfig
+	Audit            AuditConfig
+	Admin            AdminConfig
+	Tenancy          TenancyConfig
+	FeatureFlags     FeatureFlagsConfig
 }
 
 // ServerConfig contiene la configuración del servidor HTTP
@@ -15,6 +47,25 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
+	// TrustedProxies son las redes (CIDR o IP) de los proxies cuyo X-Forwarded-For se considera
+	// para obtener la IP del cliente; sin proxies se usa la IP de la conexión
+	TrustedProxies []string
+}
+
+// GRPCServerConfig contiene la configuración del servidor gRPC que expone el BFF a los servicios
+// internos. Cada servicio se autentica con su token (Tokens) o con un certificado de cliente
+// firmado por ClientCAFile (mTLS); al menos uno de los dos es obligatorio.
+type GRPCServerConfig struct {
+	Enabled bool
+	// Port es el puerto del servidor gRPC; debe ser distinto del puerto HTTP
+	Port string
+	// Tokens asocia cada servicio con el token que presenta en la metadata authorization
+	Tokens map[string]string
+	// TLSCertFile y TLSKeyFile habilitan TLS; sin ellos el servidor acepta conexiones en texto plano
+	TLSCertFile string
+	TLSKeyFile  string
+	// ClientCAFile exige a los servicios un certificado de cliente firmado por esta CA
+	ClientCAFile string
 }
 
 // GRPCConfig contiene la configuración de los clientes gRPC
@@ -29,6 +80,302 @@ type GRPCConfig struct {
 type GeneralConfig struct {
 	Environment string
 	UseMock     bool
+	// ConfigFile es la ruta del archivo JSON con la configuración recargable en caliente
+	ConfigFile string
+	// ConfigPollInterval es cada cuánto se revisa si el archivo de configuración cambió
+	ConfigPollInterval time.Duration
+}
+
+// LogConfig contiene la configuración de logging
+type LogConfig struct {
+	Level string
+}
+
+// RateLimitConfig contiene la configuración del limitador de solicitudes por cliente
+type RateLimitConfig struct {
+	// RequestsPerSecond en 0 desactiva el limitador
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// CORSConfig contiene la configuración de CORS
+type CORSConfig struct {
+	AllowedOrigins []string
+	// AllowCredentials permite cookies y cabeceras de autenticación entre orígenes; requiere
+	// orígenes explícitos
+	AllowCredentials bool
+}
+
+// WebhookConfig contiene la configuración de los webhooks de pasarelas de pago
+type WebhookConfig struct {
+	// Secrets asocia cada gatewayName con el secreto para verificar sus firmas
+	Secrets map[string]string
+	// MercadoPagoAPIURL y MercadoPagoAccessToken permiten consultar el pago notificado,
+	// ya que la firma de MercadoPago no cubre su estado ni su orden
+	MercadoPagoAPIURL      string
+	MercadoPagoAccessToken string
+}
+
+// SubscriptionConfig contiene la configuración de las suscripciones GraphQL
+type SubscriptionConfig struct {
+	// Intervalos del polling de respaldo de GetPurchaseOrderByPo (backoff exponencial)
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+}
+
+// PaymentGatewayConfig contiene la definición de una pasarela de pago
+type PaymentGatewayConfig struct {
+	Name                string   `json:"name"`
+	DisplayName         string   `json:"displayName"`
+	LogoURL             string   `json:"logoUrl"`
+	SupportedCurrencies []string `json:"supportedCurrencies"`
+	MinAmount           int64    `json:"minAmount"`
+	MaxAmount           int64    `json:"maxAmount"`
+	Enabled             bool     `json:"enabled"`
+	// EnabledRackIDs vacío habilita la pasarela en todas las instalaciones
+	EnabledRackIDs  []int `json:"enabledRackIds"`
+	DisabledRackIDs []int `json:"disabledRackIds"`
+}
+
+// OpenGuardConfig contiene las reglas de validación previas a la apertura de lockers
+type OpenGuardConfig struct {
+	// Default se aplica a los serviceName sin reglas propias
+	Default OpenGuardRuleConfig
+	// Services asocia cada serviceName con sus reglas
+	Services map[string]OpenGuardRuleConfig
+}
+
+// OpenGuardRuleConfig contiene las reglas de apertura de un serviceName
+type OpenGuardRuleConfig struct {
+	Enabled            bool
+	EnforceWindow      bool
+	EnforceOpenings    bool
+	EarlyOpenTolerance time.Duration
+}
+
+// CacheConfig contiene la configuración de las cachés de lecturas al backend
+type CacheConfig struct {
+	// PaymentInfra cachea GetPaymentInfraByQrValue por valor QR
+	PaymentInfra CachePolicyConfig
+	// Coalescing deduplica las lecturas concurrentes idénticas al backend
+	Coalescing bool
+}
+
+// CachePolicyConfig contiene la política de una caché en memoria
+type CachePolicyConfig struct {
+	Enabled    bool
+	MaxEntries int
+	// TTL es el tiempo durante el que una entrada es vigente
+	TTL time.Duration
+	// StaleTTL es el tiempo adicional durante el que una entrada vencida se sirve mientras se revalida
+	StaleTTL time.Duration
+}
+
+// GraphQLConfig contiene la configuración de las operaciones GraphQL aceptadas
+type GraphQLConfig struct {
+	// APQCacheSize es la cantidad de consultas persistidas automáticamente (APQ) que se recuerdan; 0 desactiva APQ
+	APQCacheSize int
+	// AllowlistFile es el manifiesto generado por cmd/allowlist con las operaciones del frontend
+	AllowlistFile string
+	// AllowlistStrict rechaza toda operación que no esté en el manifiesto
+	AllowlistStrict bool
+	// DataLoaderWait es la ventana en que los dataloaders acumulan claves antes de consultar
+	DataLoaderWait time.Duration
+	// DataLoaderMaxBatch despacha el lote de inmediato al alcanzar esta cantidad de claves
+	DataLoaderMaxBatch int
+}
+
+// QRConfig contiene la verificación de los QR firmados de los racks
+type QRConfig struct {
+	// Mode es disabled, migration (acepta también QR sin firma) o enforced
+	Mode string
+	// Keys son las claves vigentes; tener más de una permite rotarlas
+	Keys []QRKeyConfig
+}
+
+// QRKeyConfig contiene una clave de verificación de QR
+type QRKeyConfig struct {
+	ID string
+	// Algorithm es HS256 o EdDSA
+	Algorithm string
+	// Key es el secreto HMAC o la clave pública Ed25519, en base64
+	Key string
+}
+
+// Proveedores de notificaciones
+const (
+	NotificationProviderDisabled = "disabled"
+	NotificationProviderSMTP     = "smtp"
+	NotificationProviderHTTP     = "http"
+	NotificationProviderFile     = "file"
+	NotificationProviderMemory   = "memory"
+)
+
+// NotificationConfig contiene el envío de comprobantes por correo y SMS tras una reserva o un pago
+type NotificationConfig struct {
+	Enabled bool
+	// Locale es el idioma de los mensajes (es o en)
+	Locale string
+	// MaxAttempts es la cantidad de intentos por mensaje; los reintentos usan backoff exponencial
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	SendTimeout    time.Duration
+	QueueSize      int
+	Workers        int
+	Email          EmailNotificationConfig
+	SMS            SMSNotificationConfig
+}
+
+// EmailNotificationConfig contiene el proveedor de correo
+type EmailNotificationConfig struct {
+	// Provider es smtp, file, memory o disabled
+	Provider     string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	// FilePath es el archivo JSONL del proveedor file
+	FilePath string
+}
+
+// SMSNotificationConfig contiene el proveedor de SMS
+type SMSNotificationConfig struct {
+	// Provider es http, file, memory o disabled
+	Provider string
+	URL      string
+	Token    string
+	From     string
+	// FilePath es el archivo JSONL del proveedor file
+	FilePath string
+}
+
+// Almacenamientos y sinks del outbox de eventos de dominio
+const (
+	OutboxStoreFile   = "file"
+	OutboxStoreMemory = "memory"
+
+	OutboxSinkLog     = "log"
+	OutboxSinkWebhook = "webhook"
+	OutboxSinkMemory  = "memory"
+)
+
+// OutboxConfig contiene el outbox de eventos de dominio (PurchaseOrderCreated, BookingCreated,
+// LockerOpened, LockerOpenFailed) y sus destinos
+type OutboxConfig struct {
+	Enabled bool
+	// Store es file (log JSONL durable) o memory
+	Store    string
+	FilePath string
+	// PollInterval es cada cuánto se revisan los eventos pendientes de reintento
+	PollInterval   time.Duration
+	BatchSize      int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Sinks son los destinos de los eventos: log, webhook y/o memory
+	Sinks         []string
+	WebhookURL    string
+	WebhookSecret string
+}
+
+const (
+	BookingExtensionStoreFile   = "file"
+	BookingExtensionStoreMemory = "memory"
+)
+
+// BookingExtensionConfig contiene el almacenamiento de las extensiones de reserva que esperan la
+// confirmación de su pago y la conciliación de sus órdenes con el backend
+type BookingExtensionConfig struct {
+	// Store es file (archivo JSON durable) o memory
+	Store    string
+	FilePath string
+	// ReconcileInterval es cada cuánto se consulta el estado de las órdenes pendientes
+	ReconcileInterval time.Duration
+	// PendingTTL es el plazo tras el cual se descarta una extensión cuyo pago no se resolvió
+	PendingTTL time.Duration
+}
+
+// AuditConfig contiene el registro de auditoría de los intentos de apertura de locker
+type AuditConfig struct {
+	Enabled bool
+	// FilePath es el archivo JSONL de solo agregado con los registros
+	FilePath string
+	// AdminToken habilita la consulta lockerOpenAudit a quien lo presente en X-Admin-Token;
+	// sin token la consulta queda deshabilitada
+	AdminToken string
+}
+
+// AdminConfig contiene la configuración de las operaciones administrativas
+type AdminConfig struct {
+	// Token habilita las operaciones administrativas a quien lo presente en X-Admin-Token; sin
+	// token quedan deshabilitadas para todos
+	Token string
+}
+
+// FeatureFlagsConfig contiene la configuración del archivo de feature flags. Los flags booleanos
+// de Features se evalúan después de los del archivo.
+type FeatureFlagsConfig struct {
+	// FilePath es el archivo JSON con los flags; vacío usa solo Features
+	FilePath string
+	// PollInterval es cada cuánto se revisa si el archivo cambió; 0 desactiva la recarga
+	PollInterval time.Duration
+}
+
+// TenancyConfig contiene los tenants (operadores de lockers) atendidos por el BFF
+type TenancyConfig struct {
+	// DefaultTenant es el tenant de las solicitudes que no indican uno; vacío las deja sin tenant
+	DefaultTenant string
+	Tenants       []TenantConfig
+}
+
+// TenantConfig contiene la definición de un tenant. Las solicitudes se asocian al tenant por el
+// header X-Tenant-Id, por el hostname o por el serviceName de la reserva.
+type TenantConfig struct {
+	ID              string
+	DisplayName     string
+	Hosts           []string
+	ServiceNames    []string
+	InstallationIDs []int
+	Backend         TenantBackendConfig
+	// Gateways son los gatewayName habilitados; vacío habilita todas las pasarelas
+	Gateways []string
+	// Features se superponen a los feature flags globales
+	Features  map[string]bool
+	Branding  TenantBrandingConfig
+	RateLimit RateLimitConfig
+}
+
+// TenantBackendConfig contiene los backends propios de un tenant; los valores vacíos usan los
+// globales. Las direcciones solo cambian al reiniciar; los timeouts se recargan en caliente.
+type TenantBackendConfig struct {
+	PaymentServiceAddress string
+	PaymentServiceTimeout time.Duration
+	BookingServiceAddress string
+	BookingServiceTimeout time.Duration
+}
+
+// TenantBrandingConfig contiene la marca de un tenant
+type TenantBrandingConfig struct {
+	LogoURL        string `json:"logoUrl"`
+	PrimaryColor   string `json:"primaryColor"`
+	SecondaryColor string `json:"secondaryColor"`
+}
+
+// VerificationKeys decodifica las claves de verificación de QR
+func (c QRConfig) VerificationKeys() ([]model.QRKey, error) {
+	keys := make([]model.QRKey, 0, len(c.Keys))
+	for _, key := range c.Keys {
+		material, err := base64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("QR key %q is not valid base64: %w", key.ID, err)
+		}
+		keys = append(keys, model.QRKey{
+			ID:        key.ID,
+			Algorithm: model.QRSignatureAlgorithm(key.Algorithm),
+			Material:  material,
+		})
+	}
+	return keys, nil
 }
 
 // DefaultConfig devuelve la configuración por defecto
@@ -40,6 +387,11 @@ func DefaultConfig() Config {
 			WriteTimeout: 15 * time.Second,
 			IdleTimeout:  60 * time.Second,
 		},
+		GRPCServer: GRPCServerConfig{
+			Enabled: false,
+			Port:    "9090",
+			Tokens:  map[string]string{},
+		},
 		GRPC: GRPCConfig{
 			PaymentServiceAddress: "localhost:50051",
 			PaymentServiceTimeout: 10 * time.Second,
@@ -47,8 +399,505 @@ func DefaultConfig() Config {
 			BookingServiceTimeout: 10 * time.Second,
 		},
 		General: GeneralConfig{
-			Environment: "development",
-			UseMock:     true,
+			Environment:        "development",
+			UseMock:            true,
+			ConfigPollInterval: 5 * time.Second,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 0,
+			Burst:             0,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"*"},
+		},
+		Features: map[string]bool{},
+		Webhook: WebhookConfig{
+			Secrets:           map[string]string{},
+			MercadoPagoAPIURL: "https://api.mercadopago.com",
+		},
+		Subscription: SubscriptionConfig{
+			MinPollInterval: 2 * time.Second,
+			MaxPollInterval: 30 * time.Second,
+		},
+		Gateways: []PaymentGatewayConfig{
+			{
+				Name:                "webpay",
+				DisplayName:         "Webpay",
+				LogoURL:             "https://www.transbankdevelopers.cl/public/library/img/svg/logo_webpay.svg",
+				SupportedCurrencies: []string{"CLP"},
+				MinAmount:           50,
+				Enabled:             true,
+			},
+			{
+				Name:                "mercadopago",
+				DisplayName:         "Mercado Pago",
+				LogoURL:             "https://http2.mlstatic.com/frontend-assets/mp-web-navigation/ui-navigation/6.6.92/mercadopago/logo__large.png",
+				SupportedCurrencies: []string{"CLP"},
+				MinAmount:           100,
+				Enabled:             true,
+			},
+		},
+		OpenGuard: OpenGuardConfig{
+			Default: OpenGuardRuleConfig{
+				Enabled:         false,
+				EnforceWindow:   true,
+				EnforceOpenings: true,
+			},
+			Services: map[string]OpenGuardRuleConfig{},
+		},
+		Cache: CacheConfig{
+			PaymentInfra: CachePolicyConfig{
+				Enabled:    true,
+				MaxEntries: 1000,
+				TTL:        5 * time.Minute,
+				StaleTTL:   time.Minute,
+			},
+			Coalescing: true,
+		},
+		GraphQL: GraphQLConfig{
+			APQCacheSize:       1000,
+			DataLoaderWait:     2 * time.Millisecond,
+			DataLoaderMaxBatch: 100,
+		},
+		QR: QRConfig{
+			Mode: string(model.QRVerificationDisabled),
+		},
+		Notification: NotificationConfig{
+			Enabled:        false,
+			Locale:         string(model.DefaultLocale),
+			MaxAttempts:    5,
+			InitialBackoff: 2 * time.Second,
+			SendTimeout:    10 * time.Second,
+			QueueSize:      1000,
+			Workers:        2,
+			Email: EmailNotificationConfig{
+				Provider: NotificationProviderFile,
+				SMTPPort: "587",
+				FilePath: "notifications.jsonl",
+			},
+			SMS: SMSNotificationConfig{
+				Provider: NotificationProviderFile,
+				FilePath: "notifications.jsonl",
+			},
+		},
+		Outbox: OutboxConfig{
+			Enabled:        false,
+			Store:          OutboxStoreFile,
+			FilePath:       "outbox.jsonl",
+			PollInterval:   time.Second,
+			BatchSize:      100,
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Minute,
+			Sinks:          []string{OutboxSinkLog},
 		},
+		BookingExtension: BookingExtensionConfig{
+			Store:             BookingExtensionStoreFile,
+			FilePath:          "booking_extensions.json",
+			ReconcileInterval: time.Minute,
+			PendingTTL:        24 * time.Hour,
+		},
+		Audit: AuditConfig{
+			Enabled:  true,
+			FilePath: "locker_open_audit.jsonl",
+		},
+		FeatureFlags: FeatureFlagsConfig{
+			PollInterval: 5 * time.Second,
+		},
+	}
+}
+
+// Validate verifica que la configuración sea consistente
+func (c Config) Validate() error {
+	var errs []error
+
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.GRPC.PaymentServiceTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("payment service timeout must be positive, got %s", c.GRPC.PaymentServiceTimeout))
+	}
+
+	if c.GRPC.BookingServiceTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("booking service timeout must be positive, got %s", c.GRPC.BookingServiceTimeout))
+	}
+
+	if c.GRPCServer.Enabled {
+		errs = append(errs, c.GRPCServer.validate(c.Server.Port)...)
+	}
+
+	if c.RateLimit.RequestsPerSecond < 0 {
+		errs = append(errs, fmt.Errorf("rate limit requests per second must not be negative, got %v", c.RateLimit.RequestsPerSecond))
+	}
+
+	if c.RateLimit.RequestsPerSecond > 0 && c.RateLimit.Burst <= 0 {
+		errs = append(errs, fmt.Errorf("rate limit burst must be positive when the limiter is enabled, got %d", c.RateLimit.Burst))
+	}
+
+	if c.Subscription.MinPollInterval <= 0 || c.Subscription.MaxPollInterval < c.Subscription.MinPollInterval {
+		errs = append(errs, fmt.Errorf("invalid subscription poll intervals: min=%s, max=%s", c.Subscription.MinPollInterval, c.Subscription.MaxPollInterval))
+	}
+
+	gatewayNames := make(map[string]bool, len(c.Gateways))
+	for _, gateway := range c.Gateways {
+		name := strings.ToLower(strings.TrimSpace(gateway.Name))
+		switch {
+		case name == "":
+			errs = append(errs, errors.New("payment gateway name must not be empty"))
+		case gatewayNames[name]:
+			errs = append(errs, fmt.Errorf("duplicated payment gateway %q", gateway.Name))
+		case gateway.MinAmount < 0 || (gateway.MaxAmount != 0 && gateway.MaxAmount < gateway.MinAmount):
+			errs = append(errs, fmt.Errorf("invalid amount limits for payment gateway %q", gateway.Name))
+		}
+		gatewayNames[name] = true
+	}
+
+	if c.Webhook.Secrets["mercadopago"] != "" && (strings.TrimSpace(c.Webhook.MercadoPagoAPIURL) == "" || strings.TrimSpace(c.Webhook.MercadoPagoAccessToken) == "") {
+		errs = append(errs, errors.New("mercadopago webhook requires an API URL and an access token to fetch payments"))
+	}
+
+	if c.OpenGuard.Default.EarlyOpenTolerance < 0 {
+		errs = append(errs, fmt.Errorf("open guard early open tolerance must not be negative, got %s", c.OpenGuard.Default.EarlyOpenTolerance))
+	}
+
+	for serviceName, rules := range c.OpenGuard.Services {
+		if rules.EarlyOpenTolerance < 0 {
+			errs = append(errs, fmt.Errorf("open guard early open tolerance for service %q must not be negative, got %s", serviceName, rules.EarlyOpenTolerance))
+		}
+	}
+
+	if policy := c.Cache.PaymentInfra; policy.Enabled && (policy.MaxEntries <= 0 || policy.TTL <= 0 || policy.StaleTTL < 0) {
+		errs = append(errs, fmt.Errorf("invalid payment infra cache policy: maxEntries=%d, ttl=%s, staleTtl=%s", policy.MaxEntries, policy.TTL, policy.StaleTTL))
+	}
+
+	if c.GraphQL.APQCacheSize < 0 {
+		errs = append(errs, fmt.Errorf("APQ cache size must not be negative, got %d", c.GraphQL.APQCacheSize))
+	}
+
+	if c.GraphQL.DataLoaderWait < 0 || c.GraphQL.DataLoaderMaxBatch <= 0 {
+		errs = append(errs, fmt.Errorf("invalid dataloader settings: wait=%s, maxBatch=%d", c.GraphQL.DataLoaderWait, c.GraphQL.DataLoaderMaxBatch))
+	}
+
+	if c.GraphQL.AllowlistStrict && strings.TrimSpace(c.GraphQL.AllowlistFile) == "" {
+		errs = append(errs, errors.New("strict allowlist requires an allowlist manifest file"))
+	}
+
+	if keys, err := c.QR.VerificationKeys(); err != nil {
+		errs = append(errs, err)
+	} else if _, err := service.NewQRVerifier(model.QRVerificationMode(c.QR.Mode), keys); err != nil {
+		errs = append(errs, fmt.Errorf("invalid QR verification settings: %w", err))
+	}
+
+	if c.Notification.Enabled {
+		errs = append(errs, c.Notification.validate()...)
+	}
+
+	if c.Outbox.Enabled {
+		errs = append(errs, c.Outbox.validate()...)
+	}
+
+	errs = append(errs, c.BookingExtension.validate()...)
+
+	if c.Audit.Enabled && strings.TrimSpace(c.Audit.FilePath) == "" {
+		errs = append(errs, errors.New("locker open audit requires a file path"))
+	}
+
+	errs = append(errs, c.Tenancy.validate(gatewayNames)...)
+
+	if c.FeatureFlags.PollInterval < 0 {
+		errs = append(errs, fmt.Errorf("feature flags poll interval must not be negative, got %s", c.FeatureFlags.PollInterval))
+	}
+
+	for name := range c.Features {
+		if strings.TrimSpace(name) == "" {
+			errs = append(errs, errors.New("feature names must not be empty"))
+			break
+		}
+	}
+
+	// Un flag mock.* con porcentaje o segmentos desviaría tráfico real de producción a los mocks
+	if c.General.Environment == "production" {
+		for name := range c.Features {
+			if strings.HasPrefix(name, model.FeatureFlagMockPrefix) {
+				errs = append(errs, fmt.Errorf("feature %q is not allowed in production", name))
+			}
+		}
+		for _, tenant := range c.Tenancy.Tenants {
+			for name := range tenant.Features {
+				if strings.HasPrefix(name, model.FeatureFlagMockPrefix) {
+					errs = append(errs, fmt.Errorf("feature %q of tenant %q is not allowed in production", name, tenant.ID))
+				}
+			}
+		}
+	}
+
+	if len(c.CORS.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("at least one CORS allowed origin is required"))
+	}
+
+	for _, origin := range c.CORS.AllowedOrigins {
+		if strings.TrimSpace(origin) == "" {
+			errs = append(errs, errors.New("CORS allowed origins must not be empty"))
+			break
+		}
+	}
+
+	if slices.Contains(c.CORS.AllowedOrigins, "*") {
+		if c.CORS.AllowCredentials {
+			errs = append(errs, errors.New("CORS credentials require explicit allowed origins, not \"*\""))
+		}
+		if c.General.Environment == "production" {
+			errs = append(errs, errors.New("CORS allowed origins must be explicit in production, not \"*\""))
+		}
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if !validTrustedProxy(proxy) {
+			errs = append(errs, fmt.Errorf("invalid trusted proxy %q, expected an IP address or CIDR", proxy))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validate verifica los ajustes de notificaciones y sus proveedores
+func (c NotificationConfig) validate() []error {
+	var errs []error
+
+	if _, ok := model.ParseLocale(c.Locale); !ok {
+		errs = append(errs, fmt.Errorf("unsupported notification locale %q", c.Locale))
+	}
+
+	if c.MaxAttempts <= 0 || c.InitialBackoff < 0 || c.SendTimeout <= 0 || c.QueueSize <= 0 || c.Workers <= 0 {
+		errs = append(errs, fmt.Errorf("invalid notification delivery settings: maxAttempts=%d, initialBackoff=%s, sendTimeout=%s, queueSize=%d, workers=%d",
+			c.MaxAttempts, c.InitialBackoff, c.SendTimeout, c.QueueSize, c.Workers))
+	}
+
+	switch c.Email.Provider {
+	case NotificationProviderDisabled, NotificationProviderMemory:
+	case NotificationProviderSMTP:
+		if strings.TrimSpace(c.Email.SMTPHost) == "" || strings.TrimSpace(c.Email.SMTPPort) == "" || strings.TrimSpace(c.Email.From) == "" {
+			errs = append(errs, errors.New("SMTP email provider requires host, port and from address"))
+		}
+	case NotificationProviderFile:
+		if strings.TrimSpace(c.Email.FilePath) == "" {
+			errs = append(errs, errors.New("file email provider requires a file path"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported email provider %q", c.Email.Provider))
+	}
+
+	switch c.SMS.Provider {
+	case NotificationProviderDisabled, NotificationProviderMemory:
+	case NotificationProviderHTTP:
+		if strings.TrimSpace(c.SMS.URL) == "" {
+			errs = append(errs, errors.New("HTTP SMS provider requires a URL"))
+		}
+	case NotificationProviderFile:
+		if strings.TrimSpace(c.SMS.FilePath) == "" {
+			errs = append(errs, errors.New("file SMS provider requires a file path"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported SMS provider %q", c.SMS.Provider))
+	}
+
+	return errs
+}
+
+// validate verifica el almacenamiento, el despachador y los sinks del outbox
+func (c GRPCServerConfig) validate(httpPort string) []error {
+	var errs []error
+
+	if strings.TrimSpace(c.Port) == "" || c.Port == httpPort {
+		errs = append(errs, fmt.Errorf("gRPC server port must be set and differ from the HTTP port, got %q", c.Port))
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("gRPC server TLS requires both a certificate and a key"))
+	}
+
+	if c.ClientCAFile != "" && c.TLSCertFile == "" {
+		errs = append(errs, errors.New("gRPC server client certificates require TLS"))
+	}
+
+	if len(c.Tokens) == 0 && c.ClientCAFile == "" {
+		errs = append(errs, errors.New("gRPC server requires service tokens or client certificates"))
+	}
+
+	for service, token := range c.Tokens {
+		if strings.TrimSpace(service) == "" || strings.TrimSpace(token) == "" {
+			errs = append(errs, errors.New("gRPC server service tokens must have a service name and a token"))
+			break
+		}
+	}
+
+	return errs
+}
+
+func (c BookingExtensionConfig) validate() []error {
+	var errs []error
+
+	switch c.Store {
+	case BookingExtensionStoreMemory:
+	case BookingExtensionStoreFile:
+		if strings.TrimSpace(c.FilePath) == "" {
+			errs = append(errs, errors.New("file booking extension store requires a file path"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported booking extension store %q", c.Store))
+	}
+
+	if c.ReconcileInterval <= 0 || c.PendingTTL <= 0 {
+		errs = append(errs, fmt.Errorf("invalid booking extension settings: reconcileInterval=%s, pendingTtl=%s", c.ReconcileInterval, c.PendingTTL))
+	}
+
+	return errs
+}
+
+func (c OutboxConfig) validate() []error {
+	var errs []error
+
+	switch c.Store {
+	case OutboxStoreMemory:
+	case OutboxStoreFile:
+		if strings.TrimSpace(c.FilePath) == "" {
+			errs = append(errs, errors.New("file outbox store requires a file path"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported outbox store %q", c.Store))
+	}
+
+	if c.PollInterval <= 0 || c.BatchSize <= 0 || c.InitialBackoff <= 0 || c.MaxBackoff < c.InitialBackoff {
+		errs = append(errs, fmt.Errorf("invalid outbox dispatcher settings: pollInterval=%s, batchSize=%d, initialBackoff=%s, maxBackoff=%s",
+			c.PollInterval, c.BatchSize, c.InitialBackoff, c.MaxBackoff))
+	}
+
+	if len(c.Sinks) == 0 {
+		errs = append(errs, errors.New("outbox requires at least one sink"))
+	}
+
+	for _, sink := range c.Sinks {
+		switch sink {
+		case OutboxSinkLog, OutboxSinkMemory:
+		case OutboxSinkWebhook:
+			if strings.TrimSpace(c.WebhookURL) == "" {
+				errs = append(errs, errors.New("webhook outbox sink requires a URL"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("unsupported outbox sink %q", sink))
+		}
+	}
+
+	return errs
+}
+
+// validate verifica que los tenants sean únicos, que no compartan hostnames, serviceName ni
+// instalaciones y que solo habiliten pasarelas registradas
+func (c TenancyConfig) validate(gatewayNames map[string]bool) []error {
+	var errs []error
+
+	ids := make(map[string]bool, len(c.Tenants))
+	hosts := make(map[string]string)
+	serviceNames := make(map[string]string)
+	installations := make(map[int]string)
+
+	for _, tenant := range c.Tenants {
+		id := strings.ToLower(strings.TrimSpace(tenant.ID))
+		switch {
+		case id == "":
+			errs = append(errs, errors.New("tenant id must not be empty"))
+			continue
+		case ids[id]:
+			errs = append(errs, fmt.Errorf("duplicated tenant %q", tenant.ID))
+			continue
+		}
+		ids[id] = true
+
+		for _, host := range tenant.Hosts {
+			host = strings.ToLower(strings.TrimSpace(host))
+			if owner, ok := hosts[host]; ok {
+				errs = append(errs, fmt.Errorf("host %q of tenant %q is already used by tenant %q", host, tenant.ID, owner))
+			} else if host == "" {
+				errs = append(errs, fmt.Errorf("hosts of tenant %q must not be empty", tenant.ID))
+			}
+			hosts[host] = tenant.ID
+		}
+
+		for _, serviceName := range tenant.ServiceNames {
+			serviceName = strings.ToLower(strings.TrimSpace(serviceName))
+			if owner, ok := serviceNames[serviceName]; ok {
+				errs = append(errs, fmt.Errorf("serviceName %q of tenant %q is already used by tenant %q", serviceName, tenant.ID, owner))
+			} else if serviceName == "" {
+				errs = append(errs, fmt.Errorf("serviceNames of tenant %q must not be empty", tenant.ID))
+			}
+			serviceNames[serviceName] = tenant.ID
+		}
+
+		for _, installationID := range tenant.InstallationIDs {
+			if owner, ok := installations[installationID]; ok {
+				errs = append(errs, fmt.Errorf("installation %d of tenant %q is already used by tenant %q", installationID, tenant.ID, owner))
+			} else if installationID <= 0 {
+				errs = append(errs, fmt.Errorf("installations of tenant %q must be positive, got %d", tenant.ID, installationID))
+			}
+			installations[installationID] = tenant.ID
+		}
+
+		for _, gateway := range tenant.Gateways {
+			if !gatewayNames[strings.ToLower(strings.TrimSpace(gateway))] {
+				errs = append(errs, fmt.Errorf("tenant %q enables unknown payment gateway %q", tenant.ID, gateway))
+			}
+		}
+
+		if tenant.Backend.PaymentServiceTimeout < 0 || tenant.Backend.BookingServiceTimeout < 0 {
+			errs = append(errs, fmt.Errorf("backend timeouts for tenant %q must not be negative", tenant.ID))
+		}
+
+		if tenant.RateLimit.RequestsPerSecond < 0 || (tenant.RateLimit.RequestsPerSecond > 0 && tenant.RateLimit.Burst <= 0) {
+			errs = append(errs, fmt.Errorf("invalid rate limit for tenant %q: requestsPerSecond=%v, burst=%d", tenant.ID, tenant.RateLimit.RequestsPerSecond, tenant.RateLimit.Burst))
+		}
+
+		for _, color := range []string{tenant.Branding.PrimaryColor, tenant.Branding.SecondaryColor} {
+			if color != "" && !hexColorPattern.MatchString(color) {
+				errs = append(errs, fmt.Errorf("invalid branding color %q for tenant %q, expected #RRGGBB", color, tenant.ID))
+			}
+		}
+	}
+
+	if c.DefaultTenant != "" && !ids[strings.ToLower(strings.TrimSpace(c.DefaultTenant))] {
+		errs = append(errs, fmt.Errorf("default tenant %q is not defined", c.DefaultTenant))
+	}
+
+	return errs
+}
+
+// hexColorPattern valida los colores de la marca de un tenant
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// FeatureEnabled indica si un feature flag está activo
+func (c Config) FeatureEnabled(name string) bool {
+	return c.Features[name]
+}
+
+// validTrustedProxy indica si el valor es una dirección IP o una red CIDR
+func validTrustedProxy(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		_, err := netip.ParsePrefix(value)
+		return err == nil
+	}
+	_, err := netip.ParseAddr(value)
+	return err == nil
+}
+
+// OriginAllowed indica si un origen está permitido por la configuración de CORS
+func (c Config) OriginAllowed(origin string) bool {
+	for _, allowed := range c.CORS.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
 	}
+	return false
 }
@@ -1,28 +1,103 @@
 package config
 
 import (
+	appPorts "bff-graphql-payment/internal/application/ports"
 	"bff-graphql-payment/internal/application/service"
+	"bff-graphql-payment/internal/domain/model"
 	"bff-graphql-payment/internal/domain/ports"
+	domainService "bff-graphql-payment/internal/domain/service"
 	"bff-graphql-payment/internal/infrastructure/inbound/graphql/resolver"
+	grpcServer "bff-graphql-payment/internal/infrastructure/inbound/grpc/server"
+	"bff-graphql-payment/internal/infrastructure/inbound/rest"
+	"bff-graphql-payment/internal/infrastructure/inbound/webhook"
+	"bff-graphql-payment/internal/infrastructure/logging"
+	"bff-graphql-payment/internal/infrastructure/outbound/audit"
+	"bff-graphql-payment/internal/infrastructure/outbound/bookingextension"
+	"bff-graphql-payment/internal/infrastructure/outbound/cache"
+	"bff-graphql-payment/internal/infrastructure/outbound/coalesce"
+	"bff-graphql-payment/internal/infrastructure/outbound/events"
+	"bff-graphql-payment/internal/infrastructure/outbound/featureflag"
 	"bff-graphql-payment/internal/infrastructure/outbound/grpc/client"
+	"bff-graphql-payment/internal/infrastructure/outbound/metrics"
+	"bff-graphql-payment/internal/infrastructure/outbound/notification"
+	"bff-graphql-payment/internal/infrastructure/outbound/outbox"
+	"bff-graphql-payment/internal/infrastructure/outbound/qrcode"
+	"bff-graphql-payment/internal/infrastructure/outbound/routing"
+	"errors"
 	"fmt"
+	"maps"
+	"sync/atomic"
+	"time"
 )
 
 // Container contiene todas las dependencias de la aplicación
 type Container struct {
+	// Configuración vigente; se reemplaza atómicamente en cada recarga
+	config atomic.Pointer[Config]
+
 	// Servicios
-	PaymentInfraService ports.PaymentInfraService
+	PaymentInfraService       ports.PaymentInfraService
+	PaymentWebhookService     ports.PaymentWebhookService
+	PurchaseOrderWatchService ports.PurchaseOrderWatchService
+	BookingExtensionService   *service.BookingExtensionService
+	BookingCodeQRService      ports.BookingCodeQRService
+	// ReceiptNotificationService es nil cuando las notificaciones están desactivadas
+	ReceiptNotificationService *service.ReceiptNotificationService
+	// OutboxService es nil cuando el outbox está desactivado
+	OutboxService          *service.OutboxService
+	LockerOpenAuditService ports.LockerOpenAuditService
+	TenantService          ports.TenantService
+	FeatureFlagService     *service.FeatureFlagService
 
 	// Resolvers
 	GraphQLResolver *resolver.Resolver
 
+	// Handlers HTTP
+	PaymentWebhookHandler *webhook.PaymentWebhookHandler
+	RESTHandler           *rest.Handler
+
+	// Servicio gRPC para servicios internos
+	BffGRPCServer *grpcServer.BffGRPCServer
+
+	// Dominio
+	PaymentGatewayRegistry *domainService.PaymentGatewayRegistry
+	OpenGuard              *domainService.OpenGuard
+	TenantRegistry         *domainService.TenantRegistry
+
 	// Infraestructura
 	PaymentServiceClient *client.PaymentServiceGRPCClient
+	PaymentEventBroker   *events.PaymentEventBroker
+	PaymentInfraCache    *cache.LRUCache[*model.PaymentInfra]
+	Metrics              *metrics.Recorder
+	// OutboxFile es el archivo del outbox; nil con el almacenamiento en memoria
+	OutboxFile *outbox.FileStore
+	// AuditFile es el archivo de auditoría de aperturas; nil cuando la auditoría está desactivada
+	AuditFile *audit.FileLog
+	// TenantClients son los clientes gRPC de los tenants con backend propio, por ID de tenant
+	TenantClients map[string]*client.PaymentServiceGRPCClient
+	// FeatureFlagFile es el proveedor del archivo de feature flags; nil si no se configuró
+	FeatureFlagFile *featureflag.FileProvider
+	// ConfigFeatureFlags entrega los flags booleanos de la configuración recargable
+	ConfigFeatureFlags *featureflag.StaticProvider
 }
 
 // NewContainer crea un nuevo contenedor de inyección de dependencias
 func NewContainer(config Config) (*Container, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
 	container := &Container{}
+	container.config.Store(&config)
+	container.Metrics = metrics.NewRecorder()
+
+	// Inicializar feature flags: los del archivo tienen prioridad sobre los de la configuración
+	if err := container.initFeatureFlags(config); err != nil {
+		return nil, err
+	}
+	if container.FeatureFlagFile != nil {
+		container.FeatureFlagFile.Start()
+	}
 
 	// Inicializar cliente gRPC (mock o real según configuración)
 	paymentClient, err := client.NewPaymentServiceGRPCClient(
@@ -30,17 +105,476 @@ func NewContainer(config Config) (*Container, error) {
 		config.GRPC.BookingServiceAddress,
 		config.GRPC.PaymentServiceTimeout,
 		config.General.UseMock,
+		config.General.Environment != "production",
+		container.FeatureFlagService,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment service client: %w", err)
 	}
+	paymentClient.SetTimeouts(config.GRPC.PaymentServiceTimeout, config.GRPC.BookingServiceTimeout)
 	container.PaymentServiceClient = paymentClient
 
+	// Inicializar registro de tenants y los clientes de los tenants con backend propio
+	container.TenantRegistry = domainService.NewTenantRegistry(config.Tenancy.DefaultTenant, toTenants(config.Tenancy.Tenants)...)
+	if err := container.initTenantClients(config); err != nil {
+		return nil, err
+	}
+
+	// Enviar cada llamada al backend de su tenant
+	var paymentRepository appPorts.PaymentInfraRepository = paymentClient
+	if len(container.TenantClients) > 0 {
+		tenantRepositories := make(map[string]appPorts.PaymentInfraRepository, len(container.TenantClients))
+		for tenantID, tenantClient := range container.TenantClients {
+			tenantRepositories[tenantID] = tenantClient
+		}
+		paymentRepository = routing.NewPaymentInfraRepository(paymentClient, tenantRepositories, container.TenantRegistry)
+	}
+
+	// Deduplicar lecturas concurrentes idénticas al backend
+	if config.Cache.Coalescing {
+		paymentRepository = coalesce.NewPaymentInfraRepository(paymentRepository, container.Metrics)
+	}
+
+	// Inicializar broker de eventos de pago
+	container.PaymentEventBroker = events.NewPaymentEventBroker()
+
+	// Inicializar registro de pasarelas de pago
+	container.PaymentGatewayRegistry = domainService.NewPaymentGatewayRegistry(toPaymentGateways(config.Gateways)...)
+
+	// Inicializar caché de infraestructura de pagos
+	var paymentInfraCache appPorts.PaymentInfraCache
+	if policy := config.Cache.PaymentInfra; policy.Enabled {
+		container.PaymentInfraCache, err = cache.NewLRUCache[*model.PaymentInfra](policy.MaxEntries, policy.TTL, policy.StaleTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create payment infra cache: %w", err)
+		}
+		paymentInfraCache = container.PaymentInfraCache
+	}
+
+	// Inicializar reglas de apertura de lockers
+	container.OpenGuard = domainService.NewOpenGuard(toOpenGuardRules(config.OpenGuard.Default), toOpenGuardRulesByService(config.OpenGuard.Services))
+
 	// Inicializar servicios de aplicación
-	container.PaymentInfraService = service.NewPaymentInfraService(paymentClient)
+	// Inicializar la verificación de QR firmados (configuración ya validada)
+	qrKeys, err := config.QR.VerificationKeys()
+	if err != nil {
+		return nil, fmt.Errorf("invalid QR keys: %w", err)
+	}
+	qrVerifier, err := service.NewQRVerifier(model.QRVerificationMode(config.QR.Mode), qrKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid QR verification settings: %w", err)
+	}
+
+	// Inicializar comprobantes por correo y SMS
+	var receiptNotifier appPorts.ReceiptNotifier
+	if config.Notification.Enabled {
+		container.ReceiptNotificationService, err = newReceiptNotificationService(config.Notification, paymentRepository, container.TenantRegistry, container.PaymentEventBroker, container.Metrics)
+		if err != nil {
+			return nil, err
+		}
+		container.ReceiptNotificationService.Start()
+		receiptNotifier = container.ReceiptNotificationService
+	}
+
+	// Inicializar outbox de eventos de dominio
+	var eventRecorder appPorts.DomainEventRecorder
+	if config.Outbox.Enabled {
+		if err := container.initOutbox(config.Outbox); err != nil {
+			return nil, err
+		}
+		container.OutboxService.Start()
+		eventRecorder = container.OutboxService
+	}
+
+	// Inicializar auditoría de aperturas de locker
+	var auditLog appPorts.LockerOpenAuditLog
+	if config.Audit.Enabled {
+		container.AuditFile, err = audit.OpenFileLog(config.Audit.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open locker open audit: %w", err)
+		}
+		auditLog = container.AuditFile
+	}
+	container.LockerOpenAuditService = service.NewLockerOpenAuditService(auditLog, config.Audit.AdminToken)
+
+	// Los casos de uso se ejecutan en el tenant de la solicitud y sus mensajes se localizan al
+	// idioma de cada solicitud
+	container.PaymentInfraService = service.NewLocalizedPaymentInfraService(service.NewTenantScopedPaymentInfraService(
+		service.NewPaymentInfraService(paymentRepository, container.PaymentGatewayRegistry, container.OpenGuard, qrVerifier, paymentInfraCache, receiptNotifier, eventRecorder, auditLog, container.FeatureFlagService, config.Admin.Token, container.Metrics),
+		container.TenantRegistry,
+	))
+	container.TenantService = service.NewTenantService(container.TenantRegistry)
+	container.PaymentWebhookService = service.NewPaymentWebhookService(paymentRepository, container.PaymentEventBroker)
+	container.PurchaseOrderWatchService = service.NewLocalizedPurchaseOrderWatchService(service.NewPurchaseOrderWatchService(
+		paymentRepository,
+		container.PaymentEventBroker,
+		config.Subscription.MinPollInterval,
+		config.Subscription.MaxPollInterval,
+	))
+
+	if err := container.initBookingExtension(config.BookingExtension, paymentRepository); err != nil {
+		return nil, err
+	}
+
+	// Inicializar QR de códigos de apertura (codificador en Go puro)
+	container.BookingCodeQRService = service.NewBookingCodeQRService(qrcode.NewRenderer())
+
+	// Inicializar webhooks de pasarelas de pago
+	container.PaymentWebhookHandler = webhook.NewPaymentWebhookHandler(
+		container.PaymentWebhookService,
+		config.Webhook.Secrets,
+		webhook.NewWebpayGateway(),
+		webhook.NewMercadoPagoGateway(config.Webhook.MercadoPagoAPIURL, config.Webhook.MercadoPagoAccessToken),
+	)
+
+	// Inicializar API REST para el firmware de los kioscos
+	container.RESTHandler = rest.NewHandler(container.PaymentInfraService, container.BookingCodeQRService)
+
+	// Inicializar servicio gRPC para servicios internos
+	container.BffGRPCServer = grpcServer.NewBffGRPCServer(container.PaymentInfraService)
 
 	// Inicializar resolvers GraphQL
-	container.GraphQLResolver = resolver.NewResolver(container.PaymentInfraService)
+	bookingExtensionService := service.NewLocalizedBookingExtensionService(service.NewTenantScopedBookingExtensionService(container.BookingExtensionService, container.TenantRegistry))
+	container.GraphQLResolver = resolver.NewResolver(container.PaymentInfraService, container.PurchaseOrderWatchService, bookingExtensionService, container.BookingCodeQRService, container.LockerOpenAuditService, container.TenantService, container.FeatureFlagService)
 
 	return container, nil
 }
+
+// Config devuelve una copia de la configuración vigente
+func (c *Container) Config() Config {
+	return *c.config.Load()
+}
+
+// ApplyConfig valida y aplica en caliente los ajustes seguros de una nueva configuración.
+// Si la configuración es inválida se conserva la anterior.
+func (c *Container) ApplyConfig(next Config) error {
+	// Validar todo antes de aplicar cualquier ajuste, para no dejar una recarga rechazada a medias
+	if err := next.Validate(); err != nil {
+		return err
+	}
+
+	// Los backends propios de los tenants se conectan al iniciar
+	if !maps.Equal(tenantBackendAddresses(c.Config().Tenancy), tenantBackendAddresses(next.Tenancy)) {
+		return errors.New("tenant backend addresses changed; restart the server to apply them")
+	}
+
+	// Validate ya verificó el nivel, por lo que SetLevel no falla
+	if err := logging.SetLevel(next.Log.Level); err != nil {
+		return err
+	}
+
+	if c.PaymentServiceClient != nil {
+		c.PaymentServiceClient.SetTimeouts(next.GRPC.PaymentServiceTimeout, next.GRPC.BookingServiceTimeout)
+	}
+
+	for _, tenant := range next.Tenancy.Tenants {
+		if tenantClient, ok := c.TenantClients[tenant.ID]; ok {
+			tenantClient.SetTimeouts(tenantTimeouts(next.GRPC, tenant.Backend))
+		}
+	}
+
+	if c.ConfigFeatureFlags != nil {
+		c.ConfigFeatureFlags.Replace(toFeatureFlags(next.Features)...)
+	}
+
+	if c.TenantRegistry != nil {
+		c.TenantRegistry.Replace(next.Tenancy.DefaultTenant, toTenants(next.Tenancy.Tenants)...)
+	}
+
+	if c.PaymentGatewayRegistry != nil {
+		c.PaymentGatewayRegistry.Replace(toPaymentGateways(next.Gateways)...)
+	}
+
+	if c.OpenGuard != nil {
+		c.OpenGuard.Replace(toOpenGuardRules(next.OpenGuard.Default), toOpenGuardRulesByService(next.OpenGuard.Services))
+	}
+
+	c.config.Store(&next)
+	return nil
+}
+
+// RateLimitFor devuelve el límite de solicitudes por cliente del tenant. Las solicitudes sin
+// tenant usan el del tenant por defecto; los tenants sin límite propio, el global.
+func (c *Container) RateLimitFor(tenantID string) RateLimitConfig {
+	cfg := c.Config()
+
+	tenant, ok := c.TenantRegistry.ByID(tenantID)
+	if !ok {
+		tenant, ok = c.TenantRegistry.Default()
+	}
+
+	if ok && tenant.RateLimit.RequestsPerSecond > 0 {
+		return RateLimitConfig{RequestsPerSecond: tenant.RateLimit.RequestsPerSecond, Burst: tenant.RateLimit.Burst}
+	}
+	return cfg.RateLimit
+}
+
+// initFeatureFlags crea los proveedores de feature flags y el servicio que los evalúa
+func (c *Container) initFeatureFlags(cfg Config) error {
+	c.ConfigFeatureFlags = featureflag.NewStaticProvider(toFeatureFlags(cfg.Features)...)
+
+	var providers []appPorts.FeatureFlagProvider
+	if cfg.FeatureFlags.FilePath != "" {
+		fileProvider, err := featureflag.OpenFileProvider(cfg.FeatureFlags.FilePath, cfg.FeatureFlags.PollInterval, c.Metrics)
+		if err != nil {
+			return fmt.Errorf("failed to load feature flags: %w", err)
+		}
+		c.FeatureFlagFile = fileProvider
+		providers = append(providers, fileProvider)
+	}
+	providers = append(providers, c.ConfigFeatureFlags)
+
+	c.FeatureFlagService = service.NewFeatureFlagService(providers...)
+	return nil
+}
+
+// initTenantClients crea un cliente gRPC por cada tenant con backend propio
+func (c *Container) initTenantClients(cfg Config) error {
+	c.TenantClients = make(map[string]*client.PaymentServiceGRPCClient)
+
+	for _, tenant := range cfg.Tenancy.Tenants {
+		if tenant.Backend == (TenantBackendConfig{}) {
+			continue
+		}
+
+		paymentAddress, bookingAddress := tenant.Backend.PaymentServiceAddress, tenant.Backend.BookingServiceAddress
+		if paymentAddress == "" {
+			paymentAddress = cfg.GRPC.PaymentServiceAddress
+		}
+		if bookingAddress == "" {
+			bookingAddress = cfg.GRPC.BookingServiceAddress
+		}
+
+		paymentTimeout, bookingTimeout := tenantTimeouts(cfg.GRPC, tenant.Backend)
+		tenantClient, err := client.NewPaymentServiceGRPCClient(paymentAddress, bookingAddress, paymentTimeout, cfg.General.UseMock, cfg.General.Environment != "production", c.FeatureFlagService)
+		if err != nil {
+			for _, created := range c.TenantClients {
+				created.Close()
+			}
+			return fmt.Errorf("failed to create payment service client for tenant %q: %w", tenant.ID, err)
+		}
+		tenantClient.SetTimeouts(paymentTimeout, bookingTimeout)
+		c.TenantClients[tenant.ID] = tenantClient
+	}
+
+	return nil
+}
+
+// tenantTimeouts devuelve los timeouts del backend de un tenant; sin valor propio se usan los globales
+func tenantTimeouts(global GRPCConfig, backend TenantBackendConfig) (time.Duration, time.Duration) {
+	paymentTimeout, bookingTimeout := global.PaymentServiceTimeout, global.BookingServiceTimeout
+	if backend.PaymentServiceTimeout > 0 {
+		paymentTimeout = backend.PaymentServiceTimeout
+	}
+	if backend.BookingServiceTimeout > 0 {
+		bookingTimeout = backend.BookingServiceTimeout
+	}
+	return paymentTimeout, bookingTimeout
+}
+
+// tenantBackendAddresses devuelve las direcciones de los tenants con backend propio, por ID de tenant
+func tenantBackendAddresses(cfg TenancyConfig) map[string]string {
+	addresses := make(map[string]string)
+	for _, tenant := range cfg.Tenants {
+		if tenant.Backend != (TenantBackendConfig{}) {
+			addresses[tenant.ID] = tenant.Backend.PaymentServiceAddress + "|" + tenant.Backend.BookingServiceAddress
+		}
+	}
+	return addresses
+}
+
+// newReceiptNotificationService crea el servicio de comprobantes con los proveedores configurados
+func newReceiptNotificationService(cfg NotificationConfig, repo appPorts.PaymentInfraRepository, tenants *domainService.TenantRegistry, subscriber appPorts.PaymentEventSubscriber, recorder appPorts.MetricsRecorder) (*service.ReceiptNotificationService, error) {
+	var senders []appPorts.NotificationSender
+
+	switch cfg.Email.Provider {
+	case NotificationProviderSMTP:
+		senders = append(senders, notification.NewSMTPSender(notification.SMTPConfig{
+			Host:     cfg.Email.SMTPHost,
+			Port:     cfg.Email.SMTPPort,
+			Username: cfg.Email.SMTPUsername,
+			Password: cfg.Email.SMTPPassword,
+			From:     cfg.Email.From,
+		}))
+	case NotificationProviderFile:
+		senders = append(senders, notification.NewFileSender(model.NotificationChannelEmail, cfg.Email.FilePath))
+	case NotificationProviderMemory:
+		senders = append(senders, notification.NewMemorySender(model.NotificationChannelEmail))
+	}
+
+	switch cfg.SMS.Provider {
+	case NotificationProviderHTTP:
+		senders = append(senders, notification.NewHTTPSMSSender(notification.SMSConfig{
+			URL:   cfg.SMS.URL,
+			Token: cfg.SMS.Token,
+			From:  cfg.SMS.From,
+		}))
+	case NotificationProviderFile:
+		senders = append(senders, notification.NewFileSender(model.NotificationChannelSMS, cfg.SMS.FilePath))
+	case NotificationProviderMemory:
+		senders = append(senders, notification.NewMemorySender(model.NotificationChannelSMS))
+	}
+
+	locale, _ := model.ParseLocale(cfg.Locale)
+	receiptService, err := service.NewReceiptNotificationService(repo, tenants, subscriber, senders, service.ReceiptNotificationOptions{
+		Locale:         locale,
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: cfg.InitialBackoff,
+		SendTimeout:    cfg.SendTimeout,
+		QueueSize:      cfg.QueueSize,
+		Workers:        cfg.Workers,
+	}, recorder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create receipt notification service: %w", err)
+	}
+	return receiptService, nil
+}
+
+// initOutbox abre el almacenamiento del outbox y crea su despachador con los sinks configurados
+func (c *Container) initOutbox(cfg OutboxConfig) error {
+	var store appPorts.OutboxStore = outbox.NewMemoryStore()
+	if cfg.Store == OutboxStoreFile {
+		fileStore, err := outbox.OpenFileStore(cfg.FilePath)
+		if err != nil {
+			return fmt.Errorf("failed to open outbox: %w", err)
+		}
+		c.OutboxFile = fileStore
+		store = fileStore
+	}
+
+	sinks := make([]appPorts.EventSink, 0, len(cfg.Sinks))
+	for _, sink := range cfg.Sinks {
+		switch sink {
+		case OutboxSinkLog:
+			sinks = append(sinks, outbox.NewLogSink())
+		case OutboxSinkWebhook:
+			sinks = append(sinks, outbox.NewWebhookSink(cfg.WebhookURL, cfg.WebhookSecret))
+		case OutboxSinkMemory:
+			sinks = append(sinks, outbox.NewMemorySink())
+		}
+	}
+
+	outboxService, err := service.NewOutboxService(store, sinks, service.OutboxOptions{
+		PollInterval:   cfg.PollInterval,
+		BatchSize:      cfg.BatchSize,
+		InitialBackoff: cfg.InitialBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+	}, c.Metrics)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	c.OutboxService = outboxService
+	return nil
+}
+
+// initBookingExtension abre el almacenamiento de las extensiones pendientes e inicia el servicio
+// que las aplica al confirmarse su pago
+func (c *Container) initBookingExtension(cfg BookingExtensionConfig, repo appPorts.PaymentInfraRepository) error {
+	var store appPorts.PendingBookingExtensionStore = bookingextension.NewMemoryStore()
+	if cfg.Store == BookingExtensionStoreFile {
+		fileStore, err := bookingextension.OpenFileStore(cfg.FilePath)
+		if err != nil {
+			return fmt.Errorf("failed to open booking extension store: %w", err)
+		}
+		store = fileStore
+	}
+
+	extensionService, err := service.NewBookingExtensionService(repo, c.PaymentEventBroker, c.PaymentGatewayRegistry, c.FeatureFlagService, store, service.BookingExtensionOptions{
+		ReconcileInterval: cfg.ReconcileInterval,
+		PendingTTL:        cfg.PendingTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create booking extension service: %w", err)
+	}
+
+	if err := extensionService.Start(); err != nil {
+		return err
+	}
+
+	c.BookingExtensionService = extensionService
+	return nil
+}
+
+// toPaymentGateways convierte la configuración de pasarelas a entidades de dominio
+func toPaymentGateways(configs []PaymentGatewayConfig) []model.PaymentGateway {
+	gateways := make([]model.PaymentGateway, 0, len(configs))
+	for _, cfg := range configs {
+		gateways = append(gateways, &model.StaticPaymentGateway{
+			GatewayName:     cfg.Name,
+			Display:         cfg.DisplayName,
+			Logo:            cfg.LogoURL,
+			Currencies:      cfg.SupportedCurrencies,
+			Min:             cfg.MinAmount,
+			Max:             cfg.MaxAmount,
+			Enabled:         cfg.Enabled,
+			EnabledRackIDs:  cfg.EnabledRackIDs,
+			DisabledRackIDs: cfg.DisabledRackIDs,
+		})
+	}
+	return gateways
+}
+
+// toOpenGuardRules convierte la configuración de reglas de apertura a dominio
+func toOpenGuardRules(cfg OpenGuardRuleConfig) model.OpenGuardRules {
+	return model.OpenGuardRules{
+		Enabled:            cfg.Enabled,
+		EnforceWindow:      cfg.EnforceWindow,
+		EnforceOpenings:    cfg.EnforceOpenings,
+		EarlyOpenTolerance: cfg.EarlyOpenTolerance,
+	}
+}
+
+// toOpenGuardRulesByService convierte las reglas de apertura por serviceName a dominio
+func toOpenGuardRulesByService(configs map[string]OpenGuardRuleConfig) map[string]model.OpenGuardRules {
+	rules := make(map[string]model.OpenGuardRules, len(configs))
+	for serviceName, cfg := range configs {
+		rules[serviceName] = toOpenGuardRules(cfg)
+	}
+	return rules
+}
+
+// toTenants convierte la configuración de tenants a entidades de dominio
+func toTenants(configs []TenantConfig) []model.Tenant {
+	tenants := make([]model.Tenant, 0, len(configs))
+	for _, cfg := range configs {
+		tenants = append(tenants, model.Tenant{
+			ID:              cfg.ID,
+			DisplayName:     cfg.DisplayName,
+			Hosts:           cfg.Hosts,
+			ServiceNames:    cfg.ServiceNames,
+			InstallationIDs: cfg.InstallationIDs,
+			Backend: model.TenantBackend{
+				PaymentServiceAddress: cfg.Backend.PaymentServiceAddress,
+				PaymentServiceTimeout: cfg.Backend.PaymentServiceTimeout,
+				BookingServiceAddress: cfg.Backend.BookingServiceAddress,
+				BookingServiceTimeout: cfg.Backend.BookingServiceTimeout,
+			},
+			Gateways: cfg.Gateways,
+			Features: cfg.Features,
+			Branding: model.TenantBranding{
+				LogoURL:        cfg.Branding.LogoURL,
+				PrimaryColor:   cfg.Branding.PrimaryColor,
+				SecondaryColor: cfg.Branding.SecondaryColor,
+			},
+			RateLimit: model.TenantRateLimit{
+				RequestsPerSecond: cfg.RateLimit.RequestsPerSecond,
+				Burst:             cfg.RateLimit.Burst,
+			},
+		})
+	}
+	return tenants
+}
+
+// toFeatureFlags convierte los features booleanos de la configuración a feature flags de dominio
+func toFeatureFlags(features map[string]bool) []model.FeatureFlag {
+	flags := make([]model.FeatureFlag, 0, len(features))
+	for name, enabled := range features {
+		flags = append(flags, model.FeatureFlag{
+			Name:       name,
+			Enabled:    enabled,
+			Percentage: 100,
+		})
+	}
+	return flags
+}
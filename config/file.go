@@ -0,0 +1,263 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// fileConfig representa el archivo JSON de configuración recargable.
+// Solo contiene los ajustes que es seguro cambiar sin reiniciar el proceso;
+// los campos ausentes conservan el valor actual.
+type fileConfig struct {
+	LogLevel  *string                `json:"logLevel"`
+	GRPC      *fileGRPCConfig        `json:"grpc"`
+	RateLimit *fileRateLimit         `json:"rateLimit"`
+	Features  map[string]bool        `json:"features"`
+	CORS      *fileCORSConfig        `json:"cors"`
+	Gateways  []PaymentGatewayConfig `json:"gateways"`
+	OpenGuard *fileOpenGuardConfig   `json:"openGuard"`
+	Tenancy   *fileTenancyConfig     `json:"tenancy"`
+}
+
+type fileGRPCConfig struct {
+	PaymentServiceTimeout *string `json:"paymentServiceTimeout"`
+	BookingServiceTimeout *string `json:"bookingServiceTimeout"`
+}
+
+type fileRateLimit struct {
+	RequestsPerSecond *float64 `json:"requestsPerSecond"`
+	Burst             *int     `json:"burst"`
+}
+
+type fileCORSConfig struct {
+	AllowedOrigins []string `json:"allowedOrigins"`
+}
+
+type fileOpenGuardConfig struct {
+	Default  *fileOpenGuardRule           `json:"default"`
+	Services map[string]fileOpenGuardRule `json:"services"`
+}
+
+type fileOpenGuardRule struct {
+	Enabled            bool   `json:"enabled"`
+	EnforceWindow      bool   `json:"enforceWindow"`
+	EnforceOpenings    bool   `json:"enforceOpenings"`
+	EarlyOpenTolerance string `json:"earlyOpenTolerance"`
+}
+
+type fileTenancyConfig struct {
+	DefaultTenant *string            `json:"defaultTenant"`
+	Tenants       []fileTenantConfig `json:"tenants"`
+}
+
+type fileTenantConfig struct {
+	ID              string               `json:"id"`
+	DisplayName     string               `json:"displayName"`
+	Hosts           []string             `json:"hosts"`
+	ServiceNames    []string             `json:"serviceNames"`
+	InstallationIDs []int                `json:"installationIds"`
+	Backend         fileTenantBackend    `json:"backend"`
+	Gateways        []string             `json:"gateways"`
+	Features        map[string]bool      `json:"features"`
+	Branding        TenantBrandingConfig `json:"branding"`
+	RateLimit       fileRateLimit        `json:"rateLimit"`
+}
+
+type fileTenantBackend struct {
+	PaymentServiceAddress string `json:"paymentServiceAddress"`
+	PaymentServiceTimeout string `json:"paymentServiceTimeout"`
+	BookingServiceAddress string `json:"bookingServiceAddress"`
+	BookingServiceTimeout string `json:"bookingServiceTimeout"`
+}
+
+// LoadFile lee el archivo de configuración y lo aplica sobre base.
+// Devuelve una copia nueva; base no se modifica.
+func LoadFile(path string, base Config) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return base, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file fileConfig
+	if err := json.Unmarshal(data, &file); err != nil {
+		return base, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	return file.applyTo(base)
+}
+
+// applyTo superpone los valores presentes en el archivo sobre una copia de cfg
+func (f fileConfig) applyTo(cfg Config) (Config, error) {
+	cfg = cfg.clone()
+
+	if f.LogLevel != nil {
+		cfg.Log.Level = *f.LogLevel
+	}
+
+	if f.GRPC != nil {
+		if f.GRPC.PaymentServiceTimeout != nil {
+			timeout, err := time.ParseDuration(*f.GRPC.PaymentServiceTimeout)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid grpc.paymentServiceTimeout: %w", err)
+			}
+			cfg.GRPC.PaymentServiceTimeout = timeout
+		}
+		if f.GRPC.BookingServiceTimeout != nil {
+			timeout, err := time.ParseDuration(*f.GRPC.BookingServiceTimeout)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid grpc.bookingServiceTimeout: %w", err)
+			}
+			cfg.GRPC.BookingServiceTimeout = timeout
+		}
+	}
+
+	if f.RateLimit != nil {
+		if f.RateLimit.RequestsPerSecond != nil {
+			cfg.RateLimit.RequestsPerSecond = *f.RateLimit.RequestsPerSecond
+		}
+		if f.RateLimit.Burst != nil {
+			cfg.RateLimit.Burst = *f.RateLimit.Burst
+		}
+	}
+
+	if f.Features != nil {
+		cfg.Features = make(map[string]bool, len(f.Features))
+		for name, enabled := range f.Features {
+			cfg.Features[name] = enabled
+		}
+	}
+
+	if f.CORS != nil && f.CORS.AllowedOrigins != nil {
+		cfg.CORS.AllowedOrigins = append([]string(nil), f.CORS.AllowedOrigins...)
+	}
+
+	if f.Gateways != nil {
+		cfg.Gateways = append([]PaymentGatewayConfig(nil), f.Gateways...)
+	}
+
+	if f.OpenGuard != nil {
+		if f.OpenGuard.Default != nil {
+			rules, err := f.OpenGuard.Default.toConfig()
+			if err != nil {
+				return cfg, fmt.Errorf("invalid openGuard.default: %w", err)
+			}
+			cfg.OpenGuard.Default = rules
+		}
+		if f.OpenGuard.Services != nil {
+			cfg.OpenGuard.Services = make(map[string]OpenGuardRuleConfig, len(f.OpenGuard.Services))
+			for serviceName, rule := range f.OpenGuard.Services {
+				rules, err := rule.toConfig()
+				if err != nil {
+					return cfg, fmt.Errorf("invalid openGuard.services.%s: %w", serviceName, err)
+				}
+				cfg.OpenGuard.Services[serviceName] = rules
+			}
+		}
+	}
+
+	if f.Tenancy != nil {
+		if f.Tenancy.DefaultTenant != nil {
+			cfg.Tenancy.DefaultTenant = *f.Tenancy.DefaultTenant
+		}
+		if f.Tenancy.Tenants != nil {
+			cfg.Tenancy.Tenants = make([]TenantConfig, 0, len(f.Tenancy.Tenants))
+			for _, tenant := range f.Tenancy.Tenants {
+				tenantConfig, err := tenant.toConfig()
+				if err != nil {
+					return cfg, fmt.Errorf("invalid tenancy.tenants.%s: %w", tenant.ID, err)
+				}
+				cfg.Tenancy.Tenants = append(cfg.Tenancy.Tenants, tenantConfig)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// toConfig convierte un tenant del archivo a configuración
+func (t fileTenantConfig) toConfig() (TenantConfig, error) {
+	tenant := TenantConfig{
+		ID:              t.ID,
+		DisplayName:     t.DisplayName,
+		Hosts:           t.Hosts,
+		ServiceNames:    t.ServiceNames,
+		InstallationIDs: t.InstallationIDs,
+		Backend: TenantBackendConfig{
+			PaymentServiceAddress: t.Backend.PaymentServiceAddress,
+			BookingServiceAddress: t.Backend.BookingServiceAddress,
+		},
+		Gateways: t.Gateways,
+		Features: t.Features,
+		Branding: t.Branding,
+	}
+
+	if t.Backend.PaymentServiceTimeout != "" {
+		timeout, err := time.ParseDuration(t.Backend.PaymentServiceTimeout)
+		if err != nil {
+			return tenant, fmt.Errorf("invalid backend.paymentServiceTimeout: %w", err)
+		}
+		tenant.Backend.PaymentServiceTimeout = timeout
+	}
+
+	if t.Backend.BookingServiceTimeout != "" {
+		timeout, err := time.ParseDuration(t.Backend.BookingServiceTimeout)
+		if err != nil {
+			return tenant, fmt.Errorf("invalid backend.bookingServiceTimeout: %w", err)
+		}
+		tenant.Backend.BookingServiceTimeout = timeout
+	}
+
+	if t.RateLimit.RequestsPerSecond != nil {
+		tenant.RateLimit.RequestsPerSecond = *t.RateLimit.RequestsPerSecond
+	}
+	if t.RateLimit.Burst != nil {
+		tenant.RateLimit.Burst = *t.RateLimit.Burst
+	}
+
+	return tenant, nil
+}
+
+// toConfig convierte una regla de apertura del archivo a configuración
+func (r fileOpenGuardRule) toConfig() (OpenGuardRuleConfig, error) {
+	rules := OpenGuardRuleConfig{
+		Enabled:         r.Enabled,
+		EnforceWindow:   r.EnforceWindow,
+		EnforceOpenings: r.EnforceOpenings,
+	}
+
+	if r.EarlyOpenTolerance != "" {
+		tolerance, err := time.ParseDuration(r.EarlyOpenTolerance)
+		if err != nil {
+			return rules, fmt.Errorf("invalid earlyOpenTolerance: %w", err)
+		}
+		rules.EarlyOpenTolerance = tolerance
+	}
+
+	return rules, nil
+}
+
+// clone devuelve una copia profunda de la configuración
+func (c Config) clone() Config {
+	clone := c
+
+	clone.Features = make(map[string]bool, len(c.Features))
+	for name, enabled := range c.Features {
+		clone.Features[name] = enabled
+	}
+
+	clone.Server.TrustedProxies = append([]string(nil), c.Server.TrustedProxies...)
+	clone.CORS.AllowedOrigins = append([]string(nil), c.CORS.AllowedOrigins...)
+	clone.Gateways = append([]PaymentGatewayConfig(nil), c.Gateways...)
+	clone.QR.Keys = append([]QRKeyConfig(nil), c.QR.Keys...)
+	clone.Outbox.Sinks = append([]string(nil), c.Outbox.Sinks...)
+	clone.Tenancy.Tenants = append([]TenantConfig(nil), c.Tenancy.Tenants...)
+
+	clone.OpenGuard.Services = make(map[string]OpenGuardRuleConfig, len(c.OpenGuard.Services))
+	for serviceName, rules := range c.OpenGuard.Services {
+		clone.OpenGuard.Services[serviceName] = rules
+	}
+
+	return clone
+}
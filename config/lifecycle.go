@@ -18,6 +18,38 @@ func (l *Lifecycle) Shutdown() error {
 		return nil
 	}
 
+	// Dejar de observar el archivo de feature flags
+	if l.container.FeatureFlagFile != nil {
+		l.container.FeatureFlagFile.Stop()
+	}
+
+	// Dejar de aplicar extensiones de reserva
+	if l.container.BookingExtensionService != nil {
+		l.container.BookingExtensionService.Stop()
+	}
+
+	// Enviar los comprobantes pendientes
+	if l.container.ReceiptNotificationService != nil {
+		l.container.ReceiptNotificationService.Stop()
+	}
+
+	// Detener el despachador del outbox; los eventos no entregados quedan en el archivo
+	if l.container.OutboxService != nil {
+		l.container.OutboxService.Stop()
+	}
+
+	if l.container.OutboxFile != nil {
+		if err := l.container.OutboxFile.Close(); err != nil {
+			return err
+		}
+	}
+
+	if l.container.AuditFile != nil {
+		if err := l.container.AuditFile.Close(); err != nil {
+			return err
+		}
+	}
+
 	// Cerrar cliente gRPC de pagos
 	if l.container.PaymentServiceClient != nil {
 		if err := l.container.PaymentServiceClient.Close(); err != nil {
@@ -25,6 +57,12 @@ func (l *Lifecycle) Shutdown() error {
 		}
 	}
 
+	for _, tenantClient := range l.container.TenantClients {
+		if err := tenantClient.Close(); err != nil {
+			return err
+		}
+	}
+
 	// Aquí se pueden agregar más recursos a cerrar en el futuro
 	// Por ejemplo: conexiones a base de datos, caches, etc.
 
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Reloader recarga la configuración desde archivo al recibir SIGHUP o al detectar cambios
+type Reloader struct {
+	path      string
+	interval  time.Duration
+	container *Container
+
+	mu          sync.Mutex
+	lastModTime time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewReloader crea un nuevo recargador de configuración
+func NewReloader(path string, interval time.Duration, container *Container) *Reloader {
+	return &Reloader{
+		path:      path,
+		interval:  interval,
+		container: container,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start comienza a escuchar SIGHUP y a observar el archivo de configuración
+func (r *Reloader) Start() {
+	if info, err := os.Stat(r.path); err == nil {
+		r.lastModTime = info.ModTime()
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var ticker *time.Ticker
+	var tick <-chan time.Time
+	if r.path != "" && r.interval > 0 {
+		ticker = time.NewTicker(r.interval)
+		tick = ticker.C
+	}
+
+	go func() {
+		defer close(r.done)
+		defer signal.Stop(hup)
+		if ticker != nil {
+			defer ticker.Stop()
+		}
+
+		for {
+			select {
+			case <-hup:
+				slog.Info("🔄 SIGHUP received, reloading configuration")
+				r.Reload("sighup")
+			case <-tick:
+				if r.fileChanged() {
+					slog.Info("🔄 Config file changed, reloading configuration", "file", r.path)
+					r.Reload("file_watch")
+				}
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el recargador
+func (r *Reloader) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+	<-r.done
+}
+
+// Reload lee el archivo y aplica la nueva configuración; si es inválida se conserva la anterior
+func (r *Reloader) Reload(trigger string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	metrics := r.container.Metrics
+	metrics.IncCounter("config_reload_attempts_total")
+
+	if r.path == "" {
+		slog.Warn("⚠️ Config reload skipped: CONFIG_FILE is not set", "trigger", trigger)
+		metrics.IncCounter("config_reload_skipped_total")
+		return nil
+	}
+
+	current := r.container.Config()
+	next, err := LoadFile(r.path, current)
+	if err == nil {
+		err = r.container.ApplyConfig(next)
+	}
+	if err != nil {
+		slog.Error("❌ Config reload rejected, keeping previous configuration", "trigger", trigger, "error", err)
+		metrics.IncCounter("config_reload_failures_total")
+		return err
+	}
+
+	slog.Info("✅ Config reloaded", "trigger", trigger, "logLevel", next.Log.Level,
+		"paymentTimeout", next.GRPC.PaymentServiceTimeout, "bookingTimeout", next.GRPC.BookingServiceTimeout,
+		"rateLimit", next.RateLimit.RequestsPerSecond, "burst", next.RateLimit.Burst, "corsOrigins", next.CORS.AllowedOrigins,
+		"features", next.Features, "gateways", len(next.Gateways), "tenants", len(next.Tenancy.Tenants))
+	metrics.IncCounter("config_reload_success_total")
+	return nil
+}
+
+// fileChanged indica si el archivo de configuración se modificó desde la última revisión
+func (r *Reloader) fileChanged() bool {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if info.ModTime().Equal(r.lastModTime) {
+		return false
+	}
+	r.lastModTime = info.ModTime()
+	return true
+}
@@ -8,9 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -38,8 +40,13 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AvailablePaymentGroup() AvailablePaymentGroupResolver
+	GenerateBookingResponse() GenerateBookingResponseResolver
 	Mutation() MutationResolver
+	PaymentBookingTime() PaymentBookingTimeResolver
+	PaymentRack() PaymentRackResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -48,6 +55,7 @@ type DirectiveRoot struct {
 type ComplexityRoot struct {
 	AvailableLockersByRackIDAndBookingTimeResponse struct {
 		AvailableGroups func(childComplexity int) int
+		Detail          func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Status          func(childComplexity int) int
 		TraceID         func(childComplexity int) int
@@ -60,9 +68,11 @@ type ComplexityRoot struct {
 		ImageURL    func(childComplexity int) int
 		Name        func(childComplexity int) int
 		Price       func(childComplexity int) int
+		Quote       func(childComplexity int, couponCode *string) int
 	}
 
 	BookingStatusData struct {
+		CanOpen                func(childComplexity int) int
 		ConfigurationBookingID func(childComplexity int) int
 		CreatedAt              func(childComplexity int) int
 		CurrentCode            func(childComplexity int) int
@@ -74,26 +84,65 @@ type ComplexityRoot struct {
 		InstallationName       func(childComplexity int) int
 		NumberLocker           func(childComplexity int) int
 		Openings               func(childComplexity int) int
+		RemainingTime          func(childComplexity int) int
 		ServiceName            func(childComplexity int) int
+		State                  func(childComplexity int) int
 		UpdatedAt              func(childComplexity int) int
 	}
 
+	CancelBookingResponse struct {
+		BookingID          func(childComplexity int) int
+		CancellationStatus func(childComplexity int) int
+		Detail             func(childComplexity int) int
+		Message            func(childComplexity int) int
+		PurchaseOrder      func(childComplexity int) int
+		Refund             func(childComplexity int) int
+		Status             func(childComplexity int) int
+		TraceID            func(childComplexity int) int
+		TransactionID      func(childComplexity int) int
+	}
+
 	CheckBookingStatusResponse struct {
 		Booking       func(childComplexity int) int
+		Detail        func(childComplexity int) int
 		Message       func(childComplexity int) int
 		Status        func(childComplexity int) int
+		TraceID       func(childComplexity int) int
 		TransactionID func(childComplexity int) int
 	}
 
 	ExecuteOpenResponse struct {
+		Detail        func(childComplexity int) int
 		Message       func(childComplexity int) int
 		OpenStatus    func(childComplexity int) int
 		Status        func(childComplexity int) int
+		TraceID       func(childComplexity int) int
 		TransactionID func(childComplexity int) int
 	}
 
+	ExtendBookingResponse struct {
+		BookingTime          func(childComplexity int) int
+		CurrentFinishBooking func(childComplexity int) int
+		Detail               func(childComplexity int) int
+		Message              func(childComplexity int) int
+		NewFinishBooking     func(childComplexity int) int
+		Price                func(childComplexity int) int
+		PurchaseOrder        func(childComplexity int) int
+		Status               func(childComplexity int) int
+		TraceID              func(childComplexity int) int
+		TransactionID        func(childComplexity int) int
+		URL                  func(childComplexity int) int
+	}
+
+	FeatureFlag struct {
+		Enabled func(childComplexity int) int
+		Name    func(childComplexity int) int
+	}
+
 	GenerateBookingResponse struct {
 		Code          func(childComplexity int) int
+		CodeQR        func(childComplexity int, format *model.QRImageFormat, size *int, serviceName *string, expiresAt *time.Time) int
+		Detail        func(childComplexity int) int
 		Message       func(childComplexity int) int
 		Status        func(childComplexity int) int
 		TraceID       func(childComplexity int) int
@@ -101,6 +150,7 @@ type ComplexityRoot struct {
 	}
 
 	GeneratePurchaseOrderResponse struct {
+		Detail        func(childComplexity int) int
 		Message       func(childComplexity int) int
 		Status        func(childComplexity int) int
 		TraceID       func(childComplexity int) int
@@ -108,21 +158,59 @@ type ComplexityRoot struct {
 		URL           func(childComplexity int) int
 	}
 
+	LockerOpenAuditPage struct {
+		HasNextPage func(childComplexity int) int
+		Limit       func(childComplexity int) int
+		Offset      func(childComplexity int) int
+		Records     func(childComplexity int) int
+		TotalCount  func(childComplexity int) int
+	}
+
+	LockerOpenAuditRecord struct {
+		ClientIP              func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		MaskedCode            func(childComplexity int) int
+		OpenStatuses          func(childComplexity int) int
+		Outcome               func(childComplexity int) int
+		Principal             func(childComplexity int) int
+		Reason                func(childComplexity int) int
+		ServiceName           func(childComplexity int) int
+		Timestamp             func(childComplexity int) int
+		TraceID               func(childComplexity int) int
+		UpstreamTransactionID func(childComplexity int) int
+		UserAgent             func(childComplexity int) int
+	}
+
 	Mutation struct {
-		ExecuteOpen           func(childComplexity int, input model.ExecuteOpenInput) int
-		GenerateBooking       func(childComplexity int, input model.GenerateBookingInput) int
-		GeneratePurchaseOrder func(childComplexity int, input model.GeneratePurchaseOrderInput) int
+		CancelBooking               func(childComplexity int, input model.CancelBookingInput) int
+		ExecuteOpen                 func(childComplexity int, input model.ExecuteOpenInput) int
+		ExtendBooking               func(childComplexity int, input model.ExtendBookingInput) int
+		GenerateBooking             func(childComplexity int, input model.GenerateBookingInput) int
+		GeneratePurchaseOrder       func(childComplexity int, input model.GeneratePurchaseOrderInput) int
+		InvalidatePaymentInfraCache func(childComplexity int, qrValue *string) int
 	}
 
 	PaymentBookingTime struct {
 		Amount          func(childComplexity int) int
+		AvailableGroups func(childComplexity int) int
 		ID              func(childComplexity int) int
 		Name            func(childComplexity int) int
 		UnitMeasurement func(childComplexity int) int
 	}
 
+	PaymentGateway struct {
+		DisplayName         func(childComplexity int) int
+		LogoURL             func(childComplexity int) int
+		MaxAmount           func(childComplexity int) int
+		MinAmount           func(childComplexity int) int
+		Name                func(childComplexity int) int
+		SupportedCurrencies func(childComplexity int) int
+	}
+
 	PaymentInfraResponse struct {
 		BookingTimes  func(childComplexity int) int
+		Branding      func(childComplexity int) int
+		Detail        func(childComplexity int) int
 		Installation  func(childComplexity int) int
 		Message       func(childComplexity int) int
 		PaymentRack   func(childComplexity int) int
@@ -140,10 +228,21 @@ type ComplexityRoot struct {
 		Region   func(childComplexity int) int
 	}
 
+	PaymentQuote struct {
+		CouponApplied      func(childComplexity int) int
+		CouponCode         func(childComplexity int) int
+		DiscountAmount     func(childComplexity int) int
+		DiscountPercentage func(childComplexity int) int
+		GroupID            func(childComplexity int) int
+		Price              func(childComplexity int) int
+		Total              func(childComplexity int) int
+	}
+
 	PaymentRack struct {
-		Address     func(childComplexity int) int
-		Description func(childComplexity int) int
-		ID          func(childComplexity int) int
+		Address         func(childComplexity int) int
+		AvailableGroups func(childComplexity int, bookingTimeID int) int
+		Description     func(childComplexity int) int
+		ID              func(childComplexity int) int
 	}
 
 	PurchaseOrderData struct {
@@ -164,6 +263,7 @@ type ComplexityRoot struct {
 	}
 
 	PurchaseOrderResponse struct {
+		Detail            func(childComplexity int) int
 		Message           func(childComplexity int) int
 		PurchaseOrderData func(childComplexity int) int
 		Status            func(childComplexity int) int
@@ -171,15 +271,60 @@ type ComplexityRoot struct {
 		TransactionID     func(childComplexity int) int
 	}
 
+	QrImage struct {
+		Data     func(childComplexity int) int
+		DataURI  func(childComplexity int) int
+		Format   func(childComplexity int) int
+		MimeType func(childComplexity int) int
+		Payload  func(childComplexity int) int
+		Size     func(childComplexity int) int
+	}
+
 	Query struct {
+		AvailablePaymentGateways                  func(childComplexity int, rackID int) int
 		CheckBookingStatus                        func(childComplexity int, input model.CheckBookingStatusInput) int
+		FeatureFlags                              func(childComplexity int, rackID *int, installationID *int, key *string) int
 		GetAvailableLockersByRackIDAndBookingTime func(childComplexity int, input model.GetAvailableLockersByRackIDAndBookingTimeInput) int
 		GetPaymentInfraByQRValue                  func(childComplexity int, input model.GetPaymentInfraByQRValueInput) int
 		GetPurchaseOrderByPo                      func(childComplexity int, input model.GetPurchaseOrderByPoInput) int
+		LockerOpenAudit                           func(childComplexity int, serviceName string, from time.Time, to time.Time, outcome *model.LockerOpenOutcome, limit *int, offset *int) int
+		Tenant                                    func(childComplexity int, serviceName *string) int
 		ValidateDiscountCoupon                    func(childComplexity int, input model.ValidateDiscountCouponInput) int
 	}
 
+	Refund struct {
+		Amount   func(childComplexity int) int
+		Detail   func(childComplexity int) int
+		Message  func(childComplexity int) int
+		RefundID func(childComplexity int) int
+		Status   func(childComplexity int) int
+	}
+
+	Subscription struct {
+		PurchaseOrderStatus func(childComplexity int, purchaseOrder string, traceID *string, locale *string) int
+	}
+
+	Tenant struct {
+		Branding    func(childComplexity int) int
+		DisplayName func(childComplexity int) int
+		Features    func(childComplexity int) int
+		Gateways    func(childComplexity int) int
+		ID          func(childComplexity int) int
+	}
+
+	TenantBranding struct {
+		LogoURL        func(childComplexity int) int
+		PrimaryColor   func(childComplexity int) int
+		SecondaryColor func(childComplexity int) int
+	}
+
+	TenantFeature struct {
+		Enabled func(childComplexity int) int
+		Name    func(childComplexity int) int
+	}
+
 	ValidateDiscountCouponResponse struct {
+		Detail             func(childComplexity int) int
 		DiscountPercentage func(childComplexity int) int
 		Message            func(childComplexity int) int
 		Status             func(childComplexity int) int
@@ -188,10 +333,25 @@ type ComplexityRoot struct {
 	}
 }
 
+type AvailablePaymentGroupResolver interface {
+	Quote(ctx context.Context, obj *model.AvailablePaymentGroup, couponCode *string) (*model.PaymentQuote, error)
+}
+type GenerateBookingResponseResolver interface {
+	CodeQR(ctx context.Context, obj *model.GenerateBookingResponse, format *model.QRImageFormat, size *int, serviceName *string, expiresAt *time.Time) (*model.QRImage, error)
+}
 type MutationResolver interface {
 	GeneratePurchaseOrder(ctx context.Context, input model.GeneratePurchaseOrderInput) (*model.GeneratePurchaseOrderResponse, error)
 	GenerateBooking(ctx context.Context, input model.GenerateBookingInput) (*model.GenerateBookingResponse, error)
 	ExecuteOpen(ctx context.Context, input model.ExecuteOpenInput) (*model.ExecuteOpenResponse, error)
+	CancelBooking(ctx context.Context, input model.CancelBookingInput) (*model.CancelBookingResponse, error)
+	ExtendBooking(ctx context.Context, input model.ExtendBookingInput) (*model.ExtendBookingResponse, error)
+	InvalidatePaymentInfraCache(ctx context.Context, qrValue *string) (int, error)
+}
+type PaymentBookingTimeResolver interface {
+	AvailableGroups(ctx context.Context, obj *model.PaymentBookingTime) ([]*model.AvailablePaymentGroup, error)
+}
+type PaymentRackResolver interface {
+	AvailableGroups(ctx context.Context, obj *model.PaymentRack, bookingTimeID int) ([]*model.AvailablePaymentGroup, error)
 }
 type QueryResolver interface {
 	GetPaymentInfraByQRValue(ctx context.Context, input model.GetPaymentInfraByQRValueInput) (*model.PaymentInfraResponse, error)
@@ -199,6 +359,13 @@ type QueryResolver interface {
 	ValidateDiscountCoupon(ctx context.Context, input model.ValidateDiscountCouponInput) (*model.ValidateDiscountCouponResponse, error)
 	GetPurchaseOrderByPo(ctx context.Context, input model.GetPurchaseOrderByPoInput) (*model.PurchaseOrderResponse, error)
 	CheckBookingStatus(ctx context.Context, input model.CheckBookingStatusInput) (*model.CheckBookingStatusResponse, error)
+	AvailablePaymentGateways(ctx context.Context, rackID int) ([]*model.PaymentGateway, error)
+	LockerOpenAudit(ctx context.Context, serviceName string, from time.Time, to time.Time, outcome *model.LockerOpenOutcome, limit *int, offset *int) (*model.LockerOpenAuditPage, error)
+	Tenant(ctx context.Context, serviceName *string) (*model.Tenant, error)
+	FeatureFlags(ctx context.Context, rackID *int, installationID *int, key *string) ([]*model.FeatureFlag, error)
+}
+type SubscriptionResolver interface {
+	PurchaseOrderStatus(ctx context.Context, purchaseOrder string, traceID *string, locale *string) (<-chan *model.PurchaseOrderData, error)
 }
 
 type executableSchema struct {
@@ -227,6 +394,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AvailableLockersByRackIDAndBookingTimeResponse.AvailableGroups(childComplexity), true
 
+	case "AvailableLockersByRackIDAndBookingTimeResponse.detail":
+		if e.complexity.AvailableLockersByRackIDAndBookingTimeResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.AvailableLockersByRackIDAndBookingTimeResponse.Detail(childComplexity), true
+
 	case "AvailableLockersByRackIDAndBookingTimeResponse.message":
 		if e.complexity.AvailableLockersByRackIDAndBookingTimeResponse.Message == nil {
 			break
@@ -290,6 +464,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AvailablePaymentGroup.Price(childComplexity), true
 
+	case "AvailablePaymentGroup.quote":
+		if e.complexity.AvailablePaymentGroup.Quote == nil {
+			break
+		}
+
+		args, err := ec.field_AvailablePaymentGroup_quote_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AvailablePaymentGroup.Quote(childComplexity, args["couponCode"].(*string)), true
+
+	case "BookingStatusData.canOpen":
+		if e.complexity.BookingStatusData.CanOpen == nil {
+			break
+		}
+
+		return e.complexity.BookingStatusData.CanOpen(childComplexity), true
+
 	case "BookingStatusData.configurationBookingId":
 		if e.complexity.BookingStatusData.ConfigurationBookingID == nil {
 			break
@@ -367,6 +560,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.BookingStatusData.Openings(childComplexity), true
 
+	case "BookingStatusData.remainingTime":
+		if e.complexity.BookingStatusData.RemainingTime == nil {
+			break
+		}
+
+		return e.complexity.BookingStatusData.RemainingTime(childComplexity), true
+
 	case "BookingStatusData.serviceName":
 		if e.complexity.BookingStatusData.ServiceName == nil {
 			break
@@ -374,6 +574,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.BookingStatusData.ServiceName(childComplexity), true
 
+	case "BookingStatusData.state":
+		if e.complexity.BookingStatusData.State == nil {
+			break
+		}
+
+		return e.complexity.BookingStatusData.State(childComplexity), true
+
 	case "BookingStatusData.updatedAt":
 		if e.complexity.BookingStatusData.UpdatedAt == nil {
 			break
@@ -381,6 +588,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.BookingStatusData.UpdatedAt(childComplexity), true
 
+	case "CancelBookingResponse.bookingId":
+		if e.complexity.CancelBookingResponse.BookingID == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.BookingID(childComplexity), true
+
+	case "CancelBookingResponse.cancellationStatus":
+		if e.complexity.CancelBookingResponse.CancellationStatus == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.CancellationStatus(childComplexity), true
+
+	case "CancelBookingResponse.detail":
+		if e.complexity.CancelBookingResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.Detail(childComplexity), true
+
+	case "CancelBookingResponse.message":
+		if e.complexity.CancelBookingResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.Message(childComplexity), true
+
+	case "CancelBookingResponse.purchaseOrder":
+		if e.complexity.CancelBookingResponse.PurchaseOrder == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.PurchaseOrder(childComplexity), true
+
+	case "CancelBookingResponse.refund":
+		if e.complexity.CancelBookingResponse.Refund == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.Refund(childComplexity), true
+
+	case "CancelBookingResponse.status":
+		if e.complexity.CancelBookingResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.Status(childComplexity), true
+
+	case "CancelBookingResponse.traceId":
+		if e.complexity.CancelBookingResponse.TraceID == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.TraceID(childComplexity), true
+
+	case "CancelBookingResponse.transactionId":
+		if e.complexity.CancelBookingResponse.TransactionID == nil {
+			break
+		}
+
+		return e.complexity.CancelBookingResponse.TransactionID(childComplexity), true
+
 	case "CheckBookingStatusResponse.booking":
 		if e.complexity.CheckBookingStatusResponse.Booking == nil {
 			break
@@ -388,6 +658,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CheckBookingStatusResponse.Booking(childComplexity), true
 
+	case "CheckBookingStatusResponse.detail":
+		if e.complexity.CheckBookingStatusResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.CheckBookingStatusResponse.Detail(childComplexity), true
+
 	case "CheckBookingStatusResponse.message":
 		if e.complexity.CheckBookingStatusResponse.Message == nil {
 			break
@@ -402,6 +679,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CheckBookingStatusResponse.Status(childComplexity), true
 
+	case "CheckBookingStatusResponse.traceId":
+		if e.complexity.CheckBookingStatusResponse.TraceID == nil {
+			break
+		}
+
+		return e.complexity.CheckBookingStatusResponse.TraceID(childComplexity), true
+
 	case "CheckBookingStatusResponse.transactionId":
 		if e.complexity.CheckBookingStatusResponse.TransactionID == nil {
 			break
@@ -409,6 +693,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CheckBookingStatusResponse.TransactionID(childComplexity), true
 
+	case "ExecuteOpenResponse.detail":
+		if e.complexity.ExecuteOpenResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.ExecuteOpenResponse.Detail(childComplexity), true
+
 	case "ExecuteOpenResponse.message":
 		if e.complexity.ExecuteOpenResponse.Message == nil {
 			break
@@ -430,6 +721,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ExecuteOpenResponse.Status(childComplexity), true
 
+	case "ExecuteOpenResponse.traceId":
+		if e.complexity.ExecuteOpenResponse.TraceID == nil {
+			break
+		}
+
+		return e.complexity.ExecuteOpenResponse.TraceID(childComplexity), true
+
 	case "ExecuteOpenResponse.transactionId":
 		if e.complexity.ExecuteOpenResponse.TransactionID == nil {
 			break
@@ -437,6 +735,97 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ExecuteOpenResponse.TransactionID(childComplexity), true
 
+	case "ExtendBookingResponse.bookingTime":
+		if e.complexity.ExtendBookingResponse.BookingTime == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.BookingTime(childComplexity), true
+
+	case "ExtendBookingResponse.currentFinishBooking":
+		if e.complexity.ExtendBookingResponse.CurrentFinishBooking == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.CurrentFinishBooking(childComplexity), true
+
+	case "ExtendBookingResponse.detail":
+		if e.complexity.ExtendBookingResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.Detail(childComplexity), true
+
+	case "ExtendBookingResponse.message":
+		if e.complexity.ExtendBookingResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.Message(childComplexity), true
+
+	case "ExtendBookingResponse.newFinishBooking":
+		if e.complexity.ExtendBookingResponse.NewFinishBooking == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.NewFinishBooking(childComplexity), true
+
+	case "ExtendBookingResponse.price":
+		if e.complexity.ExtendBookingResponse.Price == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.Price(childComplexity), true
+
+	case "ExtendBookingResponse.purchaseOrder":
+		if e.complexity.ExtendBookingResponse.PurchaseOrder == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.PurchaseOrder(childComplexity), true
+
+	case "ExtendBookingResponse.status":
+		if e.complexity.ExtendBookingResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.Status(childComplexity), true
+
+	case "ExtendBookingResponse.traceId":
+		if e.complexity.ExtendBookingResponse.TraceID == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.TraceID(childComplexity), true
+
+	case "ExtendBookingResponse.transactionId":
+		if e.complexity.ExtendBookingResponse.TransactionID == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.TransactionID(childComplexity), true
+
+	case "ExtendBookingResponse.url":
+		if e.complexity.ExtendBookingResponse.URL == nil {
+			break
+		}
+
+		return e.complexity.ExtendBookingResponse.URL(childComplexity), true
+
+	case "FeatureFlag.enabled":
+		if e.complexity.FeatureFlag.Enabled == nil {
+			break
+		}
+
+		return e.complexity.FeatureFlag.Enabled(childComplexity), true
+
+	case "FeatureFlag.name":
+		if e.complexity.FeatureFlag.Name == nil {
+			break
+		}
+
+		return e.complexity.FeatureFlag.Name(childComplexity), true
+
 	case "GenerateBookingResponse.code":
 		if e.complexity.GenerateBookingResponse.Code == nil {
 			break
@@ -444,6 +833,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GenerateBookingResponse.Code(childComplexity), true
 
+	case "GenerateBookingResponse.codeQr":
+		if e.complexity.GenerateBookingResponse.CodeQR == nil {
+			break
+		}
+
+		args, err := ec.field_GenerateBookingResponse_codeQr_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.GenerateBookingResponse.CodeQR(childComplexity, args["format"].(*model.QRImageFormat), args["size"].(*int), args["serviceName"].(*string), args["expiresAt"].(*time.Time)), true
+
+	case "GenerateBookingResponse.detail":
+		if e.complexity.GenerateBookingResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.GenerateBookingResponse.Detail(childComplexity), true
+
 	case "GenerateBookingResponse.message":
 		if e.complexity.GenerateBookingResponse.Message == nil {
 			break
@@ -472,6 +880,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GenerateBookingResponse.TransactionID(childComplexity), true
 
+	case "GeneratePurchaseOrderResponse.detail":
+		if e.complexity.GeneratePurchaseOrderResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.GeneratePurchaseOrderResponse.Detail(childComplexity), true
+
 	case "GeneratePurchaseOrderResponse.message":
 		if e.complexity.GeneratePurchaseOrderResponse.Message == nil {
 			break
@@ -507,146 +922,364 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GeneratePurchaseOrderResponse.URL(childComplexity), true
 
-	case "Mutation.executeOpen":
-		if e.complexity.Mutation.ExecuteOpen == nil {
+	case "LockerOpenAuditPage.hasNextPage":
+		if e.complexity.LockerOpenAuditPage.HasNextPage == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_executeOpen_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.ExecuteOpen(childComplexity, args["input"].(model.ExecuteOpenInput)), true
+		return e.complexity.LockerOpenAuditPage.HasNextPage(childComplexity), true
 
-	case "Mutation.generateBooking":
-		if e.complexity.Mutation.GenerateBooking == nil {
+	case "LockerOpenAuditPage.limit":
+		if e.complexity.LockerOpenAuditPage.Limit == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_generateBooking_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.GenerateBooking(childComplexity, args["input"].(model.GenerateBookingInput)), true
+		return e.complexity.LockerOpenAuditPage.Limit(childComplexity), true
 
-	case "Mutation.generatePurchaseOrder":
-		if e.complexity.Mutation.GeneratePurchaseOrder == nil {
+	case "LockerOpenAuditPage.offset":
+		if e.complexity.LockerOpenAuditPage.Offset == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_generatePurchaseOrder_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.GeneratePurchaseOrder(childComplexity, args["input"].(model.GeneratePurchaseOrderInput)), true
+		return e.complexity.LockerOpenAuditPage.Offset(childComplexity), true
 
-	case "PaymentBookingTime.amount":
-		if e.complexity.PaymentBookingTime.Amount == nil {
+	case "LockerOpenAuditPage.records":
+		if e.complexity.LockerOpenAuditPage.Records == nil {
 			break
 		}
 
-		return e.complexity.PaymentBookingTime.Amount(childComplexity), true
+		return e.complexity.LockerOpenAuditPage.Records(childComplexity), true
 
-	case "PaymentBookingTime.id":
-		if e.complexity.PaymentBookingTime.ID == nil {
+	case "LockerOpenAuditPage.totalCount":
+		if e.complexity.LockerOpenAuditPage.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.PaymentBookingTime.ID(childComplexity), true
+		return e.complexity.LockerOpenAuditPage.TotalCount(childComplexity), true
 
-	case "PaymentBookingTime.name":
-		if e.complexity.PaymentBookingTime.Name == nil {
+	case "LockerOpenAuditRecord.clientIp":
+		if e.complexity.LockerOpenAuditRecord.ClientIP == nil {
 			break
 		}
 
-		return e.complexity.PaymentBookingTime.Name(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.ClientIP(childComplexity), true
 
-	case "PaymentBookingTime.unitMeasurement":
-		if e.complexity.PaymentBookingTime.UnitMeasurement == nil {
+	case "LockerOpenAuditRecord.id":
+		if e.complexity.LockerOpenAuditRecord.ID == nil {
 			break
 		}
 
-		return e.complexity.PaymentBookingTime.UnitMeasurement(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.ID(childComplexity), true
 
-	case "PaymentInfraResponse.bookingTimes":
-		if e.complexity.PaymentInfraResponse.BookingTimes == nil {
+	case "LockerOpenAuditRecord.maskedCode":
+		if e.complexity.LockerOpenAuditRecord.MaskedCode == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.BookingTimes(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.MaskedCode(childComplexity), true
 
-	case "PaymentInfraResponse.installation":
-		if e.complexity.PaymentInfraResponse.Installation == nil {
+	case "LockerOpenAuditRecord.openStatuses":
+		if e.complexity.LockerOpenAuditRecord.OpenStatuses == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.Installation(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.OpenStatuses(childComplexity), true
 
-	case "PaymentInfraResponse.message":
-		if e.complexity.PaymentInfraResponse.Message == nil {
+	case "LockerOpenAuditRecord.outcome":
+		if e.complexity.LockerOpenAuditRecord.Outcome == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.Message(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.Outcome(childComplexity), true
 
-	case "PaymentInfraResponse.paymentRack":
-		if e.complexity.PaymentInfraResponse.PaymentRack == nil {
+	case "LockerOpenAuditRecord.principal":
+		if e.complexity.LockerOpenAuditRecord.Principal == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.PaymentRack(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.Principal(childComplexity), true
 
-	case "PaymentInfraResponse.status":
-		if e.complexity.PaymentInfraResponse.Status == nil {
+	case "LockerOpenAuditRecord.reason":
+		if e.complexity.LockerOpenAuditRecord.Reason == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.Status(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.Reason(childComplexity), true
 
-	case "PaymentInfraResponse.traceId":
-		if e.complexity.PaymentInfraResponse.TraceID == nil {
+	case "LockerOpenAuditRecord.serviceName":
+		if e.complexity.LockerOpenAuditRecord.ServiceName == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.TraceID(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.ServiceName(childComplexity), true
 
-	case "PaymentInfraResponse.transactionId":
-		if e.complexity.PaymentInfraResponse.TransactionID == nil {
+	case "LockerOpenAuditRecord.timestamp":
+		if e.complexity.LockerOpenAuditRecord.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.PaymentInfraResponse.TransactionID(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.Timestamp(childComplexity), true
 
-	case "PaymentInstallation.address":
-		if e.complexity.PaymentInstallation.Address == nil {
+	case "LockerOpenAuditRecord.traceId":
+		if e.complexity.LockerOpenAuditRecord.TraceID == nil {
 			break
 		}
 
-		return e.complexity.PaymentInstallation.Address(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.TraceID(childComplexity), true
 
-	case "PaymentInstallation.city":
-		if e.complexity.PaymentInstallation.City == nil {
+	case "LockerOpenAuditRecord.upstreamTransactionId":
+		if e.complexity.LockerOpenAuditRecord.UpstreamTransactionID == nil {
 			break
 		}
 
-		return e.complexity.PaymentInstallation.City(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.UpstreamTransactionID(childComplexity), true
 
-	case "PaymentInstallation.id":
-		if e.complexity.PaymentInstallation.ID == nil {
+	case "LockerOpenAuditRecord.userAgent":
+		if e.complexity.LockerOpenAuditRecord.UserAgent == nil {
 			break
 		}
 
-		return e.complexity.PaymentInstallation.ID(childComplexity), true
+		return e.complexity.LockerOpenAuditRecord.UserAgent(childComplexity), true
 
-	case "PaymentInstallation.imageUrl":
-		if e.complexity.PaymentInstallation.ImageURL == nil {
+	case "Mutation.cancelBooking":
+		if e.complexity.Mutation.CancelBooking == nil {
 			break
 		}
 
-		return e.complexity.PaymentInstallation.ImageURL(childComplexity), true
+		args, err := ec.field_Mutation_cancelBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelBooking(childComplexity, args["input"].(model.CancelBookingInput)), true
+
+	case "Mutation.executeOpen":
+		if e.complexity.Mutation.ExecuteOpen == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_executeOpen_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExecuteOpen(childComplexity, args["input"].(model.ExecuteOpenInput)), true
+
+	case "Mutation.extendBooking":
+		if e.complexity.Mutation.ExtendBooking == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_extendBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExtendBooking(childComplexity, args["input"].(model.ExtendBookingInput)), true
+
+	case "Mutation.generateBooking":
+		if e.complexity.Mutation.GenerateBooking == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_generateBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.GenerateBooking(childComplexity, args["input"].(model.GenerateBookingInput)), true
+
+	case "Mutation.generatePurchaseOrder":
+		if e.complexity.Mutation.GeneratePurchaseOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_generatePurchaseOrder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.GeneratePurchaseOrder(childComplexity, args["input"].(model.GeneratePurchaseOrderInput)), true
+
+	case "Mutation.invalidatePaymentInfraCache":
+		if e.complexity.Mutation.InvalidatePaymentInfraCache == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_invalidatePaymentInfraCache_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.InvalidatePaymentInfraCache(childComplexity, args["qrValue"].(*string)), true
+
+	case "PaymentBookingTime.amount":
+		if e.complexity.PaymentBookingTime.Amount == nil {
+			break
+		}
+
+		return e.complexity.PaymentBookingTime.Amount(childComplexity), true
+
+	case "PaymentBookingTime.availableGroups":
+		if e.complexity.PaymentBookingTime.AvailableGroups == nil {
+			break
+		}
+
+		return e.complexity.PaymentBookingTime.AvailableGroups(childComplexity), true
+
+	case "PaymentBookingTime.id":
+		if e.complexity.PaymentBookingTime.ID == nil {
+			break
+		}
+
+		return e.complexity.PaymentBookingTime.ID(childComplexity), true
+
+	case "PaymentBookingTime.name":
+		if e.complexity.PaymentBookingTime.Name == nil {
+			break
+		}
+
+		return e.complexity.PaymentBookingTime.Name(childComplexity), true
+
+	case "PaymentBookingTime.unitMeasurement":
+		if e.complexity.PaymentBookingTime.UnitMeasurement == nil {
+			break
+		}
+
+		return e.complexity.PaymentBookingTime.UnitMeasurement(childComplexity), true
+
+	case "PaymentGateway.displayName":
+		if e.complexity.PaymentGateway.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.DisplayName(childComplexity), true
+
+	case "PaymentGateway.logoUrl":
+		if e.complexity.PaymentGateway.LogoURL == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.LogoURL(childComplexity), true
+
+	case "PaymentGateway.maxAmount":
+		if e.complexity.PaymentGateway.MaxAmount == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.MaxAmount(childComplexity), true
+
+	case "PaymentGateway.minAmount":
+		if e.complexity.PaymentGateway.MinAmount == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.MinAmount(childComplexity), true
+
+	case "PaymentGateway.name":
+		if e.complexity.PaymentGateway.Name == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.Name(childComplexity), true
+
+	case "PaymentGateway.supportedCurrencies":
+		if e.complexity.PaymentGateway.SupportedCurrencies == nil {
+			break
+		}
+
+		return e.complexity.PaymentGateway.SupportedCurrencies(childComplexity), true
+
+	case "PaymentInfraResponse.bookingTimes":
+		if e.complexity.PaymentInfraResponse.BookingTimes == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.BookingTimes(childComplexity), true
+
+	case "PaymentInfraResponse.branding":
+		if e.complexity.PaymentInfraResponse.Branding == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.Branding(childComplexity), true
+
+	case "PaymentInfraResponse.detail":
+		if e.complexity.PaymentInfraResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.Detail(childComplexity), true
+
+	case "PaymentInfraResponse.installation":
+		if e.complexity.PaymentInfraResponse.Installation == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.Installation(childComplexity), true
+
+	case "PaymentInfraResponse.message":
+		if e.complexity.PaymentInfraResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.Message(childComplexity), true
+
+	case "PaymentInfraResponse.paymentRack":
+		if e.complexity.PaymentInfraResponse.PaymentRack == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.PaymentRack(childComplexity), true
+
+	case "PaymentInfraResponse.status":
+		if e.complexity.PaymentInfraResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.Status(childComplexity), true
+
+	case "PaymentInfraResponse.traceId":
+		if e.complexity.PaymentInfraResponse.TraceID == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.TraceID(childComplexity), true
+
+	case "PaymentInfraResponse.transactionId":
+		if e.complexity.PaymentInfraResponse.TransactionID == nil {
+			break
+		}
+
+		return e.complexity.PaymentInfraResponse.TransactionID(childComplexity), true
+
+	case "PaymentInstallation.address":
+		if e.complexity.PaymentInstallation.Address == nil {
+			break
+		}
+
+		return e.complexity.PaymentInstallation.Address(childComplexity), true
+
+	case "PaymentInstallation.city":
+		if e.complexity.PaymentInstallation.City == nil {
+			break
+		}
+
+		return e.complexity.PaymentInstallation.City(childComplexity), true
+
+	case "PaymentInstallation.id":
+		if e.complexity.PaymentInstallation.ID == nil {
+			break
+		}
+
+		return e.complexity.PaymentInstallation.ID(childComplexity), true
+
+	case "PaymentInstallation.imageUrl":
+		if e.complexity.PaymentInstallation.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.PaymentInstallation.ImageURL(childComplexity), true
 
 	case "PaymentInstallation.name":
 		if e.complexity.PaymentInstallation.Name == nil {
@@ -662,6 +1295,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PaymentInstallation.Region(childComplexity), true
 
+	case "PaymentQuote.couponApplied":
+		if e.complexity.PaymentQuote.CouponApplied == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.CouponApplied(childComplexity), true
+
+	case "PaymentQuote.couponCode":
+		if e.complexity.PaymentQuote.CouponCode == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.CouponCode(childComplexity), true
+
+	case "PaymentQuote.discountAmount":
+		if e.complexity.PaymentQuote.DiscountAmount == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.DiscountAmount(childComplexity), true
+
+	case "PaymentQuote.discountPercentage":
+		if e.complexity.PaymentQuote.DiscountPercentage == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.DiscountPercentage(childComplexity), true
+
+	case "PaymentQuote.groupId":
+		if e.complexity.PaymentQuote.GroupID == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.GroupID(childComplexity), true
+
+	case "PaymentQuote.price":
+		if e.complexity.PaymentQuote.Price == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.Price(childComplexity), true
+
+	case "PaymentQuote.total":
+		if e.complexity.PaymentQuote.Total == nil {
+			break
+		}
+
+		return e.complexity.PaymentQuote.Total(childComplexity), true
+
 	case "PaymentRack.address":
 		if e.complexity.PaymentRack.Address == nil {
 			break
@@ -669,6 +1351,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PaymentRack.Address(childComplexity), true
 
+	case "PaymentRack.availableGroups":
+		if e.complexity.PaymentRack.AvailableGroups == nil {
+			break
+		}
+
+		args, err := ec.field_PaymentRack_availableGroups_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.PaymentRack.AvailableGroups(childComplexity, args["bookingTimeId"].(int)), true
+
 	case "PaymentRack.description":
 		if e.complexity.PaymentRack.Description == nil {
 			break
@@ -781,6 +1475,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PurchaseOrderData.Status(childComplexity), true
 
+	case "PurchaseOrderResponse.detail":
+		if e.complexity.PurchaseOrderResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.PurchaseOrderResponse.Detail(childComplexity), true
+
 	case "PurchaseOrderResponse.message":
 		if e.complexity.PurchaseOrderResponse.Message == nil {
 			break
@@ -816,36 +1517,102 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PurchaseOrderResponse.TransactionID(childComplexity), true
 
-	case "Query.checkBookingStatus":
-		if e.complexity.Query.CheckBookingStatus == nil {
+	case "QrImage.data":
+		if e.complexity.QrImage.Data == nil {
 			break
 		}
 
-		args, err := ec.field_Query_checkBookingStatus_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.QrImage.Data(childComplexity), true
+
+	case "QrImage.dataUri":
+		if e.complexity.QrImage.DataURI == nil {
+			break
 		}
 
-		return e.complexity.Query.CheckBookingStatus(childComplexity, args["input"].(model.CheckBookingStatusInput)), true
+		return e.complexity.QrImage.DataURI(childComplexity), true
 
-	case "Query.getAvailableLockersByRackIDAndBookingTime":
-		if e.complexity.Query.GetAvailableLockersByRackIDAndBookingTime == nil {
+	case "QrImage.format":
+		if e.complexity.QrImage.Format == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.QrImage.Format(childComplexity), true
+
+	case "QrImage.mimeType":
+		if e.complexity.QrImage.MimeType == nil {
+			break
 		}
 
-		return e.complexity.Query.GetAvailableLockersByRackIDAndBookingTime(childComplexity, args["input"].(model.GetAvailableLockersByRackIDAndBookingTimeInput)), true
+		return e.complexity.QrImage.MimeType(childComplexity), true
 
-	case "Query.getPaymentInfraByQrValue":
-		if e.complexity.Query.GetPaymentInfraByQRValue == nil {
+	case "QrImage.payload":
+		if e.complexity.QrImage.Payload == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getPaymentInfraByQrValue_args(ctx, rawArgs)
+		return e.complexity.QrImage.Payload(childComplexity), true
+
+	case "QrImage.size":
+		if e.complexity.QrImage.Size == nil {
+			break
+		}
+
+		return e.complexity.QrImage.Size(childComplexity), true
+
+	case "Query.availablePaymentGateways":
+		if e.complexity.Query.AvailablePaymentGateways == nil {
+			break
+		}
+
+		args, err := ec.field_Query_availablePaymentGateways_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AvailablePaymentGateways(childComplexity, args["rackId"].(int)), true
+
+	case "Query.checkBookingStatus":
+		if e.complexity.Query.CheckBookingStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkBookingStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckBookingStatus(childComplexity, args["input"].(model.CheckBookingStatusInput)), true
+
+	case "Query.featureFlags":
+		if e.complexity.Query.FeatureFlags == nil {
+			break
+		}
+
+		args, err := ec.field_Query_featureFlags_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FeatureFlags(childComplexity, args["rackId"].(*int), args["installationId"].(*int), args["key"].(*string)), true
+
+	case "Query.getAvailableLockersByRackIDAndBookingTime":
+		if e.complexity.Query.GetAvailableLockersByRackIDAndBookingTime == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetAvailableLockersByRackIDAndBookingTime(childComplexity, args["input"].(model.GetAvailableLockersByRackIDAndBookingTimeInput)), true
+
+	case "Query.getPaymentInfraByQrValue":
+		if e.complexity.Query.GetPaymentInfraByQRValue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getPaymentInfraByQrValue_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
@@ -864,6 +1631,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetPurchaseOrderByPo(childComplexity, args["input"].(model.GetPurchaseOrderByPoInput)), true
 
+	case "Query.lockerOpenAudit":
+		if e.complexity.Query.LockerOpenAudit == nil {
+			break
+		}
+
+		args, err := ec.field_Query_lockerOpenAudit_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LockerOpenAudit(childComplexity, args["serviceName"].(string), args["from"].(time.Time), args["to"].(time.Time), args["outcome"].(*model.LockerOpenOutcome), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.tenant":
+		if e.complexity.Query.Tenant == nil {
+			break
+		}
+
+		args, err := ec.field_Query_tenant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Tenant(childComplexity, args["serviceName"].(*string)), true
+
 	case "Query.validateDiscountCoupon":
 		if e.complexity.Query.ValidateDiscountCoupon == nil {
 			break
@@ -876,6 +1667,130 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.ValidateDiscountCoupon(childComplexity, args["input"].(model.ValidateDiscountCouponInput)), true
 
+	case "Refund.amount":
+		if e.complexity.Refund.Amount == nil {
+			break
+		}
+
+		return e.complexity.Refund.Amount(childComplexity), true
+
+	case "Refund.detail":
+		if e.complexity.Refund.Detail == nil {
+			break
+		}
+
+		return e.complexity.Refund.Detail(childComplexity), true
+
+	case "Refund.message":
+		if e.complexity.Refund.Message == nil {
+			break
+		}
+
+		return e.complexity.Refund.Message(childComplexity), true
+
+	case "Refund.refundId":
+		if e.complexity.Refund.RefundID == nil {
+			break
+		}
+
+		return e.complexity.Refund.RefundID(childComplexity), true
+
+	case "Refund.status":
+		if e.complexity.Refund.Status == nil {
+			break
+		}
+
+		return e.complexity.Refund.Status(childComplexity), true
+
+	case "Subscription.purchaseOrderStatus":
+		if e.complexity.Subscription.PurchaseOrderStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_purchaseOrderStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.PurchaseOrderStatus(childComplexity, args["purchaseOrder"].(string), args["traceId"].(*string), args["locale"].(*string)), true
+
+	case "Tenant.branding":
+		if e.complexity.Tenant.Branding == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Branding(childComplexity), true
+
+	case "Tenant.displayName":
+		if e.complexity.Tenant.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.Tenant.DisplayName(childComplexity), true
+
+	case "Tenant.features":
+		if e.complexity.Tenant.Features == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Features(childComplexity), true
+
+	case "Tenant.gateways":
+		if e.complexity.Tenant.Gateways == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Gateways(childComplexity), true
+
+	case "Tenant.id":
+		if e.complexity.Tenant.ID == nil {
+			break
+		}
+
+		return e.complexity.Tenant.ID(childComplexity), true
+
+	case "TenantBranding.logoUrl":
+		if e.complexity.TenantBranding.LogoURL == nil {
+			break
+		}
+
+		return e.complexity.TenantBranding.LogoURL(childComplexity), true
+
+	case "TenantBranding.primaryColor":
+		if e.complexity.TenantBranding.PrimaryColor == nil {
+			break
+		}
+
+		return e.complexity.TenantBranding.PrimaryColor(childComplexity), true
+
+	case "TenantBranding.secondaryColor":
+		if e.complexity.TenantBranding.SecondaryColor == nil {
+			break
+		}
+
+		return e.complexity.TenantBranding.SecondaryColor(childComplexity), true
+
+	case "TenantFeature.enabled":
+		if e.complexity.TenantFeature.Enabled == nil {
+			break
+		}
+
+		return e.complexity.TenantFeature.Enabled(childComplexity), true
+
+	case "TenantFeature.name":
+		if e.complexity.TenantFeature.Name == nil {
+			break
+		}
+
+		return e.complexity.TenantFeature.Name(childComplexity), true
+
+	case "ValidateDiscountCouponResponse.detail":
+		if e.complexity.ValidateDiscountCouponResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.ValidateDiscountCouponResponse.Detail(childComplexity), true
+
 	case "ValidateDiscountCouponResponse.discountPercentage":
 		if e.complexity.ValidateDiscountCouponResponse.DiscountPercentage == nil {
 			break
@@ -919,8 +1834,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCancelBookingInput,
 		ec.unmarshalInputCheckBookingStatusInput,
 		ec.unmarshalInputExecuteOpenInput,
+		ec.unmarshalInputExtendBookingInput,
 		ec.unmarshalInputGenerateBookingInput,
 		ec.unmarshalInputGeneratePurchaseOrderInput,
 		ec.unmarshalInputGetAvailableLockersByRackIDAndBookingTimeInput,
@@ -972,6 +1889,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1039,6 +1973,21 @@ var sources = []*ast.Source{
 
   # Check Booking Status
   checkBookingStatus(input: CheckBookingStatusInput!): CheckBookingStatusResponse!
+
+  # Payment gateways enabled for a rack's installation
+  availablePaymentGateways(rackId: Int!): [PaymentGateway!]!
+
+  # Locker opening attempts of a serviceName in [from, to), newest first.
+  # Admin only: requires the X-Admin-Token header.
+  lockerOpenAudit(serviceName: String!, from: DateTime!, to: DateTime!, outcome: LockerOpenOutcome, limit: Int = 50, offset: Int = 0): LockerOpenAuditPage!
+
+  # Tenant of the request (X-Tenant-Id header or hostname), of the serviceName or the default
+  # tenant, in that order. Null when the request has no tenant.
+  tenant(serviceName: String): Tenant
+
+  # Feature flags evaluated for the request tenant and the given rack, installation or key
+  # (stable key for percentage rollouts, e.g. a device ID), sorted by name
+  featureFlags(rackId: Int, installationId: Int, key: String): [FeatureFlag!]!
 }
 
 type Mutation {
@@ -1050,24 +1999,55 @@ type Mutation {
 
   # Execute Open Locker
   executeOpen(input: ExecuteOpenInput!): ExecuteOpenResponse!
+
+  # Cancel Booking (refunds the purchase order when it was paid)
+  cancelBooking(input: CancelBookingInput!): CancelBookingResponse!
+
+  # Extend Booking (finishBooking is updated once the payment is confirmed)
+  extendBooking(input: ExtendBookingInput!): ExtendBookingResponse!
+
+  # Invalidate cached getPaymentInfraByQrValue entries (all of them when qrValue is omitted).
+  # Returns the number of removed entries.
+  invalidatePaymentInfraCache(qrValue: String): Int!
+}
+
+type Subscription {
+  # Purchase Order status changes (ends after PAID, REJECTED or EXPIRED)
+  purchaseOrderStatus(purchaseOrder: String!, traceId: String, locale: String): PurchaseOrderData!
 }
 
+# ========== SCALARS ==========
+
+# RFC 3339 timestamp, e.g. 2025-01-31T18:30:00-03:00
+scalar DateTime
+
 # ========== INPUT TYPES ==========
 
+# traceId is optional on every input. When omitted, the X-Trace-Id header, the trace-id of a
+# W3C traceparent header or a generated id is used; the response always echoes it.
+#
+# locale (es, en) is optional on every input and selects the language of the response message
+# and of the error texts. When omitted, the Accept-Language header is used (es by default).
+# Error codes in extensions.code do not depend on the language.
+
 input GetPaymentInfraByQrValueInput {
   qrValue: String!
+  traceId: String
+  locale: String
 }
 
 input GetAvailableLockersByRackIDAndBookingTimeInput {
   paymentRackId: Int!
   bookingTimeId: Int!
-  traceId: String!
+  traceId: String
+  locale: String
 }
 
 input ValidateDiscountCouponInput {
   couponCode: String!
   rackId: Int!
-  traceId: String!
+  traceId: String
+  locale: String
 }
 
 input GeneratePurchaseOrderInput {
@@ -1076,8 +2056,9 @@ input GeneratePurchaseOrderInput {
   couponCode: String
   userEmail: String!
   userPhone: String!
-  traceId: String!
+  traceId: String
   gatewayName: String!
+  locale: String
 }
 
 input GenerateBookingInput {
@@ -1086,22 +2067,51 @@ input GenerateBookingInput {
   couponCode: String
   userEmail: String!
   userPhone: String!
-  traceId: String!
+  traceId: String
+  locale: String
 }
 
 input GetPurchaseOrderByPoInput {
   purchaseOrder: String!
-  traceId: String!
+  traceId: String
+  locale: String
 }
 
 input CheckBookingStatusInput {
   serviceName: String!
   currentCode: String!
+  # IANA timezone used to render the booking timestamps (defaults to America/Santiago)
+  timezone: String
+  traceId: String
+  locale: String
 }
 
 input ExecuteOpenInput {
   serviceName: String!
   currentCode: String!
+  traceId: String
+  locale: String
+}
+
+input ExtendBookingInput {
+  serviceName: String!
+  currentCode: String!
+  bookingTimeId: Int!
+  gatewayName: String!
+  traceId: String
+  # IANA timezone used to render the finishBooking timestamps (defaults to America/Santiago)
+  timezone: String
+  locale: String
+}
+
+# Identify the booking by its code (serviceName + currentCode) or by its purchase order
+input CancelBookingInput {
+  serviceName: String
+  currentCode: String
+  purchaseOrder: String
+  reason: String!
+  traceId: String
+  locale: String
 }
 
 # ========== RESPONSE TYPES ==========
@@ -1109,16 +2119,21 @@ input ExecuteOpenInput {
 type PaymentInfraResponse {
   transactionId: String!
   message: String!
+  # Original backend message, not localized; null when the backend sent none
+  detail: String
   status: ResponseStatus!
   traceId: String!
   paymentRack: PaymentRack
   installation: PaymentInstallation
+  # Branding of the installation's tenant; null without tenant
+  branding: TenantBranding
   bookingTimes: [PaymentBookingTime!]!
 }
 
 type AvailableLockersByRackIDAndBookingTimeResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
   traceId: String!
   availableGroups: [AvailablePaymentGroup!]!
@@ -1127,6 +2142,7 @@ type AvailableLockersByRackIDAndBookingTimeResponse {
 type ValidateDiscountCouponResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
   traceId: String!
   discountPercentage: Float!
@@ -1135,6 +2151,7 @@ type ValidateDiscountCouponResponse {
 type GeneratePurchaseOrderResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
   traceId: String!
   url: String!
@@ -1143,14 +2160,18 @@ type GeneratePurchaseOrderResponse {
 type GenerateBookingResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
   traceId: String!
   code: String!
+  # Opening code rendered as a QR image (serviceName and expiresAt are embedded in the payload when given)
+  codeQr(format: QrImageFormat = PNG, size: Int = 256, serviceName: String, expiresAt: DateTime): QrImage!
 }
 
 type PurchaseOrderResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
   traceId: String!
   purchaseOrderData: PurchaseOrderData!
@@ -1159,23 +2180,41 @@ type PurchaseOrderResponse {
 type CheckBookingStatusResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
+  traceId: String!
   booking: BookingStatusData
 }
 
 type ExecuteOpenResponse {
   transactionId: String!
   message: String!
+  detail: String
   status: ResponseStatus!
+  traceId: String!
   openStatus: OpenStatus!
 }
 
+type CancelBookingResponse {
+  transactionId: String!
+  message: String!
+  detail: String
+  status: ResponseStatus!
+  traceId: String!
+  bookingId: Int!
+  purchaseOrder: String
+  cancellationStatus: CancellationStatus!
+  refund: Refund!
+}
+
 # ========== DOMAIN TYPES ==========
 
 type PaymentRack {
   id: Int!
   description: String!
   address: String!
+  # Groups with available lockers for one of the rack's booking times
+  availableGroups(bookingTimeId: Int!): [AvailablePaymentGroup!]!
 }
 
 type PaymentInstallation {
@@ -1192,6 +2231,8 @@ type PaymentBookingTime {
   name: String!
   unitMeasurement: UnitMeasurement!
   amount: Int!
+  # Groups with available lockers for this booking time (only when listed under a payment rack)
+  availableGroups: [AvailablePaymentGroup!]!
 }
 
 type AvailablePaymentGroup {
@@ -1200,6 +2241,114 @@ type AvailablePaymentGroup {
   price: Float!
   description: String!
   imageUrl: String!
+  # Price of the group with the discount coupon applied, if any
+  quote(couponCode: String): PaymentQuote!
+}
+
+type QrImage {
+  format: QrImageFormat!
+  mimeType: String!
+  # Width and height in pixels
+  size: Int!
+  # Text encoded in the QR
+  payload: String!
+  # Base64 encoded image
+  data: String!
+  dataUri: String!
+}
+
+type LockerOpenAuditPage {
+  records: [LockerOpenAuditRecord!]!
+  totalCount: Int!
+  limit: Int!
+  offset: Int!
+  hasNextPage: Boolean!
+}
+
+type LockerOpenAuditRecord {
+  id: String!
+  timestamp: DateTime!
+  principal: String!
+  clientIp: String!
+  userAgent: String!
+  serviceName: String!
+  # Opening code with all but its last characters masked
+  maskedCode: String!
+  # Every open status received from the backend, in order
+  openStatuses: [OpenStatus!]!
+  outcome: LockerOpenOutcome!
+  reason: String
+  upstreamTransactionId: String
+  traceId: String!
+}
+
+type PaymentQuote {
+  groupId: Int!
+  couponCode: String
+  couponApplied: Boolean!
+  price: Float!
+  discountPercentage: Float!
+  discountAmount: Float!
+  total: Float!
+}
+
+type ExtendBookingResponse {
+  transactionId: String!
+  message: String!
+  detail: String
+  status: ResponseStatus!
+  traceId: String!
+  purchaseOrder: String!
+  url: String!
+  bookingTime: PaymentBookingTime!
+  price: Int!
+  currentFinishBooking: DateTime!
+  # Estimated finishBooking if the payment is confirmed before currentFinishBooking
+  newFinishBooking: DateTime!
+}
+
+type Refund {
+  refundId: String
+  status: RefundStatus!
+  amount: Int!
+  message: String!
+  detail: String
+}
+
+type Tenant {
+  id: String!
+  displayName: String!
+  branding: TenantBranding!
+  # Enabled payment gateway names; empty means every registered gateway
+  gateways: [String!]!
+  # Feature flags set for the tenant, sorted by name
+  features: [TenantFeature!]!
+}
+
+type TenantBranding {
+  logoUrl: String!
+  primaryColor: String!
+  secondaryColor: String!
+}
+
+type TenantFeature {
+  name: String!
+  enabled: Boolean!
+}
+
+type FeatureFlag {
+  name: String!
+  enabled: Boolean!
+}
+
+type PaymentGateway {
+  name: String!
+  displayName: String!
+  logoUrl: String!
+  supportedCurrencies: [String!]!
+  minAmount: Int!
+  # 0 means no upper limit
+  maxAmount: Int!
 }
 
 type PurchaseOrderData {
@@ -1222,8 +2371,8 @@ type PurchaseOrderData {
 type BookingStatusData {
   id: Int!
   configurationBookingId: Int!
-  initBooking: String!
-  finishBooking: String!
+  initBooking: DateTime!
+  finishBooking: DateTime!
   installationName: String!
   numberLocker: Int!
   deviceId: String!
@@ -1231,8 +2380,12 @@ type BookingStatusData {
   openings: Int!
   serviceName: String!
   emailRecipient: String!
-  createdAt: String!
-  updatedAt: String!
+  createdAt: DateTime!
+  updatedAt: DateTime!
+  state: BookingState!
+  # Seconds until finishBooking (0 once the booking has ended)
+  remainingTime: Int!
+  canOpen: Boolean!
 }
 
 # ========== ENUMS ==========
@@ -1251,6 +2404,38 @@ enum UnitMeasurement {
   MONTH
 }
 
+enum BookingState {
+  PENDING_PAYMENT
+  ACTIVE
+  EXPIRED
+  CANCELLED
+  COMPLETED
+}
+
+enum CancellationStatus {
+  CANCELLED
+  FAILED
+}
+
+enum RefundStatus {
+  NOT_REQUIRED
+  PENDING
+  APPROVED
+  REJECTED
+  FAILED
+}
+
+enum QrImageFormat {
+  PNG
+  SVG
+}
+
+enum LockerOpenOutcome {
+  OPENED
+  FAILED
+  REJECTED
+}
+
 enum OpenStatus {
   OPEN_STATUS_UNSPECIFIED
   OPEN_STATUS_RECEIVED
@@ -1267,32 +2452,47 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Mutation_executeOpen_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_AvailablePaymentGroup_quote_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNExecuteOpenInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "couponCode", ec.unmarshalOString2ᚖstring)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
+	args["couponCode"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_generateBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_GenerateBookingResponse_codeQr_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGenerateBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalOQrImageFormat2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
+	args["format"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "size", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["size"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "serviceName", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["serviceName"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "expiresAt", ec.unmarshalODateTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["expiresAt"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_generatePurchaseOrder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_cancelBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGeneratePurchaseOrderInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCancelBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancelBookingInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1300,21 +2500,21 @@ func (ec *executionContext) field_Mutation_generatePurchaseOrder_args(ctx contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_executeOpen_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNExecuteOpenInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenInput)
 	if err != nil {
 		return nil, err
 	}
-	args["name"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_checkBookingStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_extendBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCheckBookingStatusInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNExtendBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExtendBookingInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1322,10 +2522,108 @@ func (ec *executionContext) field_Query_checkBookingStatus_args(ctx context.Cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_generateBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGetAvailableLockersByRackIDAndBookingTimeInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetAvailableLockersByRackIDAndBookingTimeInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGenerateBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_generatePurchaseOrder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGeneratePurchaseOrderInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_invalidatePaymentInfraCache_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "qrValue", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["qrValue"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_PaymentRack_availableGroups_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingTimeId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["bookingTimeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_availablePaymentGateways_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "rackId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["rackId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_checkBookingStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCheckBookingStatusInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_featureFlags_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "rackId", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["rackId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "installationId", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["installationId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "key", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["key"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGetAvailableLockersByRackIDAndBookingTimeInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetAvailableLockersByRackIDAndBookingTimeInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,6 +2653,53 @@ func (ec *executionContext) field_Query_getPurchaseOrderByPo_args(ctx context.Co
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_lockerOpenAudit_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "serviceName", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["serviceName"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNDateTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNDateTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "outcome", ec.unmarshalOLockerOpenOutcome2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome)
+	if err != nil {
+		return nil, err
+	}
+	args["outcome"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tenant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "serviceName", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["serviceName"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_validateDiscountCoupon_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1366,6 +2711,27 @@ func (ec *executionContext) field_Query_validateDiscountCoupon_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_purchaseOrderStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "purchaseOrder", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["purchaseOrder"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "traceId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["traceId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "locale", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["locale"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1506,6 +2872,47 @@ func (ec *executionContext) fieldContext_AvailableLockersByRackIDAndBookingTimeR
 	return fc, nil
 }
 
+func (ec *executionContext) _AvailableLockersByRackIDAndBookingTimeResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.AvailableLockersByRackIDAndBookingTimeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_detail(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Detail, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AvailableLockersByRackIDAndBookingTimeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AvailableLockersByRackIDAndBookingTimeResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.AvailableLockersByRackIDAndBookingTimeResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_status(ctx, field)
 	if err != nil {
@@ -1643,6 +3050,8 @@ func (ec *executionContext) fieldContext_AvailableLockersByRackIDAndBookingTimeR
 				return ec.fieldContext_AvailablePaymentGroup_description(ctx, field)
 			case "imageUrl":
 				return ec.fieldContext_AvailablePaymentGroup_imageUrl(ctx, field)
+			case "quote":
+				return ec.fieldContext_AvailablePaymentGroup_quote(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AvailablePaymentGroup", field.Name)
 		},
@@ -1870,6 +3279,77 @@ func (ec *executionContext) fieldContext_AvailablePaymentGroup_imageUrl(_ contex
 	return fc, nil
 }
 
+func (ec *executionContext) _AvailablePaymentGroup_quote(ctx context.Context, field graphql.CollectedField, obj *model.AvailablePaymentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AvailablePaymentGroup_quote(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.AvailablePaymentGroup().Quote(rctx, obj, fc.Args["couponCode"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PaymentQuote)
+	fc.Result = res
+	return ec.marshalNPaymentQuote2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentQuote(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AvailablePaymentGroup_quote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AvailablePaymentGroup",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "groupId":
+				return ec.fieldContext_PaymentQuote_groupId(ctx, field)
+			case "couponCode":
+				return ec.fieldContext_PaymentQuote_couponCode(ctx, field)
+			case "couponApplied":
+				return ec.fieldContext_PaymentQuote_couponApplied(ctx, field)
+			case "price":
+				return ec.fieldContext_PaymentQuote_price(ctx, field)
+			case "discountPercentage":
+				return ec.fieldContext_PaymentQuote_discountPercentage(ctx, field)
+			case "discountAmount":
+				return ec.fieldContext_PaymentQuote_discountAmount(ctx, field)
+			case "total":
+				return ec.fieldContext_PaymentQuote_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentQuote", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_AvailablePaymentGroup_quote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _BookingStatusData_id(ctx context.Context, field graphql.CollectedField, obj *model.BookingStatusData) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_BookingStatusData_id(ctx, field)
 	if err != nil {
@@ -1984,9 +3464,9 @@ func (ec *executionContext) _BookingStatusData_initBooking(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_BookingStatusData_initBooking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1996,7 +3476,7 @@ func (ec *executionContext) fieldContext_BookingStatusData_initBooking(_ context
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2028,9 +3508,9 @@ func (ec *executionContext) _BookingStatusData_finishBooking(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_BookingStatusData_finishBooking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -2040,7 +3520,7 @@ func (ec *executionContext) fieldContext_BookingStatusData_finishBooking(_ conte
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2380,9 +3860,9 @@ func (ec *executionContext) _BookingStatusData_createdAt(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_BookingStatusData_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -2392,7 +3872,7 @@ func (ec *executionContext) fieldContext_BookingStatusData_createdAt(_ context.C
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2424,9 +3904,9 @@ func (ec *executionContext) _BookingStatusData_updatedAt(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_BookingStatusData_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -2436,14 +3916,14 @@ func (ec *executionContext) fieldContext_BookingStatusData_updatedAt(_ context.C
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckBookingStatusResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CheckBookingStatusResponse_transactionId(ctx, field)
+func (ec *executionContext) _BookingStatusData_state(ctx context.Context, field graphql.CollectedField, obj *model.BookingStatusData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BookingStatusData_state(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2456,7 +3936,7 @@ func (ec *executionContext) _CheckBookingStatusResponse_transactionId(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2468,26 +3948,26 @@ func (ec *executionContext) _CheckBookingStatusResponse_transactionId(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.BookingState)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBookingState2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐBookingState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CheckBookingStatusResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BookingStatusData_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckBookingStatusResponse",
+		Object:     "BookingStatusData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type BookingState does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckBookingStatusResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CheckBookingStatusResponse_message(ctx, field)
+func (ec *executionContext) _BookingStatusData_remainingTime(ctx context.Context, field graphql.CollectedField, obj *model.BookingStatusData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BookingStatusData_remainingTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2500,7 +3980,7 @@ func (ec *executionContext) _CheckBookingStatusResponse_message(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.RemainingTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2512,26 +3992,26 @@ func (ec *executionContext) _CheckBookingStatusResponse_message(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CheckBookingStatusResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BookingStatusData_remainingTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckBookingStatusResponse",
+		Object:     "BookingStatusData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckBookingStatusResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CheckBookingStatusResponse_status(ctx, field)
+func (ec *executionContext) _BookingStatusData_canOpen(ctx context.Context, field graphql.CollectedField, obj *model.BookingStatusData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BookingStatusData_canOpen(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2544,7 +4024,7 @@ func (ec *executionContext) _CheckBookingStatusResponse_status(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.CanOpen, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2556,26 +4036,26 @@ func (ec *executionContext) _CheckBookingStatusResponse_status(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResponseStatus)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CheckBookingStatusResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BookingStatusData_canOpen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckBookingStatusResponse",
+		Object:     "BookingStatusData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ResponseStatus does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckBookingStatusResponse_booking(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CheckBookingStatusResponse_booking(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2588,63 +4068,38 @@ func (ec *executionContext) _CheckBookingStatusResponse_booking(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Booking, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.BookingStatusData)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOBookingStatusData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐBookingStatusData(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CheckBookingStatusResponse_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckBookingStatusResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_BookingStatusData_id(ctx, field)
-			case "configurationBookingId":
-				return ec.fieldContext_BookingStatusData_configurationBookingId(ctx, field)
-			case "initBooking":
-				return ec.fieldContext_BookingStatusData_initBooking(ctx, field)
-			case "finishBooking":
-				return ec.fieldContext_BookingStatusData_finishBooking(ctx, field)
-			case "installationName":
-				return ec.fieldContext_BookingStatusData_installationName(ctx, field)
-			case "numberLocker":
-				return ec.fieldContext_BookingStatusData_numberLocker(ctx, field)
-			case "deviceId":
-				return ec.fieldContext_BookingStatusData_deviceId(ctx, field)
-			case "currentCode":
-				return ec.fieldContext_BookingStatusData_currentCode(ctx, field)
-			case "openings":
-				return ec.fieldContext_BookingStatusData_openings(ctx, field)
-			case "serviceName":
-				return ec.fieldContext_BookingStatusData_serviceName(ctx, field)
-			case "emailRecipient":
-				return ec.fieldContext_BookingStatusData_emailRecipient(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_BookingStatusData_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_BookingStatusData_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BookingStatusData", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExecuteOpenResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExecuteOpenResponse_transactionId(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2657,7 +4112,7 @@ func (ec *executionContext) _ExecuteOpenResponse_transactionId(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2674,9 +4129,9 @@ func (ec *executionContext) _ExecuteOpenResponse_transactionId(ctx context.Conte
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExecuteOpenResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExecuteOpenResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2687,8 +4142,8 @@ func (ec *executionContext) fieldContext_ExecuteOpenResponse_transactionId(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _ExecuteOpenResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExecuteOpenResponse_message(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2701,26 +4156,23 @@ func (ec *executionContext) _ExecuteOpenResponse_message(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExecuteOpenResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExecuteOpenResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2731,8 +4183,8 @@ func (ec *executionContext) fieldContext_ExecuteOpenResponse_message(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _ExecuteOpenResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExecuteOpenResponse_status(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2762,9 +4214,9 @@ func (ec *executionContext) _ExecuteOpenResponse_status(ctx context.Context, fie
 	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExecuteOpenResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExecuteOpenResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2775,8 +4227,8 @@ func (ec *executionContext) fieldContext_ExecuteOpenResponse_status(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _ExecuteOpenResponse_openStatus(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExecuteOpenResponse_openStatus(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2789,7 +4241,7 @@ func (ec *executionContext) _ExecuteOpenResponse_openStatus(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OpenStatus, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2801,26 +4253,26 @@ func (ec *executionContext) _ExecuteOpenResponse_openStatus(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.OpenStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExecuteOpenResponse_openStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExecuteOpenResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type OpenStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GenerateBookingResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GenerateBookingResponse_transactionId(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_bookingId(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_bookingId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2833,7 +4285,7 @@ func (ec *executionContext) _GenerateBookingResponse_transactionId(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.BookingID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2845,26 +4297,26 @@ func (ec *executionContext) _GenerateBookingResponse_transactionId(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GenerateBookingResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_bookingId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GenerateBookingResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GenerateBookingResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GenerateBookingResponse_message(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_purchaseOrder(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_purchaseOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2877,26 +4329,23 @@ func (ec *executionContext) _GenerateBookingResponse_message(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.PurchaseOrder, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GenerateBookingResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_purchaseOrder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GenerateBookingResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2907,8 +4356,8 @@ func (ec *executionContext) fieldContext_GenerateBookingResponse_message(_ conte
 	return fc, nil
 }
 
-func (ec *executionContext) _GenerateBookingResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GenerateBookingResponse_status(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_cancellationStatus(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_cancellationStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2921,7 +4370,7 @@ func (ec *executionContext) _GenerateBookingResponse_status(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.CancellationStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2933,26 +4382,26 @@ func (ec *executionContext) _GenerateBookingResponse_status(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResponseStatus)
+	res := resTmp.(model.CancellationStatus)
 	fc.Result = res
-	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+	return ec.marshalNCancellationStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancellationStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GenerateBookingResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_cancellationStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GenerateBookingResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ResponseStatus does not have child fields")
+			return nil, errors.New("field of type CancellationStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GenerateBookingResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GenerateBookingResponse_traceId(ctx, field)
+func (ec *executionContext) _CancelBookingResponse_refund(ctx context.Context, field graphql.CollectedField, obj *model.CancelBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CancelBookingResponse_refund(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2965,7 +4414,7 @@ func (ec *executionContext) _GenerateBookingResponse_traceId(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraceID, nil
+		return obj.Refund, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2977,26 +4426,38 @@ func (ec *executionContext) _GenerateBookingResponse_traceId(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Refund)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRefund2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐRefund(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GenerateBookingResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelBookingResponse_refund(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GenerateBookingResponse",
+		Object:     "CancelBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "refundId":
+				return ec.fieldContext_Refund_refundId(ctx, field)
+			case "status":
+				return ec.fieldContext_Refund_status(ctx, field)
+			case "amount":
+				return ec.fieldContext_Refund_amount(ctx, field)
+			case "message":
+				return ec.fieldContext_Refund_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_Refund_detail(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Refund", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GenerateBookingResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GenerateBookingResponse_code(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3009,7 +4470,7 @@ func (ec *executionContext) _GenerateBookingResponse_code(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3026,9 +4487,9 @@ func (ec *executionContext) _GenerateBookingResponse_code(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GenerateBookingResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GenerateBookingResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3039,8 +4500,8 @@ func (ec *executionContext) fieldContext_GenerateBookingResponse_code(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_transactionId(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3053,7 +4514,7 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_transactionId(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3070,9 +4531,9 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_transactionId(ctx con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratePurchaseOrderResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3083,8 +4544,8 @@ func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_transacti
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_message(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3097,26 +4558,23 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_message(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratePurchaseOrderResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3127,8 +4585,8 @@ func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_message(_
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_status(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3158,9 +4616,9 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_status(ctx context.Co
 	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratePurchaseOrderResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3171,8 +4629,8 @@ func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_status(_
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_traceId(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3202,9 +4660,9 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_traceId(ctx context.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratePurchaseOrderResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3215,8 +4673,8 @@ func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_traceId(_
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse_url(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_url(ctx, field)
+func (ec *executionContext) _CheckBookingStatusResponse_booking(ctx context.Context, field graphql.CollectedField, obj *model.CheckBookingStatusResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CheckBookingStatusResponse_booking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3229,38 +4687,69 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse_url(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.Booking, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.BookingStatusData)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOBookingStatusData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐBookingStatusData(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckBookingStatusResponse_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratePurchaseOrderResponse",
+		Object:     "CheckBookingStatusResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BookingStatusData_id(ctx, field)
+			case "configurationBookingId":
+				return ec.fieldContext_BookingStatusData_configurationBookingId(ctx, field)
+			case "initBooking":
+				return ec.fieldContext_BookingStatusData_initBooking(ctx, field)
+			case "finishBooking":
+				return ec.fieldContext_BookingStatusData_finishBooking(ctx, field)
+			case "installationName":
+				return ec.fieldContext_BookingStatusData_installationName(ctx, field)
+			case "numberLocker":
+				return ec.fieldContext_BookingStatusData_numberLocker(ctx, field)
+			case "deviceId":
+				return ec.fieldContext_BookingStatusData_deviceId(ctx, field)
+			case "currentCode":
+				return ec.fieldContext_BookingStatusData_currentCode(ctx, field)
+			case "openings":
+				return ec.fieldContext_BookingStatusData_openings(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_BookingStatusData_serviceName(ctx, field)
+			case "emailRecipient":
+				return ec.fieldContext_BookingStatusData_emailRecipient(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_BookingStatusData_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_BookingStatusData_updatedAt(ctx, field)
+			case "state":
+				return ec.fieldContext_BookingStatusData_state(ctx, field)
+			case "remainingTime":
+				return ec.fieldContext_BookingStatusData_remainingTime(ctx, field)
+			case "canOpen":
+				return ec.fieldContext_BookingStatusData_canOpen(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BookingStatusData", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_generatePurchaseOrder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_generatePurchaseOrder(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3273,7 +4762,7 @@ func (ec *executionContext) _Mutation_generatePurchaseOrder(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().GeneratePurchaseOrder(rctx, fc.Args["input"].(model.GeneratePurchaseOrderInput))
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3285,49 +4774,26 @@ func (ec *executionContext) _Mutation_generatePurchaseOrder(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.GeneratePurchaseOrderResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNGeneratePurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_generatePurchaseOrder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_GeneratePurchaseOrderResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_GeneratePurchaseOrderResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_GeneratePurchaseOrderResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_GeneratePurchaseOrderResponse_traceId(ctx, field)
-			case "url":
-				return ec.fieldContext_GeneratePurchaseOrderResponse_url(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GeneratePurchaseOrderResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_generatePurchaseOrder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_generateBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_generateBooking(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3340,7 +4806,7 @@ func (ec *executionContext) _Mutation_generateBooking(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().GenerateBooking(rctx, fc.Args["input"].(model.GenerateBookingInput))
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3352,49 +4818,26 @@ func (ec *executionContext) _Mutation_generateBooking(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.GenerateBookingResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNGenerateBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_generateBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_GenerateBookingResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_GenerateBookingResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_GenerateBookingResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_GenerateBookingResponse_traceId(ctx, field)
-			case "code":
-				return ec.fieldContext_GenerateBookingResponse_code(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GenerateBookingResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_generateBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_executeOpen(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_executeOpen(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3407,59 +4850,35 @@ func (ec *executionContext) _Mutation_executeOpen(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ExecuteOpen(rctx, fc.Args["input"].(model.ExecuteOpenInput))
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ExecuteOpenResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNExecuteOpenResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_executeOpen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_ExecuteOpenResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_ExecuteOpenResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_ExecuteOpenResponse_status(ctx, field)
-			case "openStatus":
-				return ec.fieldContext_ExecuteOpenResponse_openStatus(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ExecuteOpenResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_executeOpen_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentBookingTime_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentBookingTime_id(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3472,7 +4891,7 @@ func (ec *executionContext) _PaymentBookingTime_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3484,26 +4903,26 @@ func (ec *executionContext) _PaymentBookingTime_id(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.ResponseStatus)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentBookingTime_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentBookingTime",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentBookingTime_name(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentBookingTime_name(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3516,7 +4935,7 @@ func (ec *executionContext) _PaymentBookingTime_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3533,9 +4952,9 @@ func (ec *executionContext) _PaymentBookingTime_name(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentBookingTime_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentBookingTime",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3546,8 +4965,8 @@ func (ec *executionContext) fieldContext_PaymentBookingTime_name(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentBookingTime_unitMeasurement(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentBookingTime_unitMeasurement(ctx, field)
+func (ec *executionContext) _ExecuteOpenResponse_openStatus(ctx context.Context, field graphql.CollectedField, obj *model.ExecuteOpenResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExecuteOpenResponse_openStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3560,7 +4979,7 @@ func (ec *executionContext) _PaymentBookingTime_unitMeasurement(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UnitMeasurement, nil
+		return obj.OpenStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3572,26 +4991,26 @@ func (ec *executionContext) _PaymentBookingTime_unitMeasurement(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.UnitMeasurement)
+	res := resTmp.(model.OpenStatus)
 	fc.Result = res
-	return ec.marshalNUnitMeasurement2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐUnitMeasurement(ctx, field.Selections, res)
+	return ec.marshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentBookingTime_unitMeasurement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecuteOpenResponse_openStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentBookingTime",
+		Object:     "ExecuteOpenResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UnitMeasurement does not have child fields")
+			return nil, errors.New("field of type OpenStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentBookingTime_amount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentBookingTime_amount(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3604,7 +5023,7 @@ func (ec *executionContext) _PaymentBookingTime_amount(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3616,26 +5035,26 @@ func (ec *executionContext) _PaymentBookingTime_amount(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentBookingTime_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentBookingTime",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_transactionId(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3648,7 +5067,7 @@ func (ec *executionContext) _PaymentInfraResponse_transactionId(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3665,9 +5084,9 @@ func (ec *executionContext) _PaymentInfraResponse_transactionId(ctx context.Cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3678,8 +5097,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_transactionId(_ co
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_message(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3692,26 +5111,23 @@ func (ec *executionContext) _PaymentInfraResponse_message(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3722,8 +5138,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_message(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_status(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3753,9 +5169,9 @@ func (ec *executionContext) _PaymentInfraResponse_status(ctx context.Context, fi
 	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3766,8 +5182,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_status(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_traceId(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3797,9 +5213,9 @@ func (ec *executionContext) _PaymentInfraResponse_traceId(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3810,8 +5226,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_traceId(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_paymentRack(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_paymentRack(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_purchaseOrder(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_purchaseOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3824,43 +5240,38 @@ func (ec *executionContext) _PaymentInfraResponse_paymentRack(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PaymentRack, nil
+		return obj.PurchaseOrder, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PaymentRack)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPaymentRack2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentRack(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_paymentRack(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_purchaseOrder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_PaymentRack_id(ctx, field)
-			case "description":
-				return ec.fieldContext_PaymentRack_description(ctx, field)
-			case "address":
-				return ec.fieldContext_PaymentRack_address(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PaymentRack", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_installation(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_installation(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_url(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3873,49 +5284,38 @@ func (ec *executionContext) _PaymentInfraResponse_installation(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Installation, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PaymentInstallation)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPaymentInstallation2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInstallation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_installation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_PaymentInstallation_id(ctx, field)
-			case "name":
-				return ec.fieldContext_PaymentInstallation_name(ctx, field)
-			case "region":
-				return ec.fieldContext_PaymentInstallation_region(ctx, field)
-			case "city":
-				return ec.fieldContext_PaymentInstallation_city(ctx, field)
-			case "address":
-				return ec.fieldContext_PaymentInstallation_address(ctx, field)
-			case "imageUrl":
-				return ec.fieldContext_PaymentInstallation_imageUrl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PaymentInstallation", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInfraResponse_bookingTimes(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInfraResponse_bookingTimes(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_bookingTime(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_bookingTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3928,7 +5328,7 @@ func (ec *executionContext) _PaymentInfraResponse_bookingTimes(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BookingTimes, nil
+		return obj.BookingTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3940,14 +5340,14 @@ func (ec *executionContext) _PaymentInfraResponse_bookingTimes(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PaymentBookingTime)
+	res := resTmp.(*model.PaymentBookingTime)
 	fc.Result = res
-	return ec.marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTimeᚄ(ctx, field.Selections, res)
+	return ec.marshalNPaymentBookingTime2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInfraResponse_bookingTimes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_bookingTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInfraResponse",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3961,6 +5361,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_bookingTimes(_ con
 				return ec.fieldContext_PaymentBookingTime_unitMeasurement(ctx, field)
 			case "amount":
 				return ec.fieldContext_PaymentBookingTime_amount(ctx, field)
+			case "availableGroups":
+				return ec.fieldContext_PaymentBookingTime_availableGroups(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type PaymentBookingTime", field.Name)
 		},
@@ -3968,8 +5370,8 @@ func (ec *executionContext) fieldContext_PaymentInfraResponse_bookingTimes(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_id(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_price(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_price(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3982,7 +5384,7 @@ func (ec *executionContext) _PaymentInstallation_id(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Price, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3999,9 +5401,9 @@ func (ec *executionContext) _PaymentInstallation_id(ctx context.Context, field g
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4012,8 +5414,8 @@ func (ec *executionContext) fieldContext_PaymentInstallation_id(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_name(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_name(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_currentFinishBooking(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_currentFinishBooking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4026,7 +5428,7 @@ func (ec *executionContext) _PaymentInstallation_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.CurrentFinishBooking, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4038,26 +5440,26 @@ func (ec *executionContext) _PaymentInstallation_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_currentFinishBooking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_region(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_region(ctx, field)
+func (ec *executionContext) _ExtendBookingResponse_newFinishBooking(ctx context.Context, field graphql.CollectedField, obj *model.ExtendBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExtendBookingResponse_newFinishBooking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4070,7 +5472,7 @@ func (ec *executionContext) _PaymentInstallation_region(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Region, nil
+		return obj.NewFinishBooking, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4082,26 +5484,26 @@ func (ec *executionContext) _PaymentInstallation_region(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_region(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendBookingResponse_newFinishBooking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "ExtendBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_city(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_city(ctx, field)
+func (ec *executionContext) _FeatureFlag_name(ctx context.Context, field graphql.CollectedField, obj *model.FeatureFlag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeatureFlag_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4114,7 +5516,7 @@ func (ec *executionContext) _PaymentInstallation_city(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.City, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4131,9 +5533,9 @@ func (ec *executionContext) _PaymentInstallation_city(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeatureFlag_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "FeatureFlag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4144,8 +5546,8 @@ func (ec *executionContext) fieldContext_PaymentInstallation_city(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_address(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_address(ctx, field)
+func (ec *executionContext) _FeatureFlag_enabled(ctx context.Context, field graphql.CollectedField, obj *model.FeatureFlag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeatureFlag_enabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4158,7 +5560,7 @@ func (ec *executionContext) _PaymentInstallation_address(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Enabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4170,26 +5572,26 @@ func (ec *executionContext) _PaymentInstallation_address(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeatureFlag_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "FeatureFlag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentInstallation_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentInstallation_imageUrl(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4202,7 +5604,7 @@ func (ec *executionContext) _PaymentInstallation_imageUrl(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImageURL, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4219,9 +5621,9 @@ func (ec *executionContext) _PaymentInstallation_imageUrl(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentInstallation_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentInstallation",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4232,8 +5634,8 @@ func (ec *executionContext) fieldContext_PaymentInstallation_imageUrl(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentRack_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentRack_id(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4246,7 +5648,7 @@ func (ec *executionContext) _PaymentRack_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4258,26 +5660,26 @@ func (ec *executionContext) _PaymentRack_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentRack_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentRack",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentRack_description(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentRack_description(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4290,26 +5692,23 @@ func (ec *executionContext) _PaymentRack_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentRack_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentRack",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4320,8 +5719,8 @@ func (ec *executionContext) fieldContext_PaymentRack_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentRack_address(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaymentRack_address(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4334,7 +5733,7 @@ func (ec *executionContext) _PaymentRack_address(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4346,26 +5745,26 @@ func (ec *executionContext) _PaymentRack_address(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ResponseStatus)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaymentRack_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentRack",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_couponId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_couponId(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4378,7 +5777,7 @@ func (ec *executionContext) _PurchaseOrderData_couponId(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CouponID, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4390,26 +5789,26 @@ func (ec *executionContext) _PurchaseOrderData_couponId(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_couponId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_bookingReference(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_bookingReference(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4422,7 +5821,7 @@ func (ec *executionContext) _PurchaseOrderData_bookingReference(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BookingReference, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4434,26 +5833,26 @@ func (ec *executionContext) _PurchaseOrderData_bookingReference(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_bookingReference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_oc(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_oc(ctx, field)
+func (ec *executionContext) _GenerateBookingResponse_codeQr(ctx context.Context, field graphql.CollectedField, obj *model.GenerateBookingResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GenerateBookingResponse_codeQr(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4466,7 +5865,7 @@ func (ec *executionContext) _PurchaseOrderData_oc(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Oc, nil
+		return ec.resolvers.GenerateBookingResponse().CodeQR(rctx, obj, fc.Args["format"].(*model.QRImageFormat), fc.Args["size"].(*int), fc.Args["serviceName"].(*string), fc.Args["expiresAt"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4478,26 +5877,51 @@ func (ec *executionContext) _PurchaseOrderData_oc(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.QRImage)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNQrImage2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_oc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GenerateBookingResponse_codeQr(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GenerateBookingResponse",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_QrImage_format(ctx, field)
+			case "mimeType":
+				return ec.fieldContext_QrImage_mimeType(ctx, field)
+			case "size":
+				return ec.fieldContext_QrImage_size(ctx, field)
+			case "payload":
+				return ec.fieldContext_QrImage_payload(ctx, field)
+			case "data":
+				return ec.fieldContext_QrImage_data(ctx, field)
+			case "dataUri":
+				return ec.fieldContext_QrImage_dataUri(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type QrImage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_GenerateBookingResponse_codeQr_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_email(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_email(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4510,7 +5934,7 @@ func (ec *executionContext) _PurchaseOrderData_email(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4527,9 +5951,9 @@ func (ec *executionContext) _PurchaseOrderData_email(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4540,8 +5964,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderData_email(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_phone(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_phone(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4554,7 +5978,7 @@ func (ec *executionContext) _PurchaseOrderData_phone(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Phone, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4571,9 +5995,9 @@ func (ec *executionContext) _PurchaseOrderData_phone(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4584,8 +6008,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderData_phone(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_discount(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_discount(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4598,38 +6022,35 @@ func (ec *executionContext) _PurchaseOrderData_discount(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Discount, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_productPrice(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_productPrice(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4642,7 +6063,7 @@ func (ec *executionContext) _PurchaseOrderData_productPrice(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProductPrice, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4654,26 +6075,26 @@ func (ec *executionContext) _PurchaseOrderData_productPrice(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.ResponseStatus)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_productPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_finalProductPrice(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_finalProductPrice(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4686,7 +6107,7 @@ func (ec *executionContext) _PurchaseOrderData_finalProductPrice(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FinalProductPrice, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4703,9 +6124,9 @@ func (ec *executionContext) _PurchaseOrderData_finalProductPrice(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_finalProductPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4716,8 +6137,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderData_finalProductPrice(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_productName(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_productName(ctx, field)
+func (ec *executionContext) _GeneratePurchaseOrderResponse_url(ctx context.Context, field graphql.CollectedField, obj *model.GeneratePurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratePurchaseOrderResponse_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4730,7 +6151,7 @@ func (ec *executionContext) _PurchaseOrderData_productName(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProductName, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4747,9 +6168,9 @@ func (ec *executionContext) _PurchaseOrderData_productName(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratePurchaseOrderResponse_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "GeneratePurchaseOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4760,8 +6181,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderData_productName(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_productDescription(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_productDescription(ctx, field)
+func (ec *executionContext) _LockerOpenAuditPage_records(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditPage_records(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4774,7 +6195,7 @@ func (ec *executionContext) _PurchaseOrderData_productDescription(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProductDescription, nil
+		return obj.Records, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4786,26 +6207,52 @@ func (ec *executionContext) _PurchaseOrderData_productDescription(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.LockerOpenAuditRecord)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLockerOpenAuditRecord2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditRecordᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_productDescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditPage_records(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "LockerOpenAuditPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LockerOpenAuditRecord_id(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LockerOpenAuditRecord_timestamp(ctx, field)
+			case "principal":
+				return ec.fieldContext_LockerOpenAuditRecord_principal(ctx, field)
+			case "clientIp":
+				return ec.fieldContext_LockerOpenAuditRecord_clientIp(ctx, field)
+			case "userAgent":
+				return ec.fieldContext_LockerOpenAuditRecord_userAgent(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_LockerOpenAuditRecord_serviceName(ctx, field)
+			case "maskedCode":
+				return ec.fieldContext_LockerOpenAuditRecord_maskedCode(ctx, field)
+			case "openStatuses":
+				return ec.fieldContext_LockerOpenAuditRecord_openStatuses(ctx, field)
+			case "outcome":
+				return ec.fieldContext_LockerOpenAuditRecord_outcome(ctx, field)
+			case "reason":
+				return ec.fieldContext_LockerOpenAuditRecord_reason(ctx, field)
+			case "upstreamTransactionId":
+				return ec.fieldContext_LockerOpenAuditRecord_upstreamTransactionId(ctx, field)
+			case "traceId":
+				return ec.fieldContext_LockerOpenAuditRecord_traceId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LockerOpenAuditRecord", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_lockerPosition(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_lockerPosition(ctx, field)
+func (ec *executionContext) _LockerOpenAuditPage_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditPage_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4818,7 +6265,7 @@ func (ec *executionContext) _PurchaseOrderData_lockerPosition(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LockerPosition, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4835,9 +6282,9 @@ func (ec *executionContext) _PurchaseOrderData_lockerPosition(ctx context.Contex
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_lockerPosition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditPage_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "LockerOpenAuditPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4848,8 +6295,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderData_lockerPosition(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_installationName(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_installationName(ctx, field)
+func (ec *executionContext) _LockerOpenAuditPage_limit(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditPage_limit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4862,7 +6309,7 @@ func (ec *executionContext) _PurchaseOrderData_installationName(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InstallationName, nil
+		return obj.Limit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4874,26 +6321,26 @@ func (ec *executionContext) _PurchaseOrderData_installationName(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_installationName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditPage_limit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "LockerOpenAuditPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_deviceSerieNum(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_deviceSerieNum(ctx, field)
+func (ec *executionContext) _LockerOpenAuditPage_offset(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditPage_offset(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4906,7 +6353,7 @@ func (ec *executionContext) _PurchaseOrderData_deviceSerieNum(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeviceSerieNum, nil
+		return obj.Offset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4918,26 +6365,26 @@ func (ec *executionContext) _PurchaseOrderData_deviceSerieNum(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_deviceSerieNum(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditPage_offset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "LockerOpenAuditPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderData_status(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderData_status(ctx, field)
+func (ec *executionContext) _LockerOpenAuditPage_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditPage_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4950,7 +6397,7 @@ func (ec *executionContext) _PurchaseOrderData_status(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4962,26 +6409,26 @@ func (ec *executionContext) _PurchaseOrderData_status(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderData_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditPage_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderData",
+		Object:     "LockerOpenAuditPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderResponse_transactionId(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_id(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4994,7 +6441,7 @@ func (ec *executionContext) _PurchaseOrderResponse_transactionId(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5011,9 +6458,9 @@ func (ec *executionContext) _PurchaseOrderResponse_transactionId(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderResponse",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5024,8 +6471,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderResponse_transactionId(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderResponse_message(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5038,7 +6485,7 @@ func (ec *executionContext) _PurchaseOrderResponse_message(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5050,26 +6497,26 @@ func (ec *executionContext) _PurchaseOrderResponse_message(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderResponse",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderResponse_status(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_principal(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_principal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5082,7 +6529,7 @@ func (ec *executionContext) _PurchaseOrderResponse_status(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Principal, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5094,26 +6541,26 @@ func (ec *executionContext) _PurchaseOrderResponse_status(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResponseStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_principal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderResponse",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ResponseStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderResponse_traceId(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_clientIp(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_clientIp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5126,7 +6573,7 @@ func (ec *executionContext) _PurchaseOrderResponse_traceId(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraceID, nil
+		return obj.ClientIP, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5143,9 +6590,9 @@ func (ec *executionContext) _PurchaseOrderResponse_traceId(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_clientIp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderResponse",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5156,8 +6603,8 @@ func (ec *executionContext) fieldContext_PurchaseOrderResponse_traceId(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _PurchaseOrderResponse_purchaseOrderData(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PurchaseOrderResponse_purchaseOrderData(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_userAgent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5170,7 +6617,7 @@ func (ec *executionContext) _PurchaseOrderResponse_purchaseOrderData(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PurchaseOrderData, nil
+		return obj.UserAgent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5182,56 +6629,26 @@ func (ec *executionContext) _PurchaseOrderResponse_purchaseOrderData(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PurchaseOrderData)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPurchaseOrderData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderData(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PurchaseOrderResponse_purchaseOrderData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_userAgent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PurchaseOrderResponse",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "couponId":
-				return ec.fieldContext_PurchaseOrderData_couponId(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_PurchaseOrderData_bookingReference(ctx, field)
-			case "oc":
-				return ec.fieldContext_PurchaseOrderData_oc(ctx, field)
-			case "email":
-				return ec.fieldContext_PurchaseOrderData_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_PurchaseOrderData_phone(ctx, field)
-			case "discount":
-				return ec.fieldContext_PurchaseOrderData_discount(ctx, field)
-			case "productPrice":
-				return ec.fieldContext_PurchaseOrderData_productPrice(ctx, field)
-			case "finalProductPrice":
-				return ec.fieldContext_PurchaseOrderData_finalProductPrice(ctx, field)
-			case "productName":
-				return ec.fieldContext_PurchaseOrderData_productName(ctx, field)
-			case "productDescription":
-				return ec.fieldContext_PurchaseOrderData_productDescription(ctx, field)
-			case "lockerPosition":
-				return ec.fieldContext_PurchaseOrderData_lockerPosition(ctx, field)
-			case "installationName":
-				return ec.fieldContext_PurchaseOrderData_installationName(ctx, field)
-			case "deviceSerieNum":
-				return ec.fieldContext_PurchaseOrderData_deviceSerieNum(ctx, field)
-			case "status":
-				return ec.fieldContext_PurchaseOrderData_status(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PurchaseOrderData", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getPaymentInfraByQrValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getPaymentInfraByQrValue(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_serviceName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5244,7 +6661,7 @@ func (ec *executionContext) _Query_getPaymentInfraByQrValue(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetPaymentInfraByQRValue(rctx, fc.Args["input"].(model.GetPaymentInfraByQRValueInput))
+		return obj.ServiceName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5256,53 +6673,26 @@ func (ec *executionContext) _Query_getPaymentInfraByQrValue(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PaymentInfraResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPaymentInfraResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInfraResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getPaymentInfraByQrValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_PaymentInfraResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_PaymentInfraResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_PaymentInfraResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_PaymentInfraResponse_traceId(ctx, field)
-			case "paymentRack":
-				return ec.fieldContext_PaymentInfraResponse_paymentRack(ctx, field)
-			case "installation":
-				return ec.fieldContext_PaymentInfraResponse_installation(ctx, field)
-			case "bookingTimes":
-				return ec.fieldContext_PaymentInfraResponse_bookingTimes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PaymentInfraResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getPaymentInfraByQrValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAvailableLockersByRackIDAndBookingTime(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getAvailableLockersByRackIDAndBookingTime(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_maskedCode(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_maskedCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5315,7 +6705,7 @@ func (ec *executionContext) _Query_getAvailableLockersByRackIDAndBookingTime(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAvailableLockersByRackIDAndBookingTime(rctx, fc.Args["input"].(model.GetAvailableLockersByRackIDAndBookingTimeInput))
+		return obj.MaskedCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5327,49 +6717,26 @@ func (ec *executionContext) _Query_getAvailableLockersByRackIDAndBookingTime(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AvailableLockersByRackIDAndBookingTimeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAvailableLockersByRackIDAndBookingTimeResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getAvailableLockersByRackIDAndBookingTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_maskedCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_traceId(ctx, field)
-			case "availableGroups":
-				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_availableGroups(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AvailableLockersByRackIDAndBookingTimeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_validateDiscountCoupon(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_validateDiscountCoupon(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_openStatuses(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_openStatuses(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5382,7 +6749,7 @@ func (ec *executionContext) _Query_validateDiscountCoupon(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ValidateDiscountCoupon(rctx, fc.Args["input"].(model.ValidateDiscountCouponInput))
+		return obj.OpenStatuses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5394,49 +6761,26 @@ func (ec *executionContext) _Query_validateDiscountCoupon(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ValidateDiscountCouponResponse)
+	res := resTmp.([]model.OpenStatus)
 	fc.Result = res
-	return ec.marshalNValidateDiscountCouponResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐValidateDiscountCouponResponse(ctx, field.Selections, res)
+	return ec.marshalNOpenStatus2ᚕbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatusᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_validateDiscountCoupon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_openStatuses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_ValidateDiscountCouponResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_ValidateDiscountCouponResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_ValidateDiscountCouponResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_ValidateDiscountCouponResponse_traceId(ctx, field)
-			case "discountPercentage":
-				return ec.fieldContext_ValidateDiscountCouponResponse_discountPercentage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ValidateDiscountCouponResponse", field.Name)
+			return nil, errors.New("field of type OpenStatus does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_validateDiscountCoupon_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getPurchaseOrderByPo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getPurchaseOrderByPo(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_outcome(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_outcome(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5449,7 +6793,7 @@ func (ec *executionContext) _Query_getPurchaseOrderByPo(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetPurchaseOrderByPo(rctx, fc.Args["input"].(model.GetPurchaseOrderByPoInput))
+		return obj.Outcome, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5461,49 +6805,26 @@ func (ec *executionContext) _Query_getPurchaseOrderByPo(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PurchaseOrderResponse)
+	res := resTmp.(model.LockerOpenOutcome)
 	fc.Result = res
-	return ec.marshalNPurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderResponse(ctx, field.Selections, res)
+	return ec.marshalNLockerOpenOutcome2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getPurchaseOrderByPo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_outcome(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_PurchaseOrderResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_PurchaseOrderResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_PurchaseOrderResponse_status(ctx, field)
-			case "traceId":
-				return ec.fieldContext_PurchaseOrderResponse_traceId(ctx, field)
-			case "purchaseOrderData":
-				return ec.fieldContext_PurchaseOrderResponse_purchaseOrderData(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PurchaseOrderResponse", field.Name)
+			return nil, errors.New("field of type LockerOpenOutcome does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getPurchaseOrderByPo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_checkBookingStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_checkBookingStatus(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_reason(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5516,59 +6837,35 @@ func (ec *executionContext) _Query_checkBookingStatus(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CheckBookingStatus(rctx, fc.Args["input"].(model.CheckBookingStatusInput))
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.CheckBookingStatusResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNCheckBookingStatusResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_checkBookingStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transactionId":
-				return ec.fieldContext_CheckBookingStatusResponse_transactionId(ctx, field)
-			case "message":
-				return ec.fieldContext_CheckBookingStatusResponse_message(ctx, field)
-			case "status":
-				return ec.fieldContext_CheckBookingStatusResponse_status(ctx, field)
-			case "booking":
-				return ec.fieldContext_CheckBookingStatusResponse_booking(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CheckBookingStatusResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_checkBookingStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_upstreamTransactionId(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_upstreamTransactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5581,7 +6878,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.UpstreamTransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5590,61 +6887,26 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_upstreamTransactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _LockerOpenAuditRecord_traceId(ctx context.Context, field graphql.CollectedField, obj *model.LockerOpenAuditRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LockerOpenAuditRecord_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5657,49 +6919,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LockerOpenAuditRecord_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LockerOpenAuditRecord",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ValidateDiscountCouponResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_transactionId(ctx, field)
+func (ec *executionContext) _Mutation_generatePurchaseOrder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_generatePurchaseOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5712,7 +6963,7 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_transactionId(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return ec.resolvers.Mutation().GeneratePurchaseOrder(rctx, fc.Args["input"].(model.GeneratePurchaseOrderInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5724,26 +6975,51 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_transactionId(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.GeneratePurchaseOrderResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNGeneratePurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_generatePurchaseOrder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ValidateDiscountCouponResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_traceId(ctx, field)
+			case "url":
+				return ec.fieldContext_GeneratePurchaseOrderResponse_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GeneratePurchaseOrderResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_generatePurchaseOrder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ValidateDiscountCouponResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_message(ctx, field)
+func (ec *executionContext) _Mutation_generateBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_generateBooking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5756,7 +7032,7 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_message(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Mutation().GenerateBooking(rctx, fc.Args["input"].(model.GenerateBookingInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5768,26 +7044,53 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_message(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.GenerateBookingResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNGenerateBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_generateBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ValidateDiscountCouponResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_GenerateBookingResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_GenerateBookingResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_GenerateBookingResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_GenerateBookingResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_GenerateBookingResponse_traceId(ctx, field)
+			case "code":
+				return ec.fieldContext_GenerateBookingResponse_code(ctx, field)
+			case "codeQr":
+				return ec.fieldContext_GenerateBookingResponse_codeQr(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GenerateBookingResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_generateBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ValidateDiscountCouponResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_status(ctx, field)
+func (ec *executionContext) _Mutation_executeOpen(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_executeOpen(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5800,7 +7103,7 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_status(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return ec.resolvers.Mutation().ExecuteOpen(rctx, fc.Args["input"].(model.ExecuteOpenInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5812,26 +7115,51 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_status(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResponseStatus)
+	res := resTmp.(*model.ExecuteOpenResponse)
 	fc.Result = res
-	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+	return ec.marshalNExecuteOpenResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_executeOpen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ValidateDiscountCouponResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ResponseStatus does not have child fields")
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_ExecuteOpenResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_ExecuteOpenResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_ExecuteOpenResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_ExecuteOpenResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_ExecuteOpenResponse_traceId(ctx, field)
+			case "openStatus":
+				return ec.fieldContext_ExecuteOpenResponse_openStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExecuteOpenResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_executeOpen_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ValidateDiscountCouponResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_traceId(ctx, field)
+func (ec *executionContext) _Mutation_cancelBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cancelBooking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5844,7 +7172,7 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_traceId(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraceID, nil
+		return ec.resolvers.Mutation().CancelBooking(rctx, fc.Args["input"].(model.CancelBookingInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5856,26 +7184,57 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_traceId(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.CancelBookingResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCancelBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancelBookingResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ValidateDiscountCouponResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_CancelBookingResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_CancelBookingResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_CancelBookingResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_CancelBookingResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_CancelBookingResponse_traceId(ctx, field)
+			case "bookingId":
+				return ec.fieldContext_CancelBookingResponse_bookingId(ctx, field)
+			case "purchaseOrder":
+				return ec.fieldContext_CancelBookingResponse_purchaseOrder(ctx, field)
+			case "cancellationStatus":
+				return ec.fieldContext_CancelBookingResponse_cancellationStatus(ctx, field)
+			case "refund":
+				return ec.fieldContext_CancelBookingResponse_refund(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CancelBookingResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ValidateDiscountCouponResponse_discountPercentage(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_discountPercentage(ctx, field)
+func (ec *executionContext) _Mutation_extendBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_extendBooking(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5888,7 +7247,7 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_discountPercentage(c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DiscountPercentage, nil
+		return ec.resolvers.Mutation().ExtendBooking(rctx, fc.Args["input"].(model.ExtendBookingInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5900,28 +7259,63 @@ func (ec *executionContext) _ValidateDiscountCouponResponse_discountPercentage(c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*model.ExtendBookingResponse)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNExtendBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExtendBookingResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_discountPercentage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_extendBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ValidateDiscountCouponResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_ExtendBookingResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_ExtendBookingResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_ExtendBookingResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_ExtendBookingResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_ExtendBookingResponse_traceId(ctx, field)
+			case "purchaseOrder":
+				return ec.fieldContext_ExtendBookingResponse_purchaseOrder(ctx, field)
+			case "url":
+				return ec.fieldContext_ExtendBookingResponse_url(ctx, field)
+			case "bookingTime":
+				return ec.fieldContext_ExtendBookingResponse_bookingTime(ctx, field)
+			case "price":
+				return ec.fieldContext_ExtendBookingResponse_price(ctx, field)
+			case "currentFinishBooking":
+				return ec.fieldContext_ExtendBookingResponse_currentFinishBooking(ctx, field)
+			case "newFinishBooking":
+				return ec.fieldContext_ExtendBookingResponse_newFinishBooking(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtendBookingResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
-	if err != nil {
-		return graphql.Null
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_extendBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_invalidatePaymentInfraCache(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_invalidatePaymentInfraCache(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
@@ -5932,7 +7326,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().InvalidatePaymentInfraCache(rctx, fc.Args["qrValue"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5944,26 +7338,37 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_invalidatePaymentInfraCache(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_invalidatePaymentInfraCache_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _PaymentBookingTime_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentBookingTime_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5976,35 +7381,38 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentBookingTime_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PaymentBookingTime",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _PaymentBookingTime_name(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentBookingTime_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6017,7 +7425,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6029,26 +7437,26 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentBookingTime_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PaymentBookingTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _PaymentBookingTime_unitMeasurement(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentBookingTime_unitMeasurement(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6061,7 +7469,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.UnitMeasurement, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6073,26 +7481,26 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(model.UnitMeasurement)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNUnitMeasurement2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐUnitMeasurement(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentBookingTime_unitMeasurement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PaymentBookingTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type UnitMeasurement does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _PaymentBookingTime_amount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentBookingTime_amount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6105,7 +7513,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6117,51 +7525,84 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentBookingTime_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PaymentBookingTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentBookingTime_availableGroups(ctx context.Context, field graphql.CollectedField, obj *model.PaymentBookingTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentBookingTime_availableGroups(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.PaymentBookingTime().AvailableGroups(rctx, obj)
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AvailablePaymentGroup)
+	fc.Result = res
+	return ec.marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroupᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaymentBookingTime_availableGroups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentBookingTime",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "groupId":
+				return ec.fieldContext_AvailablePaymentGroup_groupId(ctx, field)
+			case "name":
+				return ec.fieldContext_AvailablePaymentGroup_name(ctx, field)
+			case "price":
+				return ec.fieldContext_AvailablePaymentGroup_price(ctx, field)
+			case "description":
+				return ec.fieldContext_AvailablePaymentGroup_description(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_AvailablePaymentGroup_imageUrl(ctx, field)
+			case "quote":
+				return ec.fieldContext_AvailablePaymentGroup_quote(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AvailablePaymentGroup", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _PaymentGateway_name(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6191,9 +7632,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PaymentGateway",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6204,8 +7645,8 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _PaymentGateway_displayName(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_displayName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6218,25 +7659,28 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PaymentGateway",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6245,8 +7689,8 @@ func (ec *executionContext) fieldContext___EnumValue_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _PaymentGateway_logoUrl(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_logoUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6259,7 +7703,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.LogoURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6271,26 +7715,26 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_logoUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PaymentGateway",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _PaymentGateway_supportedCurrencies(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_supportedCurrencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6303,25 +7747,28 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.SupportedCurrencies, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_supportedCurrencies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PaymentGateway",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6330,8 +7777,8 @@ func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _PaymentGateway_minAmount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_minAmount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6344,7 +7791,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MinAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6356,26 +7803,26 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_minAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentGateway",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _PaymentGateway_maxAmount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentGateway) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentGateway_maxAmount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6388,35 +7835,38 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.MaxAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentGateway_maxAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentGateway",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_transactionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6429,7 +7879,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6441,51 +7891,26 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6498,7 +7923,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6510,50 +7935,26 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6566,38 +7967,35 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6610,35 +8008,38 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.ResponseStatus)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_traceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6651,7 +8052,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6668,9 +8069,9 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6681,8 +8082,8 @@ func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_paymentRack(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_paymentRack(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6695,7 +8096,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.PaymentRack, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6704,26 +8105,36 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.PaymentRack)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOPaymentRack2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentRack(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_paymentRack(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PaymentRack_id(ctx, field)
+			case "description":
+				return ec.fieldContext_PaymentRack_description(ctx, field)
+			case "address":
+				return ec.fieldContext_PaymentRack_address(ctx, field)
+			case "availableGroups":
+				return ec.fieldContext_PaymentRack_availableGroups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentRack", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_installation(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_installation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6736,62 +8147,49 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Installation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.PaymentInstallation)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOPaymentInstallation2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInstallation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_installation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_PaymentInstallation_id(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				return ec.fieldContext_PaymentInstallation_name(ctx, field)
+			case "region":
+				return ec.fieldContext_PaymentInstallation_region(ctx, field)
+			case "city":
+				return ec.fieldContext_PaymentInstallation_city(ctx, field)
+			case "address":
+				return ec.fieldContext_PaymentInstallation_address(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_PaymentInstallation_imageUrl(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PaymentInstallation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_branding(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_branding(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6804,7 +8202,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Branding, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6813,26 +8211,34 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.TenantBranding)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTenantBranding2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantBranding(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_branding(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "logoUrl":
+				return ec.fieldContext_TenantBranding_logoUrl(ctx, field)
+			case "primaryColor":
+				return ec.fieldContext_TenantBranding_primaryColor(ctx, field)
+			case "secondaryColor":
+				return ec.fieldContext_TenantBranding_secondaryColor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TenantBranding", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_isDeprecated(ctx, field)
+func (ec *executionContext) _PaymentInfraResponse_bookingTimes(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInfraResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInfraResponse_bookingTimes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6845,7 +8251,7 @@ func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.BookingTimes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6857,26 +8263,38 @@ func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.PaymentBookingTime)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTimeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInfraResponse_bookingTimes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInfraResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PaymentBookingTime_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PaymentBookingTime_name(ctx, field)
+			case "unitMeasurement":
+				return ec.fieldContext_PaymentBookingTime_unitMeasurement(ctx, field)
+			case "amount":
+				return ec.fieldContext_PaymentBookingTime_amount(ctx, field)
+			case "availableGroups":
+				return ec.fieldContext_PaymentBookingTime_availableGroups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentBookingTime", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_deprecationReason(ctx, field)
+func (ec *executionContext) _PaymentInstallation_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6889,35 +8307,38 @@ func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _PaymentInstallation_name(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6930,25 +8351,28 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6957,8 +8381,8 @@ func (ec *executionContext) fieldContext___Schema_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _PaymentInstallation_region(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_region(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6971,7 +8395,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.Region, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6983,50 +8407,26 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_region(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _PaymentInstallation_city(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_city(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7039,7 +8439,7 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.City, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7051,50 +8451,26 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _PaymentInstallation_address(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7107,59 +8483,38 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _PaymentInstallation_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.PaymentInstallation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentInstallation_imageUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7172,59 +8527,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.ImageURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentInstallation_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentInstallation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _PaymentQuote_groupId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_groupId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7237,7 +8571,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.GroupID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7249,38 +8583,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_groupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _PaymentQuote_couponCode(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_couponCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7293,38 +8615,35 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.CouponCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_couponCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _PaymentQuote_couponApplied(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_couponApplied(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7337,35 +8656,38 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.CouponApplied, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_couponApplied(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _PaymentQuote_price(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_price(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7378,35 +8700,38 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Price, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _PaymentQuote_discountPercentage(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_discountPercentage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7419,35 +8744,38 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.DiscountPercentage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_discountPercentage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _PaymentQuote_discountAmount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_discountAmount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7460,60 +8788,38 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.DiscountAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_discountAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _PaymentQuote_total(ctx context.Context, field graphql.CollectedField, obj *model.PaymentQuote) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentQuote_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7526,59 +8832,38 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentQuote_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentQuote",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _PaymentRack_id(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentRack_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7591,59 +8876,38 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentRack_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentRack",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _PaymentRack_description(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentRack_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7656,56 +8920,38 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentRack_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentRack",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _PaymentRack_address(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentRack_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7718,49 +8964,38 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentRack_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentRack",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _PaymentRack_availableGroups(ctx context.Context, field graphql.CollectedField, obj *model.PaymentRack) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaymentRack_availableGroups(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7773,59 +9008,63 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.PaymentRack().AvailableGroups(rctx, obj, fc.Args["bookingTimeId"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.AvailablePaymentGroup)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroupᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PaymentRack_availableGroups(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PaymentRack",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "groupId":
+				return ec.fieldContext_AvailablePaymentGroup_groupId(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				return ec.fieldContext_AvailablePaymentGroup_name(ctx, field)
+			case "price":
+				return ec.fieldContext_AvailablePaymentGroup_price(ctx, field)
+			case "description":
+				return ec.fieldContext_AvailablePaymentGroup_description(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_AvailablePaymentGroup_imageUrl(ctx, field)
+			case "quote":
+				return ec.fieldContext_AvailablePaymentGroup_quote(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AvailablePaymentGroup", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_PaymentRack_availableGroups_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) _PurchaseOrderData_couponId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_couponId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7838,420 +9077,6405 @@ func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsOneOf(), nil
+		return obj.CouponID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurchaseOrderData_couponId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PurchaseOrderData",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _PurchaseOrderData_bookingReference(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_bookingReference(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BookingReference, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
 
-func (ec *executionContext) unmarshalInputCheckBookingStatusInput(ctx context.Context, obj any) (model.CheckBookingStatusInput, error) {
-	var it model.CheckBookingStatusInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_PurchaseOrderData_bookingReference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	fieldsInOrder := [...]string{"serviceName", "currentCode"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) _PurchaseOrderData_oc(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_oc(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "serviceName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ServiceName = data
-		case "currentCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CurrentCode = data
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Oc, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputExecuteOpenInput(ctx context.Context, obj any) (model.ExecuteOpenInput, error) {
-	var it model.ExecuteOpenInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_PurchaseOrderData_oc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	fieldsInOrder := [...]string{"serviceName", "currentCode"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) _PurchaseOrderData_email(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_email(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "serviceName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ServiceName = data
-		case "currentCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CurrentCode = data
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputGenerateBookingInput(ctx context.Context, obj any) (model.GenerateBookingInput, error) {
-	var it model.GenerateBookingInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_PurchaseOrderData_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	fieldsInOrder := [...]string{"rackIdReference", "groupId", "couponCode", "userEmail", "userPhone", "traceId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) _PurchaseOrderData_phone(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_phone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "rackIdReference":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackIdReference"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.RackIDReference = data
-		case "groupId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Phone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_discount(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_discount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Discount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_productPrice(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_productPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProductPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_productPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_finalProductPrice(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_finalProductPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FinalProductPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_finalProductPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_productName(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_productName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProductName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_productDescription(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_productDescription(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProductDescription, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_productDescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_lockerPosition(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_lockerPosition(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LockerPosition, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_lockerPosition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_installationName(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_installationName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InstallationName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_installationName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_deviceSerieNum(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_deviceSerieNum(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeviceSerieNum, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_deviceSerieNum(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderData_status(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderData_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderData_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderData",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_transactionId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TransactionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_detail(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Detail, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ResponseStatus)
+	fc.Result = res
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_traceId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurchaseOrderResponse_purchaseOrderData(ctx context.Context, field graphql.CollectedField, obj *model.PurchaseOrderResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurchaseOrderResponse_purchaseOrderData(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PurchaseOrderData, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PurchaseOrderData)
+	fc.Result = res
+	return ec.marshalNPurchaseOrderData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderData(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurchaseOrderResponse_purchaseOrderData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurchaseOrderResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "couponId":
+				return ec.fieldContext_PurchaseOrderData_couponId(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_PurchaseOrderData_bookingReference(ctx, field)
+			case "oc":
+				return ec.fieldContext_PurchaseOrderData_oc(ctx, field)
+			case "email":
+				return ec.fieldContext_PurchaseOrderData_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_PurchaseOrderData_phone(ctx, field)
+			case "discount":
+				return ec.fieldContext_PurchaseOrderData_discount(ctx, field)
+			case "productPrice":
+				return ec.fieldContext_PurchaseOrderData_productPrice(ctx, field)
+			case "finalProductPrice":
+				return ec.fieldContext_PurchaseOrderData_finalProductPrice(ctx, field)
+			case "productName":
+				return ec.fieldContext_PurchaseOrderData_productName(ctx, field)
+			case "productDescription":
+				return ec.fieldContext_PurchaseOrderData_productDescription(ctx, field)
+			case "lockerPosition":
+				return ec.fieldContext_PurchaseOrderData_lockerPosition(ctx, field)
+			case "installationName":
+				return ec.fieldContext_PurchaseOrderData_installationName(ctx, field)
+			case "deviceSerieNum":
+				return ec.fieldContext_PurchaseOrderData_deviceSerieNum(ctx, field)
+			case "status":
+				return ec.fieldContext_PurchaseOrderData_status(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PurchaseOrderData", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_format(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.QRImageFormat)
+	fc.Result = res
+	return ec.marshalNQrImageFormat2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type QrImageFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_mimeType(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_mimeType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MimeType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_mimeType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_size(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_payload(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_payload(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_payload(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_data(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_data(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QrImage_dataUri(ctx context.Context, field graphql.CollectedField, obj *model.QRImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_QrImage_dataUri(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataURI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_QrImage_dataUri(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QrImage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getPaymentInfraByQrValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getPaymentInfraByQrValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetPaymentInfraByQRValue(rctx, fc.Args["input"].(model.GetPaymentInfraByQRValueInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PaymentInfraResponse)
+	fc.Result = res
+	return ec.marshalNPaymentInfraResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInfraResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getPaymentInfraByQrValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_PaymentInfraResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_PaymentInfraResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_PaymentInfraResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_PaymentInfraResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_PaymentInfraResponse_traceId(ctx, field)
+			case "paymentRack":
+				return ec.fieldContext_PaymentInfraResponse_paymentRack(ctx, field)
+			case "installation":
+				return ec.fieldContext_PaymentInfraResponse_installation(ctx, field)
+			case "branding":
+				return ec.fieldContext_PaymentInfraResponse_branding(ctx, field)
+			case "bookingTimes":
+				return ec.fieldContext_PaymentInfraResponse_bookingTimes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentInfraResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getPaymentInfraByQrValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getAvailableLockersByRackIDAndBookingTime(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAvailableLockersByRackIDAndBookingTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAvailableLockersByRackIDAndBookingTime(rctx, fc.Args["input"].(model.GetAvailableLockersByRackIDAndBookingTimeInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AvailableLockersByRackIDAndBookingTimeResponse)
+	fc.Result = res
+	return ec.marshalNAvailableLockersByRackIDAndBookingTimeResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getAvailableLockersByRackIDAndBookingTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_traceId(ctx, field)
+			case "availableGroups":
+				return ec.fieldContext_AvailableLockersByRackIDAndBookingTimeResponse_availableGroups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AvailableLockersByRackIDAndBookingTimeResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAvailableLockersByRackIDAndBookingTime_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_validateDiscountCoupon(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_validateDiscountCoupon(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ValidateDiscountCoupon(rctx, fc.Args["input"].(model.ValidateDiscountCouponInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ValidateDiscountCouponResponse)
+	fc.Result = res
+	return ec.marshalNValidateDiscountCouponResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐValidateDiscountCouponResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_validateDiscountCoupon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_ValidateDiscountCouponResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_ValidateDiscountCouponResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_ValidateDiscountCouponResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_ValidateDiscountCouponResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_ValidateDiscountCouponResponse_traceId(ctx, field)
+			case "discountPercentage":
+				return ec.fieldContext_ValidateDiscountCouponResponse_discountPercentage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ValidateDiscountCouponResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_validateDiscountCoupon_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getPurchaseOrderByPo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getPurchaseOrderByPo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetPurchaseOrderByPo(rctx, fc.Args["input"].(model.GetPurchaseOrderByPoInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PurchaseOrderResponse)
+	fc.Result = res
+	return ec.marshalNPurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getPurchaseOrderByPo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_PurchaseOrderResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_PurchaseOrderResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_PurchaseOrderResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_PurchaseOrderResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_PurchaseOrderResponse_traceId(ctx, field)
+			case "purchaseOrderData":
+				return ec.fieldContext_PurchaseOrderResponse_purchaseOrderData(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PurchaseOrderResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getPurchaseOrderByPo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_checkBookingStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_checkBookingStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CheckBookingStatus(rctx, fc.Args["input"].(model.CheckBookingStatusInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.CheckBookingStatusResponse)
+	fc.Result = res
+	return ec.marshalNCheckBookingStatusResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_checkBookingStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactionId":
+				return ec.fieldContext_CheckBookingStatusResponse_transactionId(ctx, field)
+			case "message":
+				return ec.fieldContext_CheckBookingStatusResponse_message(ctx, field)
+			case "detail":
+				return ec.fieldContext_CheckBookingStatusResponse_detail(ctx, field)
+			case "status":
+				return ec.fieldContext_CheckBookingStatusResponse_status(ctx, field)
+			case "traceId":
+				return ec.fieldContext_CheckBookingStatusResponse_traceId(ctx, field)
+			case "booking":
+				return ec.fieldContext_CheckBookingStatusResponse_booking(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CheckBookingStatusResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_checkBookingStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_availablePaymentGateways(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_availablePaymentGateways(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AvailablePaymentGateways(rctx, fc.Args["rackId"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PaymentGateway)
+	fc.Result = res
+	return ec.marshalNPaymentGateway2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentGatewayᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_availablePaymentGateways(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_PaymentGateway_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_PaymentGateway_displayName(ctx, field)
+			case "logoUrl":
+				return ec.fieldContext_PaymentGateway_logoUrl(ctx, field)
+			case "supportedCurrencies":
+				return ec.fieldContext_PaymentGateway_supportedCurrencies(ctx, field)
+			case "minAmount":
+				return ec.fieldContext_PaymentGateway_minAmount(ctx, field)
+			case "maxAmount":
+				return ec.fieldContext_PaymentGateway_maxAmount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentGateway", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_availablePaymentGateways_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_lockerOpenAudit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_lockerOpenAudit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LockerOpenAudit(rctx, fc.Args["serviceName"].(string), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["outcome"].(*model.LockerOpenOutcome), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.LockerOpenAuditPage)
+	fc.Result = res
+	return ec.marshalNLockerOpenAuditPage2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditPage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_lockerOpenAudit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "records":
+				return ec.fieldContext_LockerOpenAuditPage_records(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LockerOpenAuditPage_totalCount(ctx, field)
+			case "limit":
+				return ec.fieldContext_LockerOpenAuditPage_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_LockerOpenAuditPage_offset(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_LockerOpenAuditPage_hasNextPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LockerOpenAuditPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_lockerOpenAudit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_tenant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_tenant(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Tenant(rctx, fc.Args["serviceName"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Tenant)
+	fc.Result = res
+	return ec.marshalOTenant2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenant(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_tenant(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Tenant_id(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Tenant_displayName(ctx, field)
+			case "branding":
+				return ec.fieldContext_Tenant_branding(ctx, field)
+			case "gateways":
+				return ec.fieldContext_Tenant_gateways(ctx, field)
+			case "features":
+				return ec.fieldContext_Tenant_features(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tenant", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_tenant_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_featureFlags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_featureFlags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FeatureFlags(rctx, fc.Args["rackId"].(*int), fc.Args["installationId"].(*int), fc.Args["key"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.FeatureFlag)
+	fc.Result = res
+	return ec.marshalNFeatureFlag2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐFeatureFlagᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_featureFlags(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_FeatureFlag_name(ctx, field)
+			case "enabled":
+				return ec.fieldContext_FeatureFlag_enabled(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FeatureFlag", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_featureFlags_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Refund_refundId(ctx context.Context, field graphql.CollectedField, obj *model.Refund) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Refund_refundId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RefundID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Refund_refundId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Refund",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Refund_status(ctx context.Context, field graphql.CollectedField, obj *model.Refund) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Refund_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.RefundStatus)
+	fc.Result = res
+	return ec.marshalNRefundStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐRefundStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Refund_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Refund",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RefundStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Refund_amount(ctx context.Context, field graphql.CollectedField, obj *model.Refund) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Refund_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Refund_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Refund",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Refund_message(ctx context.Context, field graphql.CollectedField, obj *model.Refund) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Refund_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Refund_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Refund",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Refund_detail(ctx context.Context, field graphql.CollectedField, obj *model.Refund) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Refund_detail(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Detail, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Refund_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Refund",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_purchaseOrderStatus(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_purchaseOrderStatus(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().PurchaseOrderStatus(rctx, fc.Args["purchaseOrder"].(string), fc.Args["traceId"].(*string), fc.Args["locale"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.PurchaseOrderData):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNPurchaseOrderData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderData(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_purchaseOrderStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "couponId":
+				return ec.fieldContext_PurchaseOrderData_couponId(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_PurchaseOrderData_bookingReference(ctx, field)
+			case "oc":
+				return ec.fieldContext_PurchaseOrderData_oc(ctx, field)
+			case "email":
+				return ec.fieldContext_PurchaseOrderData_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_PurchaseOrderData_phone(ctx, field)
+			case "discount":
+				return ec.fieldContext_PurchaseOrderData_discount(ctx, field)
+			case "productPrice":
+				return ec.fieldContext_PurchaseOrderData_productPrice(ctx, field)
+			case "finalProductPrice":
+				return ec.fieldContext_PurchaseOrderData_finalProductPrice(ctx, field)
+			case "productName":
+				return ec.fieldContext_PurchaseOrderData_productName(ctx, field)
+			case "productDescription":
+				return ec.fieldContext_PurchaseOrderData_productDescription(ctx, field)
+			case "lockerPosition":
+				return ec.fieldContext_PurchaseOrderData_lockerPosition(ctx, field)
+			case "installationName":
+				return ec.fieldContext_PurchaseOrderData_installationName(ctx, field)
+			case "deviceSerieNum":
+				return ec.fieldContext_PurchaseOrderData_deviceSerieNum(ctx, field)
+			case "status":
+				return ec.fieldContext_PurchaseOrderData_status(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PurchaseOrderData", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_purchaseOrderStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Tenant_id(ctx context.Context, field graphql.CollectedField, obj *model.Tenant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tenant_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tenant_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tenant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Tenant_displayName(ctx context.Context, field graphql.CollectedField, obj *model.Tenant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tenant_displayName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tenant_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tenant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Tenant_branding(ctx context.Context, field graphql.CollectedField, obj *model.Tenant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tenant_branding(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Branding, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TenantBranding)
+	fc.Result = res
+	return ec.marshalNTenantBranding2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantBranding(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tenant_branding(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tenant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "logoUrl":
+				return ec.fieldContext_TenantBranding_logoUrl(ctx, field)
+			case "primaryColor":
+				return ec.fieldContext_TenantBranding_primaryColor(ctx, field)
+			case "secondaryColor":
+				return ec.fieldContext_TenantBranding_secondaryColor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TenantBranding", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Tenant_gateways(ctx context.Context, field graphql.CollectedField, obj *model.Tenant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tenant_gateways(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gateways, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tenant_gateways(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tenant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Tenant_features(ctx context.Context, field graphql.CollectedField, obj *model.Tenant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Tenant_features(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Features, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TenantFeature)
+	fc.Result = res
+	return ec.marshalNTenantFeature2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantFeatureᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Tenant_features(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Tenant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_TenantFeature_name(ctx, field)
+			case "enabled":
+				return ec.fieldContext_TenantFeature_enabled(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TenantFeature", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TenantBranding_logoUrl(ctx context.Context, field graphql.CollectedField, obj *model.TenantBranding) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TenantBranding_logoUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogoURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TenantBranding_logoUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TenantBranding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TenantBranding_primaryColor(ctx context.Context, field graphql.CollectedField, obj *model.TenantBranding) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TenantBranding_primaryColor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PrimaryColor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TenantBranding_primaryColor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TenantBranding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TenantBranding_secondaryColor(ctx context.Context, field graphql.CollectedField, obj *model.TenantBranding) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TenantBranding_secondaryColor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SecondaryColor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TenantBranding_secondaryColor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TenantBranding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TenantFeature_name(ctx context.Context, field graphql.CollectedField, obj *model.TenantFeature) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TenantFeature_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TenantFeature_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TenantFeature",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TenantFeature_enabled(ctx context.Context, field graphql.CollectedField, obj *model.TenantFeature) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TenantFeature_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TenantFeature_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TenantFeature",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_transactionId(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_transactionId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TransactionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_transactionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_detail(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Detail, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ResponseStatus)
+	fc.Result = res
+	return ec.marshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ResponseStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_traceId(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_traceId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ValidateDiscountCouponResponse_discountPercentage(ctx context.Context, field graphql.CollectedField, obj *model.ValidateDiscountCouponResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ValidateDiscountCouponResponse_discountPercentage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DiscountPercentage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ValidateDiscountCouponResponse_discountPercentage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ValidateDiscountCouponResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOneOf(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputCancelBookingInput(ctx context.Context, obj any) (model.CancelBookingInput, error) {
+	var it model.CancelBookingInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"serviceName", "currentCode", "purchaseOrder", "reason", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "currentCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CurrentCode = data
+		case "purchaseOrder":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("purchaseOrder"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PurchaseOrder = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCheckBookingStatusInput(ctx context.Context, obj any) (model.CheckBookingStatusInput, error) {
+	var it model.CheckBookingStatusInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"serviceName", "currentCode", "timezone", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "currentCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CurrentCode = data
+		case "timezone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Timezone = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputExecuteOpenInput(ctx context.Context, obj any) (model.ExecuteOpenInput, error) {
+	var it model.ExecuteOpenInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"serviceName", "currentCode", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "currentCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CurrentCode = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputExtendBookingInput(ctx context.Context, obj any) (model.ExtendBookingInput, error) {
+	var it model.ExtendBookingInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"serviceName", "currentCode", "bookingTimeId", "gatewayName", "traceId", "timezone", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "currentCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currentCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CurrentCode = data
+		case "bookingTimeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bookingTimeId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BookingTimeID = data
+		case "gatewayName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gatewayName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GatewayName = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "timezone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Timezone = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGenerateBookingInput(ctx context.Context, obj any) (model.GenerateBookingInput, error) {
+	var it model.GenerateBookingInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"rackIdReference", "groupId", "couponCode", "userEmail", "userPhone", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "rackIdReference":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackIdReference"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RackIDReference = data
+		case "groupId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupID = data
+		case "couponCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CouponCode = data
+		case "userEmail":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userEmail"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserEmail = data
+		case "userPhone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userPhone"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserPhone = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGeneratePurchaseOrderInput(ctx context.Context, obj any) (model.GeneratePurchaseOrderInput, error) {
+	var it model.GeneratePurchaseOrderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"rackIdReference", "groupId", "couponCode", "userEmail", "userPhone", "traceId", "gatewayName", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "rackIdReference":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackIdReference"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RackIDReference = data
+		case "groupId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupID = data
+		case "couponCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CouponCode = data
+		case "userEmail":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userEmail"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserEmail = data
+		case "userPhone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userPhone"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserPhone = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "gatewayName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gatewayName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GatewayName = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetAvailableLockersByRackIDAndBookingTimeInput(ctx context.Context, obj any) (model.GetAvailableLockersByRackIDAndBookingTimeInput, error) {
+	var it model.GetAvailableLockersByRackIDAndBookingTimeInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"paymentRackId", "bookingTimeId", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "paymentRackId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paymentRackId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PaymentRackID = data
+		case "bookingTimeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bookingTimeId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BookingTimeID = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetPaymentInfraByQrValueInput(ctx context.Context, obj any) (model.GetPaymentInfraByQRValueInput, error) {
+	var it model.GetPaymentInfraByQRValueInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"qrValue", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "qrValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("qrValue"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.QRValue = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetPurchaseOrderByPoInput(ctx context.Context, obj any) (model.GetPurchaseOrderByPoInput, error) {
+	var it model.GetPurchaseOrderByPoInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"purchaseOrder", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "purchaseOrder":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("purchaseOrder"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PurchaseOrder = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputValidateDiscountCouponInput(ctx context.Context, obj any) (model.ValidateDiscountCouponInput, error) {
+	var it model.ValidateDiscountCouponInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"couponCode", "rackId", "traceId", "locale"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "couponCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CouponCode = data
+		case "rackId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackId"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RackID = data
+		case "traceId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.GroupID = data
-		case "couponCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			it.TraceID = data
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var availableLockersByRackIDAndBookingTimeResponseImplementors = []string{"AvailableLockersByRackIDAndBookingTimeResponse"}
+
+func (ec *executionContext) _AvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, availableLockersByRackIDAndBookingTimeResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AvailableLockersByRackIDAndBookingTimeResponse")
+		case "transactionId":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableGroups":
+			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_availableGroups(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var availablePaymentGroupImplementors = []string{"AvailablePaymentGroup"}
+
+func (ec *executionContext) _AvailablePaymentGroup(ctx context.Context, sel ast.SelectionSet, obj *model.AvailablePaymentGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, availablePaymentGroupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AvailablePaymentGroup")
+		case "groupId":
+			out.Values[i] = ec._AvailablePaymentGroup_groupId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._AvailablePaymentGroup_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "price":
+			out.Values[i] = ec._AvailablePaymentGroup_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "description":
+			out.Values[i] = ec._AvailablePaymentGroup_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "imageUrl":
+			out.Values[i] = ec._AvailablePaymentGroup_imageUrl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "quote":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AvailablePaymentGroup_quote(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var bookingStatusDataImplementors = []string{"BookingStatusData"}
+
+func (ec *executionContext) _BookingStatusData(ctx context.Context, sel ast.SelectionSet, obj *model.BookingStatusData) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bookingStatusDataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BookingStatusData")
+		case "id":
+			out.Values[i] = ec._BookingStatusData_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "configurationBookingId":
+			out.Values[i] = ec._BookingStatusData_configurationBookingId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "initBooking":
+			out.Values[i] = ec._BookingStatusData_initBooking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finishBooking":
+			out.Values[i] = ec._BookingStatusData_finishBooking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "installationName":
+			out.Values[i] = ec._BookingStatusData_installationName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numberLocker":
+			out.Values[i] = ec._BookingStatusData_numberLocker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deviceId":
+			out.Values[i] = ec._BookingStatusData_deviceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currentCode":
+			out.Values[i] = ec._BookingStatusData_currentCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openings":
+			out.Values[i] = ec._BookingStatusData_openings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceName":
+			out.Values[i] = ec._BookingStatusData_serviceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "emailRecipient":
+			out.Values[i] = ec._BookingStatusData_emailRecipient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._BookingStatusData_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CouponCode = data
-		case "userEmail":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userEmail"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "updatedAt":
+			out.Values[i] = ec._BookingStatusData_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._BookingStatusData_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remainingTime":
+			out.Values[i] = ec._BookingStatusData_remainingTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "canOpen":
+			out.Values[i] = ec._BookingStatusData_canOpen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cancelBookingResponseImplementors = []string{"CancelBookingResponse"}
+
+func (ec *executionContext) _CancelBookingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CancelBookingResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cancelBookingResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CancelBookingResponse")
+		case "transactionId":
+			out.Values[i] = ec._CancelBookingResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._CancelBookingResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._CancelBookingResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CancelBookingResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._CancelBookingResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bookingId":
+			out.Values[i] = ec._CancelBookingResponse_bookingId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purchaseOrder":
+			out.Values[i] = ec._CancelBookingResponse_purchaseOrder(ctx, field, obj)
+		case "cancellationStatus":
+			out.Values[i] = ec._CancelBookingResponse_cancellationStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refund":
+			out.Values[i] = ec._CancelBookingResponse_refund(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkBookingStatusResponseImplementors = []string{"CheckBookingStatusResponse"}
+
+func (ec *executionContext) _CheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CheckBookingStatusResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkBookingStatusResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckBookingStatusResponse")
+		case "transactionId":
+			out.Values[i] = ec._CheckBookingStatusResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._CheckBookingStatusResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._CheckBookingStatusResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CheckBookingStatusResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._CheckBookingStatusResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "booking":
+			out.Values[i] = ec._CheckBookingStatusResponse_booking(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var executeOpenResponseImplementors = []string{"ExecuteOpenResponse"}
+
+func (ec *executionContext) _ExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ExecuteOpenResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, executeOpenResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExecuteOpenResponse")
+		case "transactionId":
+			out.Values[i] = ec._ExecuteOpenResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ExecuteOpenResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._ExecuteOpenResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._ExecuteOpenResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._ExecuteOpenResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openStatus":
+			out.Values[i] = ec._ExecuteOpenResponse_openStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var extendBookingResponseImplementors = []string{"ExtendBookingResponse"}
+
+func (ec *executionContext) _ExtendBookingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ExtendBookingResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, extendBookingResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExtendBookingResponse")
+		case "transactionId":
+			out.Values[i] = ec._ExtendBookingResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ExtendBookingResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._ExtendBookingResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._ExtendBookingResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._ExtendBookingResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purchaseOrder":
+			out.Values[i] = ec._ExtendBookingResponse_purchaseOrder(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "url":
+			out.Values[i] = ec._ExtendBookingResponse_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bookingTime":
+			out.Values[i] = ec._ExtendBookingResponse_bookingTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.UserEmail = data
-		case "userPhone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userPhone"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "price":
+			out.Values[i] = ec._ExtendBookingResponse_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.UserPhone = data
-		case "traceId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "currentFinishBooking":
+			out.Values[i] = ec._ExtendBookingResponse_currentFinishBooking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.TraceID = data
+		case "newFinishBooking":
+			out.Values[i] = ec._ExtendBookingResponse_newFinishBooking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGeneratePurchaseOrderInput(ctx context.Context, obj any) (model.GeneratePurchaseOrderInput, error) {
-	var it model.GeneratePurchaseOrderInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"rackIdReference", "groupId", "couponCode", "userEmail", "userPhone", "traceId", "gatewayName"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "rackIdReference":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackIdReference"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var featureFlagImplementors = []string{"FeatureFlag"}
+
+func (ec *executionContext) _FeatureFlag(ctx context.Context, sel ast.SelectionSet, obj *model.FeatureFlag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, featureFlagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FeatureFlag")
+		case "name":
+			out.Values[i] = ec._FeatureFlag_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RackIDReference = data
-		case "groupId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "enabled":
+			out.Values[i] = ec._FeatureFlag_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.GroupID = data
-		case "couponCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generateBookingResponseImplementors = []string{"GenerateBookingResponse"}
+
+func (ec *executionContext) _GenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateBookingResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generateBookingResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GenerateBookingResponse")
+		case "transactionId":
+			out.Values[i] = ec._GenerateBookingResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.CouponCode = data
-		case "userEmail":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userEmail"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "message":
+			out.Values[i] = ec._GenerateBookingResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.UserEmail = data
-		case "userPhone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userPhone"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "detail":
+			out.Values[i] = ec._GenerateBookingResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._GenerateBookingResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.UserPhone = data
 		case "traceId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._GenerateBookingResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.TraceID = data
-		case "gatewayName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gatewayName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "code":
+			out.Values[i] = ec._GenerateBookingResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.GatewayName = data
+		case "codeQr":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GenerateBookingResponse_codeQr(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGetAvailableLockersByRackIDAndBookingTimeInput(ctx context.Context, obj any) (model.GetAvailableLockersByRackIDAndBookingTimeInput, error) {
-	var it model.GetAvailableLockersByRackIDAndBookingTimeInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"paymentRackId", "bookingTimeId", "traceId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "paymentRackId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paymentRackId"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var generatePurchaseOrderResponseImplementors = []string{"GeneratePurchaseOrderResponse"}
+
+func (ec *executionContext) _GeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePurchaseOrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generatePurchaseOrderResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GeneratePurchaseOrderResponse")
+		case "transactionId":
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_transactionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PaymentRackID = data
-		case "bookingTimeId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bookingTimeId"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "message":
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "detail":
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.BookingTimeID = data
 		case "traceId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.TraceID = data
+		case "url":
+			out.Values[i] = ec._GeneratePurchaseOrderResponse_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputGetPaymentInfraByQrValueInput(ctx context.Context, obj any) (model.GetPaymentInfraByQRValueInput, error) {
-	var it model.GetPaymentInfraByQRValueInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-	fieldsInOrder := [...]string{"qrValue"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "qrValue":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("qrValue"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.QRValue = data
-		}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputGetPurchaseOrderByPoInput(ctx context.Context, obj any) (model.GetPurchaseOrderByPoInput, error) {
-	var it model.GetPurchaseOrderByPoInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var lockerOpenAuditPageImplementors = []string{"LockerOpenAuditPage"}
 
-	fieldsInOrder := [...]string{"purchaseOrder", "traceId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "purchaseOrder":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("purchaseOrder"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _LockerOpenAuditPage(ctx context.Context, sel ast.SelectionSet, obj *model.LockerOpenAuditPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lockerOpenAuditPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LockerOpenAuditPage")
+		case "records":
+			out.Values[i] = ec._LockerOpenAuditPage_records(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PurchaseOrder = data
-		case "traceId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "totalCount":
+			out.Values[i] = ec._LockerOpenAuditPage_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.TraceID = data
+		case "limit":
+			out.Values[i] = ec._LockerOpenAuditPage_limit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offset":
+			out.Values[i] = ec._LockerOpenAuditPage_offset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasNextPage":
+			out.Values[i] = ec._LockerOpenAuditPage_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputValidateDiscountCouponInput(ctx context.Context, obj any) (model.ValidateDiscountCouponInput, error) {
-	var it model.ValidateDiscountCouponInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"couponCode", "rackId", "traceId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "couponCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("couponCode"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var lockerOpenAuditRecordImplementors = []string{"LockerOpenAuditRecord"}
+
+func (ec *executionContext) _LockerOpenAuditRecord(ctx context.Context, sel ast.SelectionSet, obj *model.LockerOpenAuditRecord) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lockerOpenAuditRecordImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LockerOpenAuditRecord")
+		case "id":
+			out.Values[i] = ec._LockerOpenAuditRecord_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CouponCode = data
-		case "rackId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rackId"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "timestamp":
+			out.Values[i] = ec._LockerOpenAuditRecord_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RackID = data
+		case "principal":
+			out.Values[i] = ec._LockerOpenAuditRecord_principal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clientIp":
+			out.Values[i] = ec._LockerOpenAuditRecord_clientIp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "userAgent":
+			out.Values[i] = ec._LockerOpenAuditRecord_userAgent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceName":
+			out.Values[i] = ec._LockerOpenAuditRecord_serviceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maskedCode":
+			out.Values[i] = ec._LockerOpenAuditRecord_maskedCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openStatuses":
+			out.Values[i] = ec._LockerOpenAuditRecord_openStatuses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "outcome":
+			out.Values[i] = ec._LockerOpenAuditRecord_outcome(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._LockerOpenAuditRecord_reason(ctx, field, obj)
+		case "upstreamTransactionId":
+			out.Values[i] = ec._LockerOpenAuditRecord_upstreamTransactionId(ctx, field, obj)
 		case "traceId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("traceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._LockerOpenAuditRecord_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.TraceID = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var availableLockersByRackIDAndBookingTimeResponseImplementors = []string{"AvailableLockersByRackIDAndBookingTimeResponse"}
+var mutationImplementors = []string{"Mutation"}
 
-func (ec *executionContext) _AvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, availableLockersByRackIDAndBookingTimeResponseImplementors)
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AvailableLockersByRackIDAndBookingTimeResponse")
-		case "transactionId":
-			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "generatePurchaseOrder":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_generatePurchaseOrder(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_message(ctx, field, obj)
+		case "generateBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_generateBooking(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_status(ctx, field, obj)
+		case "executeOpen":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_executeOpen(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "traceId":
-			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_traceId(ctx, field, obj)
+		case "cancelBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelBooking(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "availableGroups":
-			out.Values[i] = ec._AvailableLockersByRackIDAndBookingTimeResponse_availableGroups(ctx, field, obj)
+		case "extendBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_extendBooking(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "invalidatePaymentInfraCache":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_invalidatePaymentInfraCache(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8278,42 +15502,73 @@ func (ec *executionContext) _AvailableLockersByRackIDAndBookingTimeResponse(ctx
 	return out
 }
 
-var availablePaymentGroupImplementors = []string{"AvailablePaymentGroup"}
+var paymentBookingTimeImplementors = []string{"PaymentBookingTime"}
 
-func (ec *executionContext) _AvailablePaymentGroup(ctx context.Context, sel ast.SelectionSet, obj *model.AvailablePaymentGroup) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, availablePaymentGroupImplementors)
+func (ec *executionContext) _PaymentBookingTime(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentBookingTime) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentBookingTimeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AvailablePaymentGroup")
-		case "groupId":
-			out.Values[i] = ec._AvailablePaymentGroup_groupId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentBookingTime")
+		case "id":
+			out.Values[i] = ec._PaymentBookingTime_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "name":
-			out.Values[i] = ec._AvailablePaymentGroup_name(ctx, field, obj)
+			out.Values[i] = ec._PaymentBookingTime_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "price":
-			out.Values[i] = ec._AvailablePaymentGroup_price(ctx, field, obj)
+		case "unitMeasurement":
+			out.Values[i] = ec._PaymentBookingTime_unitMeasurement(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "description":
-			out.Values[i] = ec._AvailablePaymentGroup_description(ctx, field, obj)
+		case "amount":
+			out.Values[i] = ec._PaymentBookingTime_amount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "imageUrl":
-			out.Values[i] = ec._AvailablePaymentGroup_imageUrl(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+		case "availableGroups":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PaymentBookingTime_availableGroups(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8337,79 +15592,44 @@ func (ec *executionContext) _AvailablePaymentGroup(ctx context.Context, sel ast.
 	return out
 }
 
-var bookingStatusDataImplementors = []string{"BookingStatusData"}
+var paymentGatewayImplementors = []string{"PaymentGateway"}
 
-func (ec *executionContext) _BookingStatusData(ctx context.Context, sel ast.SelectionSet, obj *model.BookingStatusData) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, bookingStatusDataImplementors)
+func (ec *executionContext) _PaymentGateway(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentGateway) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentGatewayImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("BookingStatusData")
-		case "id":
-			out.Values[i] = ec._BookingStatusData_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "configurationBookingId":
-			out.Values[i] = ec._BookingStatusData_configurationBookingId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "initBooking":
-			out.Values[i] = ec._BookingStatusData_initBooking(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "finishBooking":
-			out.Values[i] = ec._BookingStatusData_finishBooking(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "installationName":
-			out.Values[i] = ec._BookingStatusData_installationName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "numberLocker":
-			out.Values[i] = ec._BookingStatusData_numberLocker(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deviceId":
-			out.Values[i] = ec._BookingStatusData_deviceId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "currentCode":
-			out.Values[i] = ec._BookingStatusData_currentCode(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentGateway")
+		case "name":
+			out.Values[i] = ec._PaymentGateway_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "openings":
-			out.Values[i] = ec._BookingStatusData_openings(ctx, field, obj)
+		case "displayName":
+			out.Values[i] = ec._PaymentGateway_displayName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "serviceName":
-			out.Values[i] = ec._BookingStatusData_serviceName(ctx, field, obj)
+		case "logoUrl":
+			out.Values[i] = ec._PaymentGateway_logoUrl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "emailRecipient":
-			out.Values[i] = ec._BookingStatusData_emailRecipient(ctx, field, obj)
+		case "supportedCurrencies":
+			out.Values[i] = ec._PaymentGateway_supportedCurrencies(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._BookingStatusData_createdAt(ctx, field, obj)
+		case "minAmount":
+			out.Values[i] = ec._PaymentGateway_minAmount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._BookingStatusData_updatedAt(ctx, field, obj)
+		case "maxAmount":
+			out.Values[i] = ec._PaymentGateway_maxAmount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8436,34 +15656,50 @@ func (ec *executionContext) _BookingStatusData(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var checkBookingStatusResponseImplementors = []string{"CheckBookingStatusResponse"}
+var paymentInfraResponseImplementors = []string{"PaymentInfraResponse"}
 
-func (ec *executionContext) _CheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CheckBookingStatusResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, checkBookingStatusResponseImplementors)
+func (ec *executionContext) _PaymentInfraResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentInfraResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentInfraResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CheckBookingStatusResponse")
+			out.Values[i] = graphql.MarshalString("PaymentInfraResponse")
 		case "transactionId":
-			out.Values[i] = ec._CheckBookingStatusResponse_transactionId(ctx, field, obj)
+			out.Values[i] = ec._PaymentInfraResponse_transactionId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._CheckBookingStatusResponse_message(ctx, field, obj)
+			out.Values[i] = ec._PaymentInfraResponse_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "detail":
+			out.Values[i] = ec._PaymentInfraResponse_detail(ctx, field, obj)
 		case "status":
-			out.Values[i] = ec._CheckBookingStatusResponse_status(ctx, field, obj)
+			out.Values[i] = ec._PaymentInfraResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "traceId":
+			out.Values[i] = ec._PaymentInfraResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "paymentRack":
+			out.Values[i] = ec._PaymentInfraResponse_paymentRack(ctx, field, obj)
+		case "installation":
+			out.Values[i] = ec._PaymentInfraResponse_installation(ctx, field, obj)
+		case "branding":
+			out.Values[i] = ec._PaymentInfraResponse_branding(ctx, field, obj)
+		case "bookingTimes":
+			out.Values[i] = ec._PaymentInfraResponse_bookingTimes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "booking":
-			out.Values[i] = ec._CheckBookingStatusResponse_booking(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8487,34 +15723,44 @@ func (ec *executionContext) _CheckBookingStatusResponse(ctx context.Context, sel
 	return out
 }
 
-var executeOpenResponseImplementors = []string{"ExecuteOpenResponse"}
+var paymentInstallationImplementors = []string{"PaymentInstallation"}
 
-func (ec *executionContext) _ExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ExecuteOpenResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, executeOpenResponseImplementors)
+func (ec *executionContext) _PaymentInstallation(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentInstallation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentInstallationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ExecuteOpenResponse")
-		case "transactionId":
-			out.Values[i] = ec._ExecuteOpenResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentInstallation")
+		case "id":
+			out.Values[i] = ec._PaymentInstallation_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._ExecuteOpenResponse_message(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._PaymentInstallation_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._ExecuteOpenResponse_status(ctx, field, obj)
+		case "region":
+			out.Values[i] = ec._PaymentInstallation_region(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "openStatus":
-			out.Values[i] = ec._ExecuteOpenResponse_openStatus(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._PaymentInstallation_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "address":
+			out.Values[i] = ec._PaymentInstallation_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imageUrl":
+			out.Values[i] = ec._PaymentInstallation_imageUrl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8541,39 +15787,46 @@ func (ec *executionContext) _ExecuteOpenResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var generateBookingResponseImplementors = []string{"GenerateBookingResponse"}
+var paymentQuoteImplementors = []string{"PaymentQuote"}
 
-func (ec *executionContext) _GenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateBookingResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generateBookingResponseImplementors)
+func (ec *executionContext) _PaymentQuote(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentQuote) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentQuoteImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GenerateBookingResponse")
-		case "transactionId":
-			out.Values[i] = ec._GenerateBookingResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentQuote")
+		case "groupId":
+			out.Values[i] = ec._PaymentQuote_groupId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._GenerateBookingResponse_message(ctx, field, obj)
+		case "couponCode":
+			out.Values[i] = ec._PaymentQuote_couponCode(ctx, field, obj)
+		case "couponApplied":
+			out.Values[i] = ec._PaymentQuote_couponApplied(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._GenerateBookingResponse_status(ctx, field, obj)
+		case "price":
+			out.Values[i] = ec._PaymentQuote_price(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "traceId":
-			out.Values[i] = ec._GenerateBookingResponse_traceId(ctx, field, obj)
+		case "discountPercentage":
+			out.Values[i] = ec._PaymentQuote_discountPercentage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "code":
-			out.Values[i] = ec._GenerateBookingResponse_code(ctx, field, obj)
+		case "discountAmount":
+			out.Values[i] = ec._PaymentQuote_discountAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._PaymentQuote_total(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8600,42 +15853,68 @@ func (ec *executionContext) _GenerateBookingResponse(ctx context.Context, sel as
 	return out
 }
 
-var generatePurchaseOrderResponseImplementors = []string{"GeneratePurchaseOrderResponse"}
+var paymentRackImplementors = []string{"PaymentRack"}
 
-func (ec *executionContext) _GeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePurchaseOrderResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generatePurchaseOrderResponseImplementors)
+func (ec *executionContext) _PaymentRack(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentRack) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentRackImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GeneratePurchaseOrderResponse")
-		case "transactionId":
-			out.Values[i] = ec._GeneratePurchaseOrderResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentRack")
+		case "id":
+			out.Values[i] = ec._PaymentRack_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "message":
-			out.Values[i] = ec._GeneratePurchaseOrderResponse_message(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._PaymentRack_description(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "status":
-			out.Values[i] = ec._GeneratePurchaseOrderResponse_status(ctx, field, obj)
+		case "address":
+			out.Values[i] = ec._PaymentRack_address(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "traceId":
-			out.Values[i] = ec._GeneratePurchaseOrderResponse_traceId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+		case "availableGroups":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PaymentRack_availableGroups(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "url":
-			out.Values[i] = ec._GeneratePurchaseOrderResponse_url(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8659,43 +15938,84 @@ func (ec *executionContext) _GeneratePurchaseOrderResponse(ctx context.Context,
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var purchaseOrderDataImplementors = []string{"PurchaseOrderData"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _PurchaseOrderData(ctx context.Context, sel ast.SelectionSet, obj *model.PurchaseOrderData) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, purchaseOrderDataImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "generatePurchaseOrder":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_generatePurchaseOrder(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("PurchaseOrderData")
+		case "couponId":
+			out.Values[i] = ec._PurchaseOrderData_couponId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bookingReference":
+			out.Values[i] = ec._PurchaseOrderData_bookingReference(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oc":
+			out.Values[i] = ec._PurchaseOrderData_oc(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "email":
+			out.Values[i] = ec._PurchaseOrderData_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "phone":
+			out.Values[i] = ec._PurchaseOrderData_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "discount":
+			out.Values[i] = ec._PurchaseOrderData_discount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "generateBooking":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_generateBooking(ctx, field)
-			})
+		case "productPrice":
+			out.Values[i] = ec._PurchaseOrderData_productPrice(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "executeOpen":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_executeOpen(ctx, field)
-			})
+		case "finalProductPrice":
+			out.Values[i] = ec._PurchaseOrderData_finalProductPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "productName":
+			out.Values[i] = ec._PurchaseOrderData_productName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "productDescription":
+			out.Values[i] = ec._PurchaseOrderData_productDescription(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lockerPosition":
+			out.Values[i] = ec._PurchaseOrderData_lockerPosition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "installationName":
+			out.Values[i] = ec._PurchaseOrderData_installationName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deviceSerieNum":
+			out.Values[i] = ec._PurchaseOrderData_deviceSerieNum(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._PurchaseOrderData_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8722,34 +16042,41 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var paymentBookingTimeImplementors = []string{"PaymentBookingTime"}
+var purchaseOrderResponseImplementors = []string{"PurchaseOrderResponse"}
 
-func (ec *executionContext) _PaymentBookingTime(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentBookingTime) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentBookingTimeImplementors)
+func (ec *executionContext) _PurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PurchaseOrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, purchaseOrderResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentBookingTime")
-		case "id":
-			out.Values[i] = ec._PaymentBookingTime_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PurchaseOrderResponse")
+		case "transactionId":
+			out.Values[i] = ec._PurchaseOrderResponse_transactionId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._PaymentBookingTime_name(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._PurchaseOrderResponse_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "unitMeasurement":
-			out.Values[i] = ec._PaymentBookingTime_unitMeasurement(ctx, field, obj)
+		case "detail":
+			out.Values[i] = ec._PurchaseOrderResponse_detail(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._PurchaseOrderResponse_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "amount":
-			out.Values[i] = ec._PaymentBookingTime_amount(ctx, field, obj)
+		case "traceId":
+			out.Values[i] = ec._PurchaseOrderResponse_traceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purchaseOrderData":
+			out.Values[i] = ec._PurchaseOrderResponse_purchaseOrderData(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8776,43 +16103,44 @@ func (ec *executionContext) _PaymentBookingTime(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var paymentInfraResponseImplementors = []string{"PaymentInfraResponse"}
+var qrImageImplementors = []string{"QrImage"}
 
-func (ec *executionContext) _PaymentInfraResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentInfraResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentInfraResponseImplementors)
+func (ec *executionContext) _QrImage(ctx context.Context, sel ast.SelectionSet, obj *model.QRImage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, qrImageImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentInfraResponse")
-		case "transactionId":
-			out.Values[i] = ec._PaymentInfraResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("QrImage")
+		case "format":
+			out.Values[i] = ec._QrImage_format(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._PaymentInfraResponse_message(ctx, field, obj)
+		case "mimeType":
+			out.Values[i] = ec._QrImage_mimeType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._PaymentInfraResponse_status(ctx, field, obj)
+		case "size":
+			out.Values[i] = ec._QrImage_size(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "traceId":
-			out.Values[i] = ec._PaymentInfraResponse_traceId(ctx, field, obj)
+		case "payload":
+			out.Values[i] = ec._QrImage_payload(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "paymentRack":
-			out.Values[i] = ec._PaymentInfraResponse_paymentRack(ctx, field, obj)
-		case "installation":
-			out.Values[i] = ec._PaymentInfraResponse_installation(ctx, field, obj)
-		case "bookingTimes":
-			out.Values[i] = ec._PaymentInfraResponse_bookingTimes(ctx, field, obj)
+		case "data":
+			out.Values[i] = ec._QrImage_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dataUri":
+			out.Values[i] = ec._QrImage_dataUri(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8839,47 +16167,228 @@ func (ec *executionContext) _PaymentInfraResponse(ctx context.Context, sel ast.S
 	return out
 }
 
-var paymentInstallationImplementors = []string{"PaymentInstallation"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _PaymentInstallation(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentInstallation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentInstallationImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentInstallation")
-		case "id":
-			out.Values[i] = ec._PaymentInstallation_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			out.Values[i] = graphql.MarshalString("Query")
+		case "getPaymentInfraByQrValue":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getPaymentInfraByQrValue(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "name":
-			out.Values[i] = ec._PaymentInstallation_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAvailableLockersByRackIDAndBookingTime":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAvailableLockersByRackIDAndBookingTime(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "validateDiscountCoupon":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validateDiscountCoupon(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getPurchaseOrderByPo":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getPurchaseOrderByPo(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "checkBookingStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_checkBookingStatus(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "availablePaymentGateways":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_availablePaymentGateways(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "lockerOpenAudit":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_lockerOpenAudit(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "region":
-			out.Values[i] = ec._PaymentInstallation_region(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "city":
-			out.Values[i] = ec._PaymentInstallation_city(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "tenant":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_tenant(ctx, field)
+				return res
 			}
-		case "address":
-			out.Values[i] = ec._PaymentInstallation_address(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "imageUrl":
-			out.Values[i] = ec._PaymentInstallation_imageUrl(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "featureFlags":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_featureFlags(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8903,32 +16412,36 @@ func (ec *executionContext) _PaymentInstallation(ctx context.Context, sel ast.Se
 	return out
 }
 
-var paymentRackImplementors = []string{"PaymentRack"}
+var refundImplementors = []string{"Refund"}
 
-func (ec *executionContext) _PaymentRack(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentRack) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentRackImplementors)
+func (ec *executionContext) _Refund(ctx context.Context, sel ast.SelectionSet, obj *model.Refund) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, refundImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentRack")
-		case "id":
-			out.Values[i] = ec._PaymentRack_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Refund")
+		case "refundId":
+			out.Values[i] = ec._Refund_refundId(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Refund_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "description":
-			out.Values[i] = ec._PaymentRack_description(ctx, field, obj)
+		case "amount":
+			out.Values[i] = ec._Refund_amount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "address":
-			out.Values[i] = ec._PaymentRack_address(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._Refund_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "detail":
+			out.Values[i] = ec._Refund_detail(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8952,143 +16465,59 @@ func (ec *executionContext) _PaymentRack(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var purchaseOrderDataImplementors = []string{"PurchaseOrderData"}
-
-func (ec *executionContext) _PurchaseOrderData(ctx context.Context, sel ast.SelectionSet, obj *model.PurchaseOrderData) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, purchaseOrderDataImplementors)
+var subscriptionImplementors = []string{"Subscription"}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PurchaseOrderData")
-		case "couponId":
-			out.Values[i] = ec._PurchaseOrderData_couponId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "bookingReference":
-			out.Values[i] = ec._PurchaseOrderData_bookingReference(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "oc":
-			out.Values[i] = ec._PurchaseOrderData_oc(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "email":
-			out.Values[i] = ec._PurchaseOrderData_email(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "phone":
-			out.Values[i] = ec._PurchaseOrderData_phone(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "discount":
-			out.Values[i] = ec._PurchaseOrderData_discount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "productPrice":
-			out.Values[i] = ec._PurchaseOrderData_productPrice(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "finalProductPrice":
-			out.Values[i] = ec._PurchaseOrderData_finalProductPrice(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "productName":
-			out.Values[i] = ec._PurchaseOrderData_productName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "productDescription":
-			out.Values[i] = ec._PurchaseOrderData_productDescription(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "lockerPosition":
-			out.Values[i] = ec._PurchaseOrderData_lockerPosition(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "installationName":
-			out.Values[i] = ec._PurchaseOrderData_installationName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deviceSerieNum":
-			out.Values[i] = ec._PurchaseOrderData_deviceSerieNum(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._PurchaseOrderData_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	switch fields[0].Name {
+	case "purchaseOrderStatus":
+		return ec._Subscription_purchaseOrderStatus(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
-
-	return out
 }
 
-var purchaseOrderResponseImplementors = []string{"PurchaseOrderResponse"}
+var tenantImplementors = []string{"Tenant"}
 
-func (ec *executionContext) _PurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PurchaseOrderResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, purchaseOrderResponseImplementors)
+func (ec *executionContext) _Tenant(ctx context.Context, sel ast.SelectionSet, obj *model.Tenant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tenantImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PurchaseOrderResponse")
-		case "transactionId":
-			out.Values[i] = ec._PurchaseOrderResponse_transactionId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Tenant")
+		case "id":
+			out.Values[i] = ec._Tenant_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._PurchaseOrderResponse_message(ctx, field, obj)
+		case "displayName":
+			out.Values[i] = ec._Tenant_displayName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._PurchaseOrderResponse_status(ctx, field, obj)
+		case "branding":
+			out.Values[i] = ec._Tenant_branding(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "traceId":
-			out.Values[i] = ec._PurchaseOrderResponse_traceId(ctx, field, obj)
+		case "gateways":
+			out.Values[i] = ec._Tenant_gateways(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "purchaseOrderData":
-			out.Values[i] = ec._PurchaseOrderResponse_purchaseOrderData(ctx, field, obj)
+		case "features":
+			out.Values[i] = ec._Tenant_features(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -9115,143 +16544,76 @@ func (ec *executionContext) _PurchaseOrderResponse(ctx context.Context, sel ast.
 	return out
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "getPaymentInfraByQrValue":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getPaymentInfraByQrValue(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAvailableLockersByRackIDAndBookingTime":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getAvailableLockersByRackIDAndBookingTime(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "validateDiscountCoupon":
-			field := field
+var tenantBrandingImplementors = []string{"TenantBranding"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_validateDiscountCoupon(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) _TenantBranding(ctx context.Context, sel ast.SelectionSet, obj *model.TenantBranding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tenantBrandingImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TenantBranding")
+		case "logoUrl":
+			out.Values[i] = ec._TenantBranding_logoUrl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "primaryColor":
+			out.Values[i] = ec._TenantBranding_primaryColor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "secondaryColor":
+			out.Values[i] = ec._TenantBranding_secondaryColor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getPurchaseOrderByPo":
-			field := field
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getPurchaseOrderByPo(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "checkBookingStatus":
-			field := field
+var tenantFeatureImplementors = []string{"TenantFeature"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_checkBookingStatus(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) _TenantFeature(ctx context.Context, sel ast.SelectionSet, obj *model.TenantFeature) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tenantFeatureImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TenantFeature")
+		case "name":
+			out.Values[i] = ec._TenantFeature_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "enabled":
+			out.Values[i] = ec._TenantFeature_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9296,6 +16658,8 @@ func (ec *executionContext) _ValidateDiscountCouponResponse(ctx context.Context,
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "detail":
+			out.Values[i] = ec._ValidateDiscountCouponResponse_detail(ctx, field, obj)
 		case "status":
 			out.Values[i] = ec._ValidateDiscountCouponResponse_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -9651,39 +17015,221 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		return graphql.Null
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAvailableLockersByRackIDAndBookingTimeResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, v model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
+	return ec._AvailableLockersByRackIDAndBookingTimeResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAvailableLockersByRackIDAndBookingTimeResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, v *model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AvailableLockersByRackIDAndBookingTimeResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AvailablePaymentGroup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAvailablePaymentGroup2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAvailablePaymentGroup2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroup(ctx context.Context, sel ast.SelectionSet, v *model.AvailablePaymentGroup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AvailablePaymentGroup(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBookingState2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐBookingState(ctx context.Context, v any) (model.BookingState, error) {
+	var res model.BookingState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBookingState2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐBookingState(ctx context.Context, sel ast.SelectionSet, v model.BookingState) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCancelBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancelBookingInput(ctx context.Context, v any) (model.CancelBookingInput, error) {
+	res, err := ec.unmarshalInputCancelBookingInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCancelBookingResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancelBookingResponse(ctx context.Context, sel ast.SelectionSet, v model.CancelBookingResponse) graphql.Marshaler {
+	return ec._CancelBookingResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCancelBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancelBookingResponse(ctx context.Context, sel ast.SelectionSet, v *model.CancelBookingResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CancelBookingResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCancellationStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancellationStatus(ctx context.Context, v any) (model.CancellationStatus, error) {
+	var res model.CancellationStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCancellationStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCancellationStatus(ctx context.Context, sel ast.SelectionSet, v model.CancellationStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNCheckBookingStatusInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusInput(ctx context.Context, v any) (model.CheckBookingStatusInput, error) {
+	res, err := ec.unmarshalInputCheckBookingStatusInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCheckBookingStatusResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, v model.CheckBookingStatusResponse) graphql.Marshaler {
+	return ec._CheckBookingStatusResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCheckBookingStatusResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, v *model.CheckBookingStatusResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CheckBookingStatusResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := model.UnmarshalDateTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := model.MarshalDateTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNExecuteOpenInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenInput(ctx context.Context, v any) (model.ExecuteOpenInput, error) {
+	res, err := ec.unmarshalInputExecuteOpenInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNExecuteOpenResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, v model.ExecuteOpenResponse) graphql.Marshaler {
+	return ec._ExecuteOpenResponse(ctx, sel, &v)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNExecuteOpenResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, v *model.ExecuteOpenResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ExecuteOpenResponse(ctx, sel, v)
+}
 
-func (ec *executionContext) marshalNAvailableLockersByRackIDAndBookingTimeResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, v model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
-	return ec._AvailableLockersByRackIDAndBookingTimeResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNExtendBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExtendBookingInput(ctx context.Context, v any) (model.ExtendBookingInput, error) {
+	res, err := ec.unmarshalInputExtendBookingInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAvailableLockersByRackIDAndBookingTimeResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailableLockersByRackIDAndBookingTimeResponse(ctx context.Context, sel ast.SelectionSet, v *model.AvailableLockersByRackIDAndBookingTimeResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNExtendBookingResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExtendBookingResponse(ctx context.Context, sel ast.SelectionSet, v model.ExtendBookingResponse) graphql.Marshaler {
+	return ec._ExtendBookingResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNExtendBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExtendBookingResponse(ctx context.Context, sel ast.SelectionSet, v *model.ExtendBookingResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AvailableLockersByRackIDAndBookingTimeResponse(ctx, sel, v)
+	return ec._ExtendBookingResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AvailablePaymentGroup) graphql.Marshaler {
+func (ec *executionContext) marshalNFeatureFlag2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐFeatureFlagᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FeatureFlag) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9707,7 +17253,7 @@ func (ec *executionContext) marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAvailablePaymentGroup2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroup(ctx, sel, v[i])
+			ret[i] = ec.marshalNFeatureFlag2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐFeatureFlag(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9727,166 +17273,303 @@ func (ec *executionContext) marshalNAvailablePaymentGroup2ᚕᚖbffᚑgraphqlᚑ
 	return ret
 }
 
-func (ec *executionContext) marshalNAvailablePaymentGroup2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐAvailablePaymentGroup(ctx context.Context, sel ast.SelectionSet, v *model.AvailablePaymentGroup) graphql.Marshaler {
+func (ec *executionContext) marshalNFeatureFlag2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐFeatureFlag(ctx context.Context, sel ast.SelectionSet, v *model.FeatureFlag) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AvailablePaymentGroup(ctx, sel, v)
+	return ec._FeatureFlag(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalBoolean(v)
+	res := graphql.MarshalFloatContext(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) unmarshalNCheckBookingStatusInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusInput(ctx context.Context, v any) (model.CheckBookingStatusInput, error) {
-	res, err := ec.unmarshalInputCheckBookingStatusInput(ctx, v)
+func (ec *executionContext) unmarshalNGenerateBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingInput(ctx context.Context, v any) (model.GenerateBookingInput, error) {
+	res, err := ec.unmarshalInputGenerateBookingInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCheckBookingStatusResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, v model.CheckBookingStatusResponse) graphql.Marshaler {
-	return ec._CheckBookingStatusResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNGenerateBookingResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, v model.GenerateBookingResponse) graphql.Marshaler {
+	return ec._GenerateBookingResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCheckBookingStatusResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐCheckBookingStatusResponse(ctx context.Context, sel ast.SelectionSet, v *model.CheckBookingStatusResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNGenerateBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, v *model.GenerateBookingResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CheckBookingStatusResponse(ctx, sel, v)
+	return ec._GenerateBookingResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNExecuteOpenInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenInput(ctx context.Context, v any) (model.ExecuteOpenInput, error) {
-	res, err := ec.unmarshalInputExecuteOpenInput(ctx, v)
+func (ec *executionContext) unmarshalNGeneratePurchaseOrderInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderInput(ctx context.Context, v any) (model.GeneratePurchaseOrderInput, error) {
+	res, err := ec.unmarshalInputGeneratePurchaseOrderInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNExecuteOpenResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, v model.ExecuteOpenResponse) graphql.Marshaler {
-	return ec._ExecuteOpenResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNGeneratePurchaseOrderResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.GeneratePurchaseOrderResponse) graphql.Marshaler {
+	return ec._GeneratePurchaseOrderResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNExecuteOpenResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐExecuteOpenResponse(ctx context.Context, sel ast.SelectionSet, v *model.ExecuteOpenResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNGeneratePurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, v *model.GeneratePurchaseOrderResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ExecuteOpenResponse(ctx, sel, v)
+	return ec._GeneratePurchaseOrderResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
+func (ec *executionContext) unmarshalNGetAvailableLockersByRackIDAndBookingTimeInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetAvailableLockersByRackIDAndBookingTimeInput(ctx context.Context, v any) (model.GetAvailableLockersByRackIDAndBookingTimeInput, error) {
+	res, err := ec.unmarshalInputGetAvailableLockersByRackIDAndBookingTimeInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+func (ec *executionContext) unmarshalNGetPaymentInfraByQrValueInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetPaymentInfraByQRValueInput(ctx context.Context, v any) (model.GetPaymentInfraByQRValueInput, error) {
+	res, err := ec.unmarshalInputGetPaymentInfraByQrValueInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNGetPurchaseOrderByPoInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetPurchaseOrderByPoInput(ctx context.Context, v any) (model.GetPurchaseOrderByPoInput, error) {
+	res, err := ec.unmarshalInputGetPurchaseOrderByPoInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalFloatContext(v)
+	res := graphql.MarshalInt(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return res
 }
 
-func (ec *executionContext) unmarshalNGenerateBookingInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingInput(ctx context.Context, v any) (model.GenerateBookingInput, error) {
-	res, err := ec.unmarshalInputGenerateBookingInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNLockerOpenAuditPage2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditPage(ctx context.Context, sel ast.SelectionSet, v model.LockerOpenAuditPage) graphql.Marshaler {
+	return ec._LockerOpenAuditPage(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGenerateBookingResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, v model.GenerateBookingResponse) graphql.Marshaler {
-	return ec._GenerateBookingResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNLockerOpenAuditPage2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditPage(ctx context.Context, sel ast.SelectionSet, v *model.LockerOpenAuditPage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LockerOpenAuditPage(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNGenerateBookingResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGenerateBookingResponse(ctx context.Context, sel ast.SelectionSet, v *model.GenerateBookingResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNLockerOpenAuditRecord2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditRecordᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LockerOpenAuditRecord) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLockerOpenAuditRecord2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditRecord(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLockerOpenAuditRecord2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenAuditRecord(ctx context.Context, sel ast.SelectionSet, v *model.LockerOpenAuditRecord) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._GenerateBookingResponse(ctx, sel, v)
+	return ec._LockerOpenAuditRecord(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNGeneratePurchaseOrderInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderInput(ctx context.Context, v any) (model.GeneratePurchaseOrderInput, error) {
-	res, err := ec.unmarshalInputGeneratePurchaseOrderInput(ctx, v)
+func (ec *executionContext) unmarshalNLockerOpenOutcome2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome(ctx context.Context, v any) (model.LockerOpenOutcome, error) {
+	var res model.LockerOpenOutcome
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLockerOpenOutcome2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome(ctx context.Context, sel ast.SelectionSet, v model.LockerOpenOutcome) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx context.Context, v any) (model.OpenStatus, error) {
+	var res model.OpenStatus
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNGeneratePurchaseOrderResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.GeneratePurchaseOrderResponse) graphql.Marshaler {
-	return ec._GeneratePurchaseOrderResponse(ctx, sel, &v)
-}
+func (ec *executionContext) marshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx context.Context, sel ast.SelectionSet, v model.OpenStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNOpenStatus2ᚕbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatusᚄ(ctx context.Context, v any) ([]model.OpenStatus, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.OpenStatus, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNOpenStatus2ᚕbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []model.OpenStatus) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTimeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PaymentBookingTime) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPaymentBookingTime2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTime(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNGeneratePurchaseOrderResponse2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGeneratePurchaseOrderResponse(ctx context.Context, sel ast.SelectionSet, v *model.GeneratePurchaseOrderResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._GeneratePurchaseOrderResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNGetAvailableLockersByRackIDAndBookingTimeInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetAvailableLockersByRackIDAndBookingTimeInput(ctx context.Context, v any) (model.GetAvailableLockersByRackIDAndBookingTimeInput, error) {
-	res, err := ec.unmarshalInputGetAvailableLockersByRackIDAndBookingTimeInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNGetPaymentInfraByQrValueInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetPaymentInfraByQRValueInput(ctx context.Context, v any) (model.GetPaymentInfraByQRValueInput, error) {
-	res, err := ec.unmarshalInputGetPaymentInfraByQrValueInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNGetPurchaseOrderByPoInput2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐGetPurchaseOrderByPoInput(ctx context.Context, v any) (model.GetPurchaseOrderByPoInput, error) {
-	res, err := ec.unmarshalInputGetPurchaseOrderByPoInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPaymentBookingTime2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTime(ctx context.Context, sel ast.SelectionSet, v *model.PaymentBookingTime) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx context.Context, v any) (model.OpenStatus, error) {
-	var res model.OpenStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNOpenStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐOpenStatus(ctx context.Context, sel ast.SelectionSet, v model.OpenStatus) graphql.Marshaler {
-	return v
+	return ec._PaymentBookingTime(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTimeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PaymentBookingTime) graphql.Marshaler {
+func (ec *executionContext) marshalNPaymentGateway2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentGatewayᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PaymentGateway) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9910,7 +17593,7 @@ func (ec *executionContext) marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpay
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPaymentBookingTime2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTime(ctx, sel, v[i])
+			ret[i] = ec.marshalNPaymentGateway2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentGateway(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9930,14 +17613,14 @@ func (ec *executionContext) marshalNPaymentBookingTime2ᚕᚖbffᚑgraphqlᚑpay
 	return ret
 }
 
-func (ec *executionContext) marshalNPaymentBookingTime2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentBookingTime(ctx context.Context, sel ast.SelectionSet, v *model.PaymentBookingTime) graphql.Marshaler {
+func (ec *executionContext) marshalNPaymentGateway2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentGateway(ctx context.Context, sel ast.SelectionSet, v *model.PaymentGateway) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PaymentBookingTime(ctx, sel, v)
+	return ec._PaymentGateway(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNPaymentInfraResponse2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInfraResponse(ctx context.Context, sel ast.SelectionSet, v model.PaymentInfraResponse) graphql.Marshaler {
@@ -9954,6 +17637,24 @@ func (ec *executionContext) marshalNPaymentInfraResponse2ᚖbffᚑgraphqlᚑpaym
 	return ec._PaymentInfraResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPaymentQuote2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentQuote(ctx context.Context, sel ast.SelectionSet, v model.PaymentQuote) graphql.Marshaler {
+	return ec._PaymentQuote(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPaymentQuote2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentQuote(ctx context.Context, sel ast.SelectionSet, v *model.PaymentQuote) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PaymentQuote(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPurchaseOrderData2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderData(ctx context.Context, sel ast.SelectionSet, v model.PurchaseOrderData) graphql.Marshaler {
+	return ec._PurchaseOrderData(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNPurchaseOrderData2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPurchaseOrderData(ctx context.Context, sel ast.SelectionSet, v *model.PurchaseOrderData) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -9978,6 +17679,50 @@ func (ec *executionContext) marshalNPurchaseOrderResponse2ᚖbffᚑgraphqlᚑpay
 	return ec._PurchaseOrderResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNQrImage2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImage(ctx context.Context, sel ast.SelectionSet, v model.QRImage) graphql.Marshaler {
+	return ec._QrImage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNQrImage2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImage(ctx context.Context, sel ast.SelectionSet, v *model.QRImage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._QrImage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNQrImageFormat2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat(ctx context.Context, v any) (model.QRImageFormat, error) {
+	var res model.QRImageFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNQrImageFormat2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat(ctx context.Context, sel ast.SelectionSet, v model.QRImageFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRefund2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐRefund(ctx context.Context, sel ast.SelectionSet, v *model.Refund) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Refund(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRefundStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐRefundStatus(ctx context.Context, v any) (model.RefundStatus, error) {
+	var res model.RefundStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRefundStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐRefundStatus(ctx context.Context, sel ast.SelectionSet, v model.RefundStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNResponseStatus2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐResponseStatus(ctx context.Context, v any) (model.ResponseStatus, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := model.ResponseStatus(tmp)
@@ -10011,6 +17756,100 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTenantBranding2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantBranding(ctx context.Context, sel ast.SelectionSet, v *model.TenantBranding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TenantBranding(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNTenantFeature2ᚕᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantFeatureᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TenantFeature) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTenantFeature2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantFeature(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTenantFeature2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantFeature(ctx context.Context, sel ast.SelectionSet, v *model.TenantFeature) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TenantFeature(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNUnitMeasurement2bffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐUnitMeasurement(ctx context.Context, v any) (model.UnitMeasurement, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := model.UnitMeasurement(tmp)
@@ -10337,6 +18176,58 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalODateTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := model.UnmarshalDateTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := model.MarshalDateTime(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOLockerOpenOutcome2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome(ctx context.Context, v any) (*model.LockerOpenOutcome, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.LockerOpenOutcome)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOLockerOpenOutcome2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐLockerOpenOutcome(ctx context.Context, sel ast.SelectionSet, v *model.LockerOpenOutcome) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOPaymentInstallation2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐPaymentInstallation(ctx context.Context, sel ast.SelectionSet, v *model.PaymentInstallation) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10351,6 +18242,22 @@ func (ec *executionContext) marshalOPaymentRack2ᚖbffᚑgraphqlᚑpaymentᚋgra
 	return ec._PaymentRack(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOQrImageFormat2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat(ctx context.Context, v any) (*model.QRImageFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.QRImageFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOQrImageFormat2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐQRImageFormat(ctx context.Context, sel ast.SelectionSet, v *model.QRImageFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -10369,6 +18276,20 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOTenant2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenant(ctx context.Context, sel ast.SelectionSet, v *model.Tenant) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Tenant(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOTenantBranding2ᚖbffᚑgraphqlᚑpaymentᚋgraphᚋmodelᚐTenantBranding(ctx context.Context, sel ast.SelectionSet, v *model.TenantBranding) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TenantBranding(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
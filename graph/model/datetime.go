@@ -0,0 +1,32 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalDateTime serializa el escalar DateTime en RFC 3339 conservando la zona horaria del valor
+func MarshalDateTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.Format(time.RFC3339)))
+	})
+}
+
+// UnmarshalDateTime interpreta el escalar DateTime desde un string RFC 3339
+func UnmarshalDateTime(v any) (time.Time, error) {
+	value, ok := v.(string)
+	if !ok {
+		return time.Time{}, errors.New("DateTime must be an RFC 3339 string")
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DateTime %q: %w", value, err)
+	}
+	return parsed, nil
+}
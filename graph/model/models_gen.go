@@ -7,79 +7,138 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type AvailableLockersByRackIDAndBookingTimeResponse struct {
 	TransactionID   string                   `json:"transactionId"`
 	Message         string                   `json:"message"`
+	Detail          *string                  `json:"detail,omitempty"`
 	Status          ResponseStatus           `json:"status"`
 	TraceID         string                   `json:"traceId"`
 	AvailableGroups []*AvailablePaymentGroup `json:"availableGroups"`
 }
 
-type AvailablePaymentGroup struct {
-	GroupID     int     `json:"groupId"`
-	Name        string  `json:"name"`
-	Price       float64 `json:"price"`
-	Description string  `json:"description"`
-	ImageURL    string  `json:"imageUrl"`
+type BookingStatusData struct {
+	ID                     int          `json:"id"`
+	ConfigurationBookingID int          `json:"configurationBookingId"`
+	InitBooking            time.Time    `json:"initBooking"`
+	FinishBooking          time.Time    `json:"finishBooking"`
+	InstallationName       string       `json:"installationName"`
+	NumberLocker           int          `json:"numberLocker"`
+	DeviceID               string       `json:"deviceId"`
+	CurrentCode            string       `json:"currentCode"`
+	Openings               int          `json:"openings"`
+	ServiceName            string       `json:"serviceName"`
+	EmailRecipient         string       `json:"emailRecipient"`
+	CreatedAt              time.Time    `json:"createdAt"`
+	UpdatedAt              time.Time    `json:"updatedAt"`
+	State                  BookingState `json:"state"`
+	RemainingTime          int          `json:"remainingTime"`
+	CanOpen                bool         `json:"canOpen"`
 }
 
-type BookingStatusData struct {
-	ID                     int    `json:"id"`
-	ConfigurationBookingID int    `json:"configurationBookingId"`
-	InitBooking            string `json:"initBooking"`
-	FinishBooking          string `json:"finishBooking"`
-	InstallationName       string `json:"installationName"`
-	NumberLocker           int    `json:"numberLocker"`
-	DeviceID               string `json:"deviceId"`
-	CurrentCode            string `json:"currentCode"`
-	Openings               int    `json:"openings"`
-	ServiceName            string `json:"serviceName"`
-	EmailRecipient         string `json:"emailRecipient"`
-	CreatedAt              string `json:"createdAt"`
-	UpdatedAt              string `json:"updatedAt"`
+type CancelBookingInput struct {
+	ServiceName   *string `json:"serviceName,omitempty"`
+	CurrentCode   *string `json:"currentCode,omitempty"`
+	PurchaseOrder *string `json:"purchaseOrder,omitempty"`
+	Reason        string  `json:"reason"`
+	TraceID       *string `json:"traceId,omitempty"`
+	Locale        *string `json:"locale,omitempty"`
+}
+
+type CancelBookingResponse struct {
+	TransactionID      string             `json:"transactionId"`
+	Message            string             `json:"message"`
+	Detail             *string            `json:"detail,omitempty"`
+	Status             ResponseStatus     `json:"status"`
+	TraceID            string             `json:"traceId"`
+	BookingID          int                `json:"bookingId"`
+	PurchaseOrder      *string            `json:"purchaseOrder,omitempty"`
+	CancellationStatus CancellationStatus `json:"cancellationStatus"`
+	Refund             *Refund            `json:"refund"`
 }
 
 type CheckBookingStatusInput struct {
-	ServiceName string `json:"serviceName"`
-	CurrentCode string `json:"currentCode"`
+	ServiceName string  `json:"serviceName"`
+	CurrentCode string  `json:"currentCode"`
+	Timezone    *string `json:"timezone,omitempty"`
+	TraceID     *string `json:"traceId,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
 }
 
 type CheckBookingStatusResponse struct {
 	TransactionID string             `json:"transactionId"`
 	Message       string             `json:"message"`
+	Detail        *string            `json:"detail,omitempty"`
 	Status        ResponseStatus     `json:"status"`
+	TraceID       string             `json:"traceId"`
 	Booking       *BookingStatusData `json:"booking,omitempty"`
 }
 
 type ExecuteOpenInput struct {
-	ServiceName string `json:"serviceName"`
-	CurrentCode string `json:"currentCode"`
+	ServiceName string  `json:"serviceName"`
+	CurrentCode string  `json:"currentCode"`
+	TraceID     *string `json:"traceId,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
 }
 
 type ExecuteOpenResponse struct {
 	TransactionID string         `json:"transactionId"`
 	Message       string         `json:"message"`
+	Detail        *string        `json:"detail,omitempty"`
 	Status        ResponseStatus `json:"status"`
+	TraceID       string         `json:"traceId"`
 	OpenStatus    OpenStatus     `json:"openStatus"`
 }
 
+type ExtendBookingInput struct {
+	ServiceName   string  `json:"serviceName"`
+	CurrentCode   string  `json:"currentCode"`
+	BookingTimeID int     `json:"bookingTimeId"`
+	GatewayName   string  `json:"gatewayName"`
+	TraceID       *string `json:"traceId,omitempty"`
+	Timezone      *string `json:"timezone,omitempty"`
+	Locale        *string `json:"locale,omitempty"`
+}
+
+type ExtendBookingResponse struct {
+	TransactionID        string              `json:"transactionId"`
+	Message              string              `json:"message"`
+	Detail               *string             `json:"detail,omitempty"`
+	Status               ResponseStatus      `json:"status"`
+	TraceID              string              `json:"traceId"`
+	PurchaseOrder        string              `json:"purchaseOrder"`
+	URL                  string              `json:"url"`
+	BookingTime          *PaymentBookingTime `json:"bookingTime"`
+	Price                int                 `json:"price"`
+	CurrentFinishBooking time.Time           `json:"currentFinishBooking"`
+	NewFinishBooking     time.Time           `json:"newFinishBooking"`
+}
+
+type FeatureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type GenerateBookingInput struct {
 	RackIDReference int     `json:"rackIdReference"`
 	GroupID         int     `json:"groupId"`
 	CouponCode      *string `json:"couponCode,omitempty"`
 	UserEmail       string  `json:"userEmail"`
 	UserPhone       string  `json:"userPhone"`
-	TraceID         string  `json:"traceId"`
+	TraceID         *string `json:"traceId,omitempty"`
+	Locale          *string `json:"locale,omitempty"`
 }
 
 type GenerateBookingResponse struct {
 	TransactionID string         `json:"transactionId"`
 	Message       string         `json:"message"`
+	Detail        *string        `json:"detail,omitempty"`
 	Status        ResponseStatus `json:"status"`
 	TraceID       string         `json:"traceId"`
 	Code          string         `json:"code"`
+	CodeQR        *QRImage       `json:"codeQr"`
 }
 
 type GeneratePurchaseOrderInput struct {
@@ -88,50 +147,83 @@ type GeneratePurchaseOrderInput struct {
 	CouponCode      *string `json:"couponCode,omitempty"`
 	UserEmail       string  `json:"userEmail"`
 	UserPhone       string  `json:"userPhone"`
-	TraceID         string  `json:"traceId"`
+	TraceID         *string `json:"traceId,omitempty"`
 	GatewayName     string  `json:"gatewayName"`
+	Locale          *string `json:"locale,omitempty"`
 }
 
 type GeneratePurchaseOrderResponse struct {
 	TransactionID string         `json:"transactionId"`
 	Message       string         `json:"message"`
+	Detail        *string        `json:"detail,omitempty"`
 	Status        ResponseStatus `json:"status"`
 	TraceID       string         `json:"traceId"`
 	URL           string         `json:"url"`
 }
 
 type GetAvailableLockersByRackIDAndBookingTimeInput struct {
-	PaymentRackID int    `json:"paymentRackId"`
-	BookingTimeID int    `json:"bookingTimeId"`
-	TraceID       string `json:"traceId"`
+	PaymentRackID int     `json:"paymentRackId"`
+	BookingTimeID int     `json:"bookingTimeId"`
+	TraceID       *string `json:"traceId,omitempty"`
+	Locale        *string `json:"locale,omitempty"`
 }
 
 type GetPaymentInfraByQRValueInput struct {
-	QRValue string `json:"qrValue"`
+	QRValue string  `json:"qrValue"`
+	TraceID *string `json:"traceId,omitempty"`
+	Locale  *string `json:"locale,omitempty"`
 }
 
 type GetPurchaseOrderByPoInput struct {
-	PurchaseOrder string `json:"purchaseOrder"`
-	TraceID       string `json:"traceId"`
+	PurchaseOrder string  `json:"purchaseOrder"`
+	TraceID       *string `json:"traceId,omitempty"`
+	Locale        *string `json:"locale,omitempty"`
+}
+
+type LockerOpenAuditPage struct {
+	Records     []*LockerOpenAuditRecord `json:"records"`
+	TotalCount  int                      `json:"totalCount"`
+	Limit       int                      `json:"limit"`
+	Offset      int                      `json:"offset"`
+	HasNextPage bool                     `json:"hasNextPage"`
+}
+
+type LockerOpenAuditRecord struct {
+	ID                    string            `json:"id"`
+	Timestamp             time.Time         `json:"timestamp"`
+	Principal             string            `json:"principal"`
+	ClientIP              string            `json:"clientIp"`
+	UserAgent             string            `json:"userAgent"`
+	ServiceName           string            `json:"serviceName"`
+	MaskedCode            string            `json:"maskedCode"`
+	OpenStatuses          []OpenStatus      `json:"openStatuses"`
+	Outcome               LockerOpenOutcome `json:"outcome"`
+	Reason                *string           `json:"reason,omitempty"`
+	UpstreamTransactionID *string           `json:"upstreamTransactionId,omitempty"`
+	TraceID               string            `json:"traceId"`
 }
 
 type Mutation struct {
 }
 
-type PaymentBookingTime struct {
-	ID              int             `json:"id"`
-	Name            string          `json:"name"`
-	UnitMeasurement UnitMeasurement `json:"unitMeasurement"`
-	Amount          int             `json:"amount"`
+type PaymentGateway struct {
+	Name                string   `json:"name"`
+	DisplayName         string   `json:"displayName"`
+	LogoURL             string   `json:"logoUrl"`
+	SupportedCurrencies []string `json:"supportedCurrencies"`
+	MinAmount           int      `json:"minAmount"`
+	MaxAmount           int      `json:"maxAmount"`
 }
 
 type PaymentInfraResponse struct {
 	TransactionID string                `json:"transactionId"`
 	Message       string                `json:"message"`
+	Detail        *string               `json:"detail,omitempty"`
 	Status        ResponseStatus        `json:"status"`
 	TraceID       string                `json:"traceId"`
 	PaymentRack   *PaymentRack          `json:"paymentRack,omitempty"`
 	Installation  *PaymentInstallation  `json:"installation,omitempty"`
+	Branding      *TenantBranding       `json:"branding,omitempty"`
 	BookingTimes  []*PaymentBookingTime `json:"bookingTimes"`
 }
 
@@ -144,10 +236,14 @@ type PaymentInstallation struct {
 	ImageURL string `json:"imageUrl"`
 }
 
-type PaymentRack struct {
-	ID          int    `json:"id"`
-	Description string `json:"description"`
-	Address     string `json:"address"`
+type PaymentQuote struct {
+	GroupID            int     `json:"groupId"`
+	CouponCode         *string `json:"couponCode,omitempty"`
+	CouponApplied      bool    `json:"couponApplied"`
+	Price              float64 `json:"price"`
+	DiscountPercentage float64 `json:"discountPercentage"`
+	DiscountAmount     float64 `json:"discountAmount"`
+	Total              float64 `json:"total"`
 }
 
 type PurchaseOrderData struct {
@@ -170,28 +266,243 @@ type PurchaseOrderData struct {
 type PurchaseOrderResponse struct {
 	TransactionID     string             `json:"transactionId"`
 	Message           string             `json:"message"`
+	Detail            *string            `json:"detail,omitempty"`
 	Status            ResponseStatus     `json:"status"`
 	TraceID           string             `json:"traceId"`
 	PurchaseOrderData *PurchaseOrderData `json:"purchaseOrderData"`
 }
 
+type QRImage struct {
+	Format   QRImageFormat `json:"format"`
+	MimeType string        `json:"mimeType"`
+	Size     int           `json:"size"`
+	Payload  string        `json:"payload"`
+	Data     string        `json:"data"`
+	DataURI  string        `json:"dataUri"`
+}
+
 type Query struct {
 }
 
+type Refund struct {
+	RefundID *string      `json:"refundId,omitempty"`
+	Status   RefundStatus `json:"status"`
+	Amount   int          `json:"amount"`
+	Message  string       `json:"message"`
+	Detail   *string      `json:"detail,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type Tenant struct {
+	ID          string           `json:"id"`
+	DisplayName string           `json:"displayName"`
+	Branding    *TenantBranding  `json:"branding"`
+	Gateways    []string         `json:"gateways"`
+	Features    []*TenantFeature `json:"features"`
+}
+
+type TenantBranding struct {
+	LogoURL        string `json:"logoUrl"`
+	PrimaryColor   string `json:"primaryColor"`
+	SecondaryColor string `json:"secondaryColor"`
+}
+
+type TenantFeature struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type ValidateDiscountCouponInput struct {
-	CouponCode string `json:"couponCode"`
-	RackID     int    `json:"rackId"`
-	TraceID    string `json:"traceId"`
+	CouponCode string  `json:"couponCode"`
+	RackID     int     `json:"rackId"`
+	TraceID    *string `json:"traceId,omitempty"`
+	Locale     *string `json:"locale,omitempty"`
 }
 
 type ValidateDiscountCouponResponse struct {
 	TransactionID      string         `json:"transactionId"`
 	Message            string         `json:"message"`
+	Detail             *string        `json:"detail,omitempty"`
 	Status             ResponseStatus `json:"status"`
 	TraceID            string         `json:"traceId"`
 	DiscountPercentage float64        `json:"discountPercentage"`
 }
 
+type BookingState string
+
+const (
+	BookingStatePendingPayment BookingState = "PENDING_PAYMENT"
+	BookingStateActive         BookingState = "ACTIVE"
+	BookingStateExpired        BookingState = "EXPIRED"
+	BookingStateCancelled      BookingState = "CANCELLED"
+	BookingStateCompleted      BookingState = "COMPLETED"
+)
+
+var AllBookingState = []BookingState{
+	BookingStatePendingPayment,
+	BookingStateActive,
+	BookingStateExpired,
+	BookingStateCancelled,
+	BookingStateCompleted,
+}
+
+func (e BookingState) IsValid() bool {
+	switch e {
+	case BookingStatePendingPayment, BookingStateActive, BookingStateExpired, BookingStateCancelled, BookingStateCompleted:
+		return true
+	}
+	return false
+}
+
+func (e BookingState) String() string {
+	return string(e)
+}
+
+func (e *BookingState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BookingState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BookingState", str)
+	}
+	return nil
+}
+
+func (e BookingState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BookingState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BookingState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CancellationStatus string
+
+const (
+	CancellationStatusCancelled CancellationStatus = "CANCELLED"
+	CancellationStatusFailed    CancellationStatus = "FAILED"
+)
+
+var AllCancellationStatus = []CancellationStatus{
+	CancellationStatusCancelled,
+	CancellationStatusFailed,
+}
+
+func (e CancellationStatus) IsValid() bool {
+	switch e {
+	case CancellationStatusCancelled, CancellationStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e CancellationStatus) String() string {
+	return string(e)
+}
+
+func (e *CancellationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CancellationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CancellationStatus", str)
+	}
+	return nil
+}
+
+func (e CancellationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CancellationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CancellationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LockerOpenOutcome string
+
+const (
+	LockerOpenOutcomeOpened   LockerOpenOutcome = "OPENED"
+	LockerOpenOutcomeFailed   LockerOpenOutcome = "FAILED"
+	LockerOpenOutcomeRejected LockerOpenOutcome = "REJECTED"
+)
+
+var AllLockerOpenOutcome = []LockerOpenOutcome{
+	LockerOpenOutcomeOpened,
+	LockerOpenOutcomeFailed,
+	LockerOpenOutcomeRejected,
+}
+
+func (e LockerOpenOutcome) IsValid() bool {
+	switch e {
+	case LockerOpenOutcomeOpened, LockerOpenOutcomeFailed, LockerOpenOutcomeRejected:
+		return true
+	}
+	return false
+}
+
+func (e LockerOpenOutcome) String() string {
+	return string(e)
+}
+
+func (e *LockerOpenOutcome) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LockerOpenOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LockerOpenOutcome", str)
+	}
+	return nil
+}
+
+func (e LockerOpenOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LockerOpenOutcome) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LockerOpenOutcome) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type OpenStatus string
 
 const (
@@ -254,3 +565,119 @@ func (e OpenStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type QRImageFormat string
+
+const (
+	QRImageFormatPng QRImageFormat = "PNG"
+	QRImageFormatSVG QRImageFormat = "SVG"
+)
+
+var AllQRImageFormat = []QRImageFormat{
+	QRImageFormatPng,
+	QRImageFormatSVG,
+}
+
+func (e QRImageFormat) IsValid() bool {
+	switch e {
+	case QRImageFormatPng, QRImageFormatSVG:
+		return true
+	}
+	return false
+}
+
+func (e QRImageFormat) String() string {
+	return string(e)
+}
+
+func (e *QRImageFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QRImageFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QrImageFormat", str)
+	}
+	return nil
+}
+
+func (e QRImageFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *QRImageFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e QRImageFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RefundStatus string
+
+const (
+	RefundStatusNotRequired RefundStatus = "NOT_REQUIRED"
+	RefundStatusPending     RefundStatus = "PENDING"
+	RefundStatusApproved    RefundStatus = "APPROVED"
+	RefundStatusRejected    RefundStatus = "REJECTED"
+	RefundStatusFailed      RefundStatus = "FAILED"
+)
+
+var AllRefundStatus = []RefundStatus{
+	RefundStatusNotRequired,
+	RefundStatusPending,
+	RefundStatusApproved,
+	RefundStatusRejected,
+	RefundStatusFailed,
+}
+
+func (e RefundStatus) IsValid() bool {
+	switch e {
+	case RefundStatusNotRequired, RefundStatusPending, RefundStatusApproved, RefundStatusRejected, RefundStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e RefundStatus) String() string {
+	return string(e)
+}
+
+func (e *RefundStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RefundStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RefundStatus", str)
+	}
+	return nil
+}
+
+func (e RefundStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RefundStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RefundStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package model
+
+// Los tipos con campos relacionados se definen a mano para conservar el contexto del padre
+// (rack y traceId) que necesitan sus field resolvers; esos campos no se exponen en el esquema.
+
+// PaymentRack es el rack de pago; availableGroups se resuelve bajo demanda
+type PaymentRack struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+	Address     string `json:"address"`
+
+	TraceID string `json:"-"`
+}
+
+// PaymentBookingTime es un tiempo de reserva del rack; availableGroups se resuelve bajo demanda
+type PaymentBookingTime struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	UnitMeasurement UnitMeasurement `json:"unitMeasurement"`
+	Amount          int             `json:"amount"`
+
+	// RackID es 0 cuando el tiempo de reserva no se obtuvo desde un rack
+	RackID  int    `json:"-"`
+	TraceID string `json:"-"`
+}
+
+// AvailablePaymentGroup es un grupo de lockers disponible; quote se resuelve bajo demanda
+type AvailablePaymentGroup struct {
+	GroupID     int     `json:"groupId"`
+	Name        string  `json:"name"`
+	Price       float64 `json:"price"`
+	Description string  `json:"description"`
+	ImageURL    string  `json:"imageUrl"`
+
+	RackID  int    `json:"-"`
+	TraceID string `json:"-"`
+}
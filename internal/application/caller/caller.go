@@ -0,0 +1,41 @@
+package caller
+
+import "context"
+
+// AnonymousPrincipal identifica a un cliente que no informó su identidad
+const AnonymousPrincipal = "anonymous"
+
+// Claves con los datos del cliente, como header HTTP o, en minúsculas, como metadata gRPC
+const (
+	// PrincipalKey lo informa el gateway que autentica al cliente antes del servidor HTTP
+	PrincipalKey = "X-Principal"
+	// AdminTokenKey habilita las operaciones administrativas
+	AdminTokenKey = "X-Admin-Token"
+)
+
+// Info describe a quien origina la solicitud. En HTTP el BFF no autentica: Principal es la
+// identidad informada por el gateway que lo antecede, o AnonymousPrincipal. En gRPC es el
+// servicio autenticado por su token o su certificado de cliente.
+type Info struct {
+	Principal string
+	ClientIP  string
+	UserAgent string
+	// AdminToken es el token presentado para operaciones administrativas
+	AdminToken string
+}
+
+type infoKey struct{}
+
+// WithInfo devuelve un contexto que lleva los datos del cliente de la solicitud
+func WithInfo(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// FromContext devuelve los datos del cliente del contexto; sin datos el cliente es anónimo
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(infoKey{}).(Info)
+	if info.Principal == "" {
+		info.Principal = AnonymousPrincipal
+	}
+	return info
+}
@@ -0,0 +1,245 @@
+package i18n
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"errors"
+)
+
+// MessageKey identifica un mensaje de estado de las respuestas
+type MessageKey string
+
+const (
+	MessagePaymentInfraFound          MessageKey = "PAYMENT_INFRA_FOUND"
+	MessagePaymentInfraNotFound       MessageKey = "PAYMENT_INFRA_NOT_FOUND"
+	MessageLockersFound               MessageKey = "LOCKERS_FOUND"
+	MessageLockersNotFound            MessageKey = "LOCKERS_NOT_FOUND"
+	MessageCouponValid                MessageKey = "COUPON_VALID"
+	MessageCouponInvalid              MessageKey = "COUPON_INVALID"
+	MessagePurchaseOrderGenerated     MessageKey = "PURCHASE_ORDER_GENERATED"
+	MessagePurchaseOrderNotGenerated  MessageKey = "PURCHASE_ORDER_NOT_GENERATED"
+	MessageBookingGenerated           MessageKey = "BOOKING_GENERATED"
+	MessageBookingNotGenerated        MessageKey = "BOOKING_NOT_GENERATED"
+	MessagePurchaseOrderFound         MessageKey = "PURCHASE_ORDER_FOUND"
+	MessagePurchaseOrderNotFound      MessageKey = "PURCHASE_ORDER_NOT_FOUND"
+	MessageBookingFound               MessageKey = "BOOKING_FOUND"
+	MessageBookingNotFound            MessageKey = "BOOKING_NOT_FOUND"
+	MessageLockerOpened               MessageKey = "LOCKER_OPENED"
+	MessageLockerNotOpened            MessageKey = "LOCKER_NOT_OPENED"
+	MessageBookingCancelled           MessageKey = "BOOKING_CANCELLED"
+	MessageBookingNotCancelled        MessageKey = "BOOKING_NOT_CANCELLED"
+	MessageRefundNotRequired          MessageKey = "REFUND_NOT_REQUIRED"
+	MessageRefundPending              MessageKey = "REFUND_PENDING"
+	MessageRefundApproved             MessageKey = "REFUND_APPROVED"
+	MessageRefundRejected             MessageKey = "REFUND_REJECTED"
+	MessageRefundFailed               MessageKey = "REFUND_FAILED"
+	MessageExtensionOrderGenerated    MessageKey = "EXTENSION_ORDER_GENERATED"
+	MessageExtensionOrderNotGenerated MessageKey = "EXTENSION_ORDER_NOT_GENERATED"
+)
+
+// messages son los mensajes de estado por idioma
+var messages = map[model.Locale]map[MessageKey]string{
+	model.LocaleSpanish: {
+		MessagePaymentInfraFound:          "Infraestructura de pago encontrada",
+		MessagePaymentInfraNotFound:       "Valor QR inválido",
+		MessageLockersFound:               "Lockers disponibles encontrados",
+		MessageLockersNotFound:            "No se pudieron obtener los lockers disponibles",
+		MessageCouponValid:                "Cupón válido",
+		MessageCouponInvalid:              "Cupón inválido",
+		MessagePurchaseOrderGenerated:     "Orden de compra generada exitosamente",
+		MessagePurchaseOrderNotGenerated:  "No se pudo generar la orden de compra",
+		MessageBookingGenerated:           "Reserva generada exitosamente",
+		MessageBookingNotGenerated:        "No se pudo generar la reserva",
+		MessagePurchaseOrderFound:         "Orden de compra encontrada",
+		MessagePurchaseOrderNotFound:      "Orden de compra no encontrada",
+		MessageBookingFound:               "Reserva encontrada",
+		MessageBookingNotFound:            "Reserva no encontrada",
+		MessageLockerOpened:               "Locker abierto exitosamente",
+		MessageLockerNotOpened:            "No se pudo abrir el locker",
+		MessageBookingCancelled:           "Reserva cancelada",
+		MessageBookingNotCancelled:        "No se pudo cancelar la reserva",
+		MessageRefundNotRequired:          "No se requiere reembolso",
+		MessageRefundPending:              "Reembolso en proceso",
+		MessageRefundApproved:             "Reembolso aprobado",
+		MessageRefundRejected:             "Reembolso rechazado",
+		MessageRefundFailed:               "No se pudo solicitar el reembolso",
+		MessageExtensionOrderGenerated:    "Orden de extensión de reserva generada exitosamente",
+		MessageExtensionOrderNotGenerated: "No se pudo generar la orden de extensión de reserva",
+	},
+	model.LocaleEnglish: {
+		MessagePaymentInfraFound:          "Payment infrastructure found",
+		MessagePaymentInfraNotFound:       "Invalid QR value",
+		MessageLockersFound:               "Available lockers found",
+		MessageLockersNotFound:            "Available lockers could not be retrieved",
+		MessageCouponValid:                "Valid coupon",
+		MessageCouponInvalid:              "Invalid coupon",
+		MessagePurchaseOrderGenerated:     "Purchase order generated successfully",
+		MessagePurchaseOrderNotGenerated:  "The purchase order could not be generated",
+		MessageBookingGenerated:           "Booking generated successfully",
+		MessageBookingNotGenerated:        "The booking could not be generated",
+		MessagePurchaseOrderFound:         "Purchase order found",
+		MessagePurchaseOrderNotFound:      "Purchase order not found",
+		MessageBookingFound:               "Booking found",
+		MessageBookingNotFound:            "Booking not found",
+		MessageLockerOpened:               "Locker opened successfully",
+		MessageLockerNotOpened:            "The locker could not be opened",
+		MessageBookingCancelled:           "Booking cancelled",
+		MessageBookingNotCancelled:        "The booking could not be cancelled",
+		MessageRefundNotRequired:          "No refund required",
+		MessageRefundPending:              "Refund in progress",
+		MessageRefundApproved:             "Refund approved",
+		MessageRefundRejected:             "Refund rejected",
+		MessageRefundFailed:               "The refund could not be requested",
+		MessageExtensionOrderGenerated:    "Booking extension order generated successfully",
+		MessageExtensionOrderNotGenerated: "The booking extension order could not be generated",
+	},
+}
+
+// errorMessages son los textos de los errores de dominio por idioma y código
+var errorMessages = map[model.Locale]map[string]string{
+	model.LocaleSpanish: {
+		exception.ErrPaymentRackNotFound.Code:             "Rack de pago no encontrado",
+		exception.ErrInvalidPaymentRackID.Code:            "ID de rack de pago inválido",
+		exception.ErrPaymentInfraServiceUnavailable.Code:  "El servicio de pagos no está disponible",
+		exception.ErrInvalidBookingTimeID.Code:            "ID de tiempo de reserva inválido",
+		exception.ErrNoLockersAvailable.Code:              "No hay lockers disponibles",
+		exception.ErrInvalidCouponCode.Code:               "Código de cupón inválido",
+		exception.ErrCouponNotFound.Code:                  "Cupón no encontrado",
+		exception.ErrInvalidCoupon.Code:                   "Cupón inválido",
+		exception.ErrInvalidGroupID.Code:                  "ID de grupo inválido",
+		exception.ErrInvalidEmail.Code:                    "Correo electrónico inválido",
+		exception.ErrInvalidPhone.Code:                    "Teléfono inválido",
+		exception.ErrPurchaseOrderFailed.Code:             "No se pudo generar la orden de compra",
+		exception.ErrInvalidTraceID.Code:                  "Trace ID inválido",
+		exception.ErrInvalidGatewayName.Code:              "Nombre de pasarela de pago inválido",
+		exception.ErrInvalidPurchaseOrder.Code:            "Orden de compra inválida",
+		exception.ErrBookingGenerationFailed.Code:         "No se pudo generar la reserva",
+		exception.ErrPurchaseOrderNotFound.Code:           "Orden de compra no encontrada",
+		exception.ErrInvalidServiceName.Code:              "Nombre de servicio inválido",
+		exception.ErrInvalidCurrentCode.Code:              "Código de apertura inválido",
+		exception.ErrBookingNotFound.Code:                 "Reserva no encontrada",
+		exception.ErrExecuteOpenFailed.Code:               "No se pudo abrir el locker",
+		exception.ErrInvalidPaymentResult.Code:            "Resultado de pago inválido",
+		exception.ErrPaymentResultMismatch.Code:           "El resultado de pago no coincide con la orden de compra",
+		exception.ErrInvalidPaymentTransition.Code:        "La orden de compra no puede cambiar al estado notificado",
+		exception.ErrInvalidTimezone.Code:                 "Zona horaria inválida",
+		exception.ErrInvalidBookingReference.Code:         "Se requiere el código de reserva o la orden de compra",
+		exception.ErrInvalidCancellationReason.Code:       "Motivo de cancelación inválido",
+		exception.ErrRefundFailed.Code:                    "No se pudo solicitar el reembolso",
+		exception.ErrInvalidQRImageFormat.Code:            "Formato de imagen QR inválido",
+		exception.ErrInvalidQRImageSize.Code:              "Tamaño de imagen QR inválido",
+		exception.ErrBookingExpired.Code:                  "La reserva expiró",
+		exception.ErrBookingNotStarted.Code:               "La reserva aún no comienza",
+		exception.ErrOpeningsExhausted.Code:               "La reserva no tiene aperturas disponibles",
+		exception.ErrBookingNotCancellable.Code:           "La reserva no se puede cancelar en su estado actual",
+		exception.ErrBookingNotExtendable.Code:            "La reserva no se puede extender en su estado actual",
+		exception.ErrUnknownPaymentGateway.Code:           "Pasarela de pago desconocida",
+		exception.ErrPaymentGatewayDisabled.Code:          "La pasarela de pago no está habilitada para esta instalación",
+		exception.ErrPaymentGatewayAmountNotAccepted.Code: "La pasarela de pago no acepta este monto",
+		exception.ErrQRMalformed.Code:                     "QR firmado con formato inválido",
+		exception.ErrQRSignatureInvalid.Code:              "Firma del QR inválida",
+		exception.ErrQRKeyUnknown.Code:                    "QR firmado con una clave desconocida",
+		exception.ErrQRExpired.Code:                       "El QR expiró",
+		exception.ErrQRUnsigned.Code:                      "No se aceptan QR sin firma",
+		exception.ErrInvalidAuditRange.Code:               "Rango de fechas de auditoría inválido",
+		exception.ErrInvalidPagination.Code:               "Paginación inválida",
+		exception.ErrAdminRequired.Code:                   "Se requiere un token de administrador",
+		exception.ErrAuditDisabled.Code:                   "La auditoría de aperturas está desactivada",
+		exception.ErrUnknownTenant.Code:                   "Tenant desconocido",
+		exception.ErrTenantMismatch.Code:                  "El recurso pertenece a otro tenant",
+	},
+	model.LocaleEnglish: {
+		exception.ErrPaymentRackNotFound.Code:             "Payment rack not found",
+		exception.ErrInvalidPaymentRackID.Code:            "Invalid payment rack ID",
+		exception.ErrPaymentInfraServiceUnavailable.Code:  "The payment service is unavailable",
+		exception.ErrInvalidBookingTimeID.Code:            "Invalid booking time ID",
+		exception.ErrNoLockersAvailable.Code:              "No lockers available",
+		exception.ErrInvalidCouponCode.Code:               "Invalid coupon code",
+		exception.ErrCouponNotFound.Code:                  "Coupon not found",
+		exception.ErrInvalidCoupon.Code:                   "Invalid coupon",
+		exception.ErrInvalidGroupID.Code:                  "Invalid group ID",
+		exception.ErrInvalidEmail.Code:                    "Invalid email",
+		exception.ErrInvalidPhone.Code:                    "Invalid phone",
+		exception.ErrPurchaseOrderFailed.Code:             "The purchase order could not be generated",
+		exception.ErrInvalidTraceID.Code:                  "Invalid trace ID",
+		exception.ErrInvalidGatewayName.Code:              "Invalid payment gateway name",
+		exception.ErrInvalidPurchaseOrder.Code:            "Invalid purchase order",
+		exception.ErrBookingGenerationFailed.Code:         "The booking could not be generated",
+		exception.ErrPurchaseOrderNotFound.Code:           "Purchase order not found",
+		exception.ErrInvalidServiceName.Code:              "Invalid service name",
+		exception.ErrInvalidCurrentCode.Code:              "Invalid opening code",
+		exception.ErrBookingNotFound.Code:                 "Booking not found",
+		exception.ErrExecuteOpenFailed.Code:               "The locker could not be opened",
+		exception.ErrInvalidPaymentResult.Code:            "Invalid payment result",
+		exception.ErrPaymentResultMismatch.Code:           "The payment result does not match the purchase order",
+		exception.ErrInvalidPaymentTransition.Code:        "The purchase order cannot change to the notified status",
+		exception.ErrInvalidTimezone.Code:                 "Invalid timezone",
+		exception.ErrInvalidBookingReference.Code:         "Booking code or purchase order is required",
+		exception.ErrInvalidCancellationReason.Code:       "Invalid cancellation reason",
+		exception.ErrRefundFailed.Code:                    "The refund could not be requested",
+		exception.ErrInvalidQRImageFormat.Code:            "Invalid QR image format",
+		exception.ErrInvalidQRImageSize.Code:              "Invalid QR image size",
+		exception.ErrBookingExpired.Code:                  "The booking has expired",
+		exception.ErrBookingNotStarted.Code:               "The booking has not started yet",
+		exception.ErrOpeningsExhausted.Code:               "The booking has no openings left",
+		exception.ErrBookingNotCancellable.Code:           "The booking cannot be cancelled in its current state",
+		exception.ErrBookingNotExtendable.Code:            "The booking cannot be extended in its current state",
+		exception.ErrUnknownPaymentGateway.Code:           "Unknown payment gateway",
+		exception.ErrPaymentGatewayDisabled.Code:          "The payment gateway is not enabled for this installation",
+		exception.ErrPaymentGatewayAmountNotAccepted.Code: "The payment gateway does not accept this amount",
+		exception.ErrQRMalformed.Code:                     "Malformed signed QR",
+		exception.ErrQRSignatureInvalid.Code:              "Invalid QR signature",
+		exception.ErrQRKeyUnknown.Code:                    "QR signed with an unknown key",
+		exception.ErrQRExpired.Code:                       "The QR has expired",
+		exception.ErrQRUnsigned.Code:                      "Unsigned QR values are not accepted",
+		exception.ErrInvalidAuditRange.Code:               "Invalid audit time range",
+		exception.ErrInvalidPagination.Code:               "Invalid pagination",
+		exception.ErrAdminRequired.Code:                   "Admin token required",
+		exception.ErrAuditDisabled.Code:                   "Locker open audit is disabled",
+		exception.ErrUnknownTenant.Code:                   "Unknown tenant",
+		exception.ErrTenantMismatch.Code:                  "The resource belongs to another tenant",
+	},
+}
+
+// Message devuelve el mensaje de estado en el idioma indicado, o en el idioma por defecto si no
+// está traducido
+func Message(locale model.Locale, key MessageKey) string {
+	if message, ok := messages[locale][key]; ok {
+		return message
+	}
+	return messages[model.DefaultLocale][key]
+}
+
+// StatusMessage devuelve el mensaje de éxito o de error según el estado de la respuesta
+func StatusMessage(locale model.Locale, status model.ResponseStatus, ok MessageKey, failed MessageKey) string {
+	if status == model.ResponseStatusOK {
+		return Message(locale, ok)
+	}
+	return Message(locale, failed)
+}
+
+// Error devuelve el código estable y el texto localizado de un error de dominio; ok es false si
+// err no es un error de dominio
+func Error(locale model.Locale, err error) (code string, message string, ok bool) {
+	var domainErr *exception.DomainError
+	if !errors.As(err, &domainErr) {
+		return "", "", false
+	}
+
+	if message, found := errorMessages[locale][domainErr.Code]; found {
+		return domainErr.Code, message, true
+	}
+	if message, found := errorMessages[model.DefaultLocale][domainErr.Code]; found {
+		return domainErr.Code, message, true
+	}
+	return domainErr.Code, domainErr.Message, true
+}
+
+// ErrorMessage devuelve el texto localizado de un error de dominio, o el texto original de
+// cualquier otro error
+func ErrorMessage(locale model.Locale, err error) string {
+	if _, message, ok := Error(locale, err); ok {
+		return message
+	}
+	return err.Error()
+}
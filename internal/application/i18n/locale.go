@@ -0,0 +1,72 @@
+package i18n
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type localeKey struct{}
+
+// WithLocale devuelve un contexto que lleva el idioma de los mensajes de la solicitud
+func WithLocale(ctx context.Context, locale model.Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext devuelve el idioma del contexto, o el idioma por defecto si no tiene
+func FromContext(ctx context.Context) model.Locale {
+	if locale, ok := ctx.Value(localeKey{}).(model.Locale); ok {
+		return locale
+	}
+	return model.DefaultLocale
+}
+
+// Resolve devuelve el contexto con idioma. Un idioma explícito soportado tiene prioridad sobre
+// el del contexto; uno no soportado se ignora.
+func Resolve(ctx context.Context, explicit *string) (context.Context, model.Locale) {
+	if explicit != nil {
+		if locale, ok := model.ParseLocale(*explicit); ok {
+			return WithLocale(ctx, locale), locale
+		}
+	}
+	return ctx, FromContext(ctx)
+}
+
+// ParseAcceptLanguage elige el idioma soportado de mayor preferencia de un header
+// Accept-Language ("en-US,en;q=0.9,es;q=0.8"); devuelve false si ninguno está soportado
+func ParseAcceptLanguage(header string) (model.Locale, bool) {
+	type candidate struct {
+		locale  model.Locale
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		if locale, ok := model.ParseLocale(tag); ok && quality > 0 {
+			candidates = append(candidates, candidate{locale: locale, quality: quality})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	// A igual preferencia gana el primero del header
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].locale, true
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// FeatureFlagProvider define la fuente de las definiciones de feature flags (archivo,
+// configuración o un servicio externo)
+type FeatureFlagProvider interface {
+	// FeatureFlags devuelve las definiciones vigentes
+	FeatureFlags() []model.FeatureFlag
+}
+
+// FeatureFlags define la evaluación de feature flags con la que los casos de uso y los
+// adaptadores liberan comportamiento nuevo de forma gradual
+type FeatureFlags interface {
+	// Enabled evalúa el flag para el sujeto; el tenant del contexto completa el sujeto. Si el
+	// flag no está definido devuelve fallback.
+	Enabled(ctx context.Context, name string, subject model.FeatureFlagSubject, fallback bool) bool
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// LockerOpenAuditLog define el registro de solo agregado de los intentos de apertura de locker
+type LockerOpenAuditLog interface {
+	Append(ctx context.Context, record model.LockerOpenAuditRecord) error
+	// Query devuelve la página de registros que cumplen el filtro, del más reciente al más antiguo
+	Query(ctx context.Context, filter model.LockerOpenAuditFilter) (*model.LockerOpenAuditPage, error)
+}
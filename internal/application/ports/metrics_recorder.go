@@ -0,0 +1,10 @@
+package ports
+
+import "time"
+
+// MetricsRecorder define la interfaz para registrar métricas operacionales desde la capa de aplicación
+type MetricsRecorder interface {
+	IncCounter(name string)
+	AddCounter(name string, delta int64)
+	ObserveDuration(name string, d time.Duration)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// NotificationSender define el puerto para entregar notificaciones por un canal (correo, SMS)
+type NotificationSender interface {
+	Channel() model.NotificationChannel
+	Send(ctx context.Context, notification model.Notification) error
+}
+
+// ReceiptNotifier define el puerto para enviar comprobantes al usuario sin bloquear el caso de uso
+type ReceiptNotifier interface {
+	NotifyReceipt(ctx context.Context, receipt model.Receipt)
+}
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"time"
+)
+
+// OutboxStore define el almacenamiento local y durable de los eventos de dominio pendientes de entrega
+type OutboxStore interface {
+	Append(ctx context.Context, events ...model.DomainEvent) error
+	// Pending devuelve, en orden de llegada, los eventos cuyo próximo intento ya venció
+	Pending(ctx context.Context, now time.Time, limit int) ([]model.OutboxEntry, error)
+	MarkDelivered(ctx context.Context, eventID string) error
+	Reschedule(ctx context.Context, entry model.OutboxEntry) error
+}
+
+// EventSink define un destino de los eventos de dominio (log, webhook, analítica).
+// La entrega es al menos una vez: los sinks deben tolerar eventos repetidos usando su ID.
+type EventSink interface {
+	Name() string
+	Deliver(ctx context.Context, event model.DomainEvent) error
+}
+
+// DomainEventRecorder define el puerto con el que los casos de uso registran eventos de dominio
+type DomainEventRecorder interface {
+	Record(ctx context.Context, events ...model.DomainEvent)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// PaymentEventPublisher define el puerto para notificar eventos de resultado de pago a los suscriptores
+type PaymentEventPublisher interface {
+	Publish(ctx context.Context, event model.PaymentResultEvent)
+}
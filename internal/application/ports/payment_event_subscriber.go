@@ -0,0 +1,12 @@
+package ports
+
+import "bff-graphql-payment/internal/domain/model"
+
+// PaymentEventSubscriber define el puerto para recibir eventos de resultado de pago de una orden de compra.
+// La función devuelta cancela la suscripción.
+type PaymentEventSubscriber interface {
+	Subscribe(purchaseOrder string) (<-chan model.PaymentResultEvent, func())
+	// SubscribeBlocking es como Subscribe, pero el publicador espera a que el suscriptor reciba
+	// cada evento en vez de descartarlo. Para suscriptores que no pueden perder eventos.
+	SubscribeBlocking(purchaseOrder string) (<-chan model.PaymentResultEvent, func())
+}
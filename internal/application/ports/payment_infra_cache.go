@@ -0,0 +1,25 @@
+package ports
+
+import "bff-graphql-payment/internal/domain/model"
+
+// CacheLookup indica el resultado de consultar una entrada en caché
+type CacheLookup int
+
+const (
+	// CacheMiss la entrada no existe o expiró por completo
+	CacheMiss CacheLookup = iota
+	// CacheFresh la entrada está vigente
+	CacheFresh
+	// CacheStale la entrada venció pero aún puede servirse mientras se revalida
+	CacheStale
+)
+
+// PaymentInfraCache define la caché de infraestructura de pagos indexada por valor QR
+type PaymentInfraCache interface {
+	Get(qrValue string) (*model.PaymentInfra, CacheLookup)
+	Set(qrValue string, paymentInfra *model.PaymentInfra)
+	// Invalidate elimina la entrada del valor QR e indica si existía
+	Invalidate(qrValue string) bool
+	// Purge elimina todas las entradas y devuelve cuántas había
+	Purge() int
+}
@@ -3,6 +3,7 @@ package ports
 import (
 	"bff-graphql-payment/internal/domain/model"
 	"context"
+	"time"
 )
 
 // PaymentInfraRepository define la interfaz del repositorio para datos de infraestructura de pagos
@@ -13,6 +14,13 @@ type PaymentInfraRepository interface {
 	GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error)
 	GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error)
 	GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, purchaseOrder string, status model.PurchaseOrderStatus, traceID string) (*model.PurchaseOrderData, error)
 	CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error)
+	GetBooking(ctx context.Context, currentCode string, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error)
 	ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error)
+	CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error)
+	RequestRefund(ctx context.Context, request model.RefundRequest) (*model.Refund, error)
+	GetBookingExtensionOptions(ctx context.Context, serviceName string, currentCode string, traceID string) (*model.BookingExtensionOptions, error)
+	GenerateBookingExtensionOrder(ctx context.Context, request model.BookingExtensionOrderRequest) (*model.BookingExtensionOrder, error)
+	UpdateBookingFinish(ctx context.Context, serviceName string, currentCode string, finishBooking time.Time, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error)
 }
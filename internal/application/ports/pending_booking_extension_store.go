@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// PendingBookingExtensionStore define el almacenamiento de las extensiones de reserva que esperan
+// la confirmación del pago de su orden
+type PendingBookingExtensionStore interface {
+	Save(ctx context.Context, extension model.PendingBookingExtension) error
+	Delete(ctx context.Context, purchaseOrder string) error
+	// List devuelve todas las extensiones pendientes, por ejemplo al reiniciar el proceso
+	List(ctx context.Context) ([]model.PendingBookingExtension, error)
+}
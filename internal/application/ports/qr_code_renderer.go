@@ -0,0 +1,8 @@
+package ports
+
+import "bff-graphql-payment/internal/domain/model"
+
+// QRCodeRenderer define la interfaz para dibujar contenido como imagen QR
+type QRCodeRenderer interface {
+	Render(content string, format model.QRImageFormat, size int) ([]byte, error)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/caller"
+	"bff-graphql-payment/internal/domain/exception"
+	"context"
+	"crypto/subtle"
+	"log/slog"
+)
+
+// authorizeAdmin verifica que el cliente haya presentado el token de administrador. Sin token
+// configurado la operación queda deshabilitada para todos.
+func authorizeAdmin(ctx context.Context, adminToken string, operation string) error {
+	info := caller.FromContext(ctx)
+	if adminToken == "" || subtle.ConstantTimeCompare([]byte(info.AdminToken), []byte(adminToken)) != 1 {
+		slog.WarnContext(ctx, "⚠️ Admin operation denied", "operation", operation, "principal", info.Principal, "clientIp", info.ClientIP)
+		return exception.ErrAdminRequired
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// BookingCodeQRService dibuja el código de apertura de una reserva como imagen QR en el servidor
+type BookingCodeQRService struct {
+	renderer ports.QRCodeRenderer
+}
+
+// NewBookingCodeQRService crea un nuevo servicio de QR de códigos de reserva
+func NewBookingCodeQRService(renderer ports.QRCodeRenderer) *BookingCodeQRService {
+	return &BookingCodeQRService{renderer: renderer}
+}
+
+// RenderBookingCode valida la solicitud y dibuja el QR. Sin formato ni tamaño se usa PNG de 256 px.
+func (s *BookingCodeQRService) RenderBookingCode(ctx context.Context, request model.BookingCodeQRRequest) (*model.QRImage, error) {
+	// Validar entrada
+	request.Code = strings.TrimSpace(request.Code)
+	if request.Code == "" {
+		return nil, exception.ErrInvalidCurrentCode
+	}
+
+	if request.Format == "" {
+		request.Format = model.QRImageFormatPNG
+	}
+	if request.Format != model.QRImageFormatPNG && request.Format != model.QRImageFormatSVG {
+		return nil, exception.ErrInvalidQRImageFormat
+	}
+
+	if request.Size == 0 {
+		request.Size = model.DefaultQRImageSize
+	}
+	if request.Size < model.MinQRImageSize || request.Size > model.MaxQRImageSize {
+		return nil, fmt.Errorf("%w: must be between %d and %d", exception.ErrInvalidQRImageSize, model.MinQRImageSize, model.MaxQRImageSize)
+	}
+
+	request.ServiceName = strings.TrimSpace(request.ServiceName)
+	payload := request.Payload()
+
+	content, err := s.renderer.Render(payload, request.Format, request.Size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render QR: %w", err)
+	}
+
+	slog.DebugContext(ctx, "🔳 Booking code QR rendered", "format", request.Format, "size", request.Size, "bytes", len(content))
+
+	return &model.QRImage{
+		Format:  request.Format,
+		Size:    request.Size,
+		Payload: payload,
+		Content: content,
+	}, nil
+}
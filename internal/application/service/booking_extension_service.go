@@ -0,0 +1,353 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	domainService "bff-graphql-payment/internal/domain/service"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bookingExtensionApplyTimeout limita la actualización de la reserva tras confirmarse el pago
+const bookingExtensionApplyTimeout = 30 * time.Second
+
+// BookingExtensionOptions contiene los ajustes de la conciliación de extensiones pendientes
+type BookingExtensionOptions struct {
+	// ReconcileInterval es cada cuánto se consulta al backend el estado de las órdenes pendientes,
+	// por si el webhook de la pasarela no llegó o llegó mientras el proceso estaba detenido
+	ReconcileInterval time.Duration
+	// PendingTTL es el plazo tras el cual se descarta una extensión cuyo pago no se resolvió
+	PendingTTL time.Duration
+}
+
+// BookingExtensionService implementa la extensión de reservas. Genera una orden de compra
+// ligada a la reserva y, cuando un webhook confirma el pago, actualiza finishBooking.
+// Las extensiones pendientes se guardan en un almacenamiento durable hasta que su orden llega a
+// un estado terminal, y se concilian periódicamente con GetPurchaseOrderByPo.
+type BookingExtensionService struct {
+	repo       ports.PaymentInfraRepository
+	subscriber ports.PaymentEventSubscriber
+	gateways   *domainService.PaymentGatewayRegistry
+	// flags libera las pasarelas de forma gradual; nil usa solo la configuración
+	flags   ports.FeatureFlags
+	store   ports.PendingBookingExtensionStore
+	options BookingExtensionOptions
+
+	// pending refleja el almacenamiento; una extensión se quita mientras se resuelve para que el
+	// webhook y la conciliación no la apliquen dos veces
+	mu      sync.Mutex
+	pending map[string]model.PendingBookingExtension
+
+	unsubscribe func()
+	stop        chan struct{}
+	stopOnce    sync.Once
+	wg          sync.WaitGroup
+}
+
+// NewBookingExtensionService crea un nuevo servicio de extensión de reservas
+func NewBookingExtensionService(repo ports.PaymentInfraRepository, subscriber ports.PaymentEventSubscriber, gateways *domainService.PaymentGatewayRegistry, flags ports.FeatureFlags, store ports.PendingBookingExtensionStore, options BookingExtensionOptions) (*BookingExtensionService, error) {
+	if options.ReconcileInterval <= 0 || options.PendingTTL <= 0 {
+		return nil, fmt.Errorf("invalid booking extension options: reconcileInterval=%s, pendingTtl=%s", options.ReconcileInterval, options.PendingTTL)
+	}
+
+	return &BookingExtensionService{
+		repo:       repo,
+		subscriber: subscriber,
+		gateways:   gateways,
+		flags:      flags,
+		store:      store,
+		options:    options,
+		pending:    make(map[string]model.PendingBookingExtension),
+		stop:       make(chan struct{}),
+	}, nil
+}
+
+// Start carga las extensiones pendientes de una ejecución anterior, comienza a escuchar los
+// eventos de pago y concilia periódicamente las órdenes pendientes
+func (s *BookingExtensionService) Start() error {
+	extensions, err := s.store.List(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to load pending booking extensions: %w", err)
+	}
+
+	s.mu.Lock()
+	for _, extension := range extensions {
+		s.pending[extension.PurchaseOrder] = extension
+	}
+	s.mu.Unlock()
+
+	// Suscripción bloqueante: un evento de pago no se descarta aunque la aplicación sea lenta
+	events, unsubscribe := s.subscriber.SubscribeBlocking("")
+	s.unsubscribe = unsubscribe
+
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		for event := range events {
+			s.resolve(event.PurchaseOrder, event.OrderStatus)
+		}
+	}()
+
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.options.ReconcileInterval)
+		defer ticker.Stop()
+
+		for {
+			s.reconcile()
+
+			select {
+			case <-ticker.C:
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop deja de escuchar los eventos de pago y de conciliar; las extensiones pendientes
+// permanecen en el almacenamiento
+func (s *BookingExtensionService) Stop() {
+	s.stopOnce.Do(func() {
+		if s.unsubscribe != nil {
+			s.unsubscribe()
+		}
+		close(s.stop)
+	})
+	s.wg.Wait()
+}
+
+// ExtendBooking genera la orden de compra para extender una reserva activa con un tiempo de reserva del rack
+func (s *BookingExtensionService) ExtendBooking(ctx context.Context, request model.BookingExtensionRequest) (*model.BookingExtension, error) {
+	// Validar entrada
+	if strings.TrimSpace(request.ServiceName) == "" {
+		return nil, exception.ErrInvalidServiceName
+	}
+
+	if strings.TrimSpace(request.CurrentCode) == "" {
+		return nil, exception.ErrInvalidCurrentCode
+	}
+
+	if request.BookingTimeID <= 0 {
+		return nil, exception.ErrInvalidBookingTimeID
+	}
+
+	if strings.TrimSpace(request.GatewayName) == "" {
+		return nil, exception.ErrInvalidGatewayName
+	}
+
+	if strings.TrimSpace(request.TraceID) == "" {
+		return nil, exception.ErrInvalidTraceID
+	}
+
+	// Solo se extienden reservas activas
+	bookingStatus, err := s.repo.CheckBookingStatus(ctx, request.ServiceName, request.CurrentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if bookingStatus == nil || bookingStatus.Status == model.ResponseStatusError || bookingStatus.Booking == nil {
+		return nil, exception.ErrBookingNotFound
+	}
+
+	booking := bookingStatus.Booking
+	if state := booking.State(time.Now()); state != model.BookingStateActive {
+		slog.WarnContext(ctx, "🚫 ExtendBooking rejected", "state", state, "serviceName", request.ServiceName)
+		return nil, exception.ErrBookingNotExtendable
+	}
+
+	// Cotizar el tiempo adicional con los tiempos de reserva del rack
+	options, err := s.repo.GetBookingExtensionOptions(ctx, request.ServiceName, request.CurrentCode, request.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	option, ok := options.Option(request.BookingTimeID)
+	if !ok {
+		return nil, exception.ErrInvalidBookingTimeID
+	}
+
+	// Validar que la pasarela exista, esté habilitada y acepte el monto
+	gateway, err := s.gateways.Resolve(request.GatewayName, options.RackID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !gatewayReleased(ctx, s.flags, request.GatewayName, options.RackID) {
+		return nil, exception.ErrPaymentGatewayDisabled
+	}
+
+	if !model.AcceptsAmount(gateway, option.Price) {
+		return nil, exception.ErrPaymentGatewayAmountNotAccepted
+	}
+
+	// Generar la orden de compra ligada a la reserva existente
+	order, err := s.repo.GenerateBookingExtensionOrder(ctx, model.BookingExtensionOrderRequest{
+		BookingID:     options.BookingID,
+		ServiceName:   request.ServiceName,
+		CurrentCode:   request.CurrentCode,
+		BookingTimeID: option.BookingTime.ID,
+		Amount:        option.Price,
+		GatewayName:   gateway.Name(),
+		TraceID:       request.TraceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Guardar la extensión antes de entregar la URL de pago: sin ella el pago no se aplicaría
+	pending := model.PendingBookingExtension{
+		PurchaseOrder: order.PurchaseOrder,
+		ServiceName:   request.ServiceName,
+		CurrentCode:   request.CurrentCode,
+		BookingTime:   option.BookingTime,
+		TraceID:       request.TraceID,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.store.Save(ctx, pending); err != nil {
+		return nil, fmt.Errorf("failed to save booking extension: %w", err)
+	}
+
+	s.mu.Lock()
+	s.pending[order.PurchaseOrder] = pending
+	s.mu.Unlock()
+
+	slog.InfoContext(ctx, "🧾 Booking extension pending payment", "purchaseOrder", order.PurchaseOrder, "serviceName", request.ServiceName, "bookingTimeId", option.BookingTime.ID, "price", option.Price)
+
+	return &model.BookingExtension{
+		Order:                order,
+		BookingTime:          option.BookingTime,
+		Price:                option.Price,
+		CurrentFinishBooking: booking.FinishBooking,
+		NewFinishBooking:     option.BookingTime.ExtendFrom(booking.FinishBooking),
+	}, nil
+}
+
+// resolve aplica la extensión cuando su orden se paga y la descarta si la orden termina sin pago.
+// Si la aplicación falla, la extensión vuelve a quedar pendiente y la conciliación la reintenta.
+func (s *BookingExtensionService) resolve(purchaseOrder string, status model.PurchaseOrderStatus) {
+	if !status.IsTerminal() {
+		return
+	}
+
+	extension, ok := s.claim(purchaseOrder)
+	if !ok {
+		return
+	}
+
+	// Registrar y aplicar con el traceId de la solicitud que generó la extensión
+	ctx, cancel := context.WithTimeout(tracing.WithTraceID(context.Background(), extension.TraceID), bookingExtensionApplyTimeout)
+	defer cancel()
+
+	if status != model.PurchaseOrderStatusPaid {
+		slog.WarnContext(ctx, "⚠️ Booking extension discarded", "purchaseOrder", purchaseOrder, "status", status)
+		s.forget(ctx, purchaseOrder)
+		return
+	}
+
+	if err := s.apply(ctx, extension); err != nil {
+		slog.ErrorContext(ctx, "❌ Booking extension could not be applied, will retry", "purchaseOrder", extension.PurchaseOrder, "serviceName", extension.ServiceName, "error", err)
+		s.release(extension)
+		return
+	}
+
+	s.forget(ctx, purchaseOrder)
+}
+
+// reconcile consulta el estado de las órdenes pendientes para resolver las que no recibieron
+// su evento de pago y descarta las que superaron PendingTTL sin resolverse
+func (s *BookingExtensionService) reconcile() {
+	s.mu.Lock()
+	extensions := make([]model.PendingBookingExtension, 0, len(s.pending))
+	for _, extension := range s.pending {
+		extensions = append(extensions, extension)
+	}
+	s.mu.Unlock()
+
+	for _, extension := range extensions {
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		ctx, cancel := context.WithTimeout(tracing.WithTraceID(context.Background(), extension.TraceID), bookingExtensionApplyTimeout)
+		order, err := s.repo.GetPurchaseOrderByPo(ctx, extension.PurchaseOrder, extension.TraceID)
+		cancel()
+
+		if err != nil {
+			slog.WarnContext(ctx, "⚠️ Booking extension reconciliation failed", "purchaseOrder", extension.PurchaseOrder, "error", err)
+		} else if order != nil && order.OrderStatus.IsTerminal() {
+			slog.InfoContext(ctx, "🔁 Booking extension reconciled", "purchaseOrder", extension.PurchaseOrder, "status", order.OrderStatus)
+			s.resolve(extension.PurchaseOrder, order.OrderStatus)
+			continue
+		}
+
+		if time.Since(extension.CreatedAt) > s.options.PendingTTL {
+			if _, ok := s.claim(extension.PurchaseOrder); ok {
+				slog.WarnContext(ctx, "⚠️ Booking extension expired without payment result", "purchaseOrder", extension.PurchaseOrder, "createdAt", extension.CreatedAt.Format(time.RFC3339))
+				s.forget(ctx, extension.PurchaseOrder)
+			}
+		}
+	}
+}
+
+// claim quita la extensión de las pendientes para resolverla; false si otro ya la tomó
+func (s *BookingExtensionService) claim(purchaseOrder string) (model.PendingBookingExtension, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	extension, ok := s.pending[purchaseOrder]
+	delete(s.pending, purchaseOrder)
+	return extension, ok
+}
+
+// release devuelve a las pendientes una extensión que no se pudo aplicar
+func (s *BookingExtensionService) release(extension model.PendingBookingExtension) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[extension.PurchaseOrder] = extension
+}
+
+// forget elimina del almacenamiento una extensión ya resuelta. Si falla, la extensión se carga
+// de nuevo al reiniciar y se resuelve otra vez; UpdateBookingFinish recibe la orden de compra
+// para que el backend pueda reconocer la repetición.
+func (s *BookingExtensionService) forget(ctx context.Context, purchaseOrder string) {
+	if err := s.store.Delete(ctx, purchaseOrder); err != nil {
+		slog.ErrorContext(ctx, "❌ Failed to remove booking extension", "purchaseOrder", purchaseOrder, "error", err)
+	}
+}
+
+// apply extiende finishBooking desde el término vigente, o desde ahora si la reserva ya venció durante el pago
+func (s *BookingExtensionService) apply(ctx context.Context, extension model.PendingBookingExtension) error {
+	bookingStatus, err := s.repo.CheckBookingStatus(ctx, extension.ServiceName, extension.CurrentCode)
+	if err != nil {
+		return err
+	}
+
+	if bookingStatus == nil || bookingStatus.Status == model.ResponseStatusError || bookingStatus.Booking == nil {
+		return exception.ErrBookingNotFound
+	}
+
+	from := bookingStatus.Booking.FinishBooking
+	if now := time.Now(); from.Before(now) {
+		from = now
+	}
+	finishBooking := extension.BookingTime.ExtendFrom(from)
+
+	if _, err := s.repo.UpdateBookingFinish(ctx, extension.ServiceName, extension.CurrentCode, finishBooking, extension.PurchaseOrder, extension.TraceID); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "✅ Booking extended", "purchaseOrder", extension.PurchaseOrder, "serviceName", extension.ServiceName, "finishBooking", finishBooking.Format(time.RFC3339))
+	return nil
+}
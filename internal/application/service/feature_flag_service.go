@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+// FeatureFlagService evalúa los feature flags de los proveedores configurados. Los features del
+// tenant de la solicitud tienen prioridad; entre proveedores gana el primero que define el flag.
+type FeatureFlagService struct {
+	providers []ports.FeatureFlagProvider
+}
+
+// NewFeatureFlagService crea el servicio de feature flags con sus proveedores, en orden de prioridad
+func NewFeatureFlagService(providers ...ports.FeatureFlagProvider) *FeatureFlagService {
+	return &FeatureFlagService{providers: providers}
+}
+
+// Enabled implementa ports.FeatureFlags
+func (s *FeatureFlagService) Enabled(ctx context.Context, name string, subject model.FeatureFlagSubject, fallback bool) bool {
+	return s.evaluate(ctx, s.flags(), name, subject, fallback)
+}
+
+// FeatureFlags implementa el caso de uso de consulta de feature flags. Devuelve todos los
+// flags evaluados para el sujeto, ordenados por nombre; los flags de mocks son internos y no
+// se exponen.
+func (s *FeatureFlagService) FeatureFlags(ctx context.Context, subject model.FeatureFlagSubject) ([]model.FeatureFlagValue, error) {
+	// Validar entrada
+	if subject.RackID < 0 || subject.InstallationID < 0 {
+		return nil, exception.ErrInvalidPaymentRackID
+	}
+
+	flags := s.flags()
+	names := make(map[string]bool, len(flags))
+	for name := range flags {
+		names[name] = true
+	}
+	if tenant := tenancy.FromContext(ctx); tenant != nil {
+		for name := range tenant.Features {
+			names[name] = true
+		}
+	}
+
+	values := make([]model.FeatureFlagValue, 0, len(names))
+	for name := range names {
+		if strings.HasPrefix(name, model.FeatureFlagMockPrefix) {
+			continue
+		}
+		values = append(values, model.FeatureFlagValue{Name: name, Enabled: s.evaluate(ctx, flags, name, subject, false)})
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
+	return values, nil
+}
+
+// evaluate evalúa un flag para el sujeto; el tenant del contexto completa el sujeto y sus
+// features reemplazan a la definición del flag
+func (s *FeatureFlagService) evaluate(ctx context.Context, flags map[string]model.FeatureFlag, name string, subject model.FeatureFlagSubject, fallback bool) bool {
+	tenant := tenancy.FromContext(ctx)
+	if tenant != nil && subject.TenantID == "" {
+		subject.TenantID = tenant.ID
+	}
+
+	if tenant != nil && strings.EqualFold(tenant.ID, subject.TenantID) {
+		if enabled, ok := tenant.Features[name]; ok {
+			return enabled
+		}
+	}
+
+	flag, ok := flags[name]
+	if !ok {
+		return fallback
+	}
+	return flag.Evaluate(subject)
+}
+
+// flags combina las definiciones de los proveedores; el primero que define un flag gana
+func (s *FeatureFlagService) flags() map[string]model.FeatureFlag {
+	flags := make(map[string]model.FeatureFlag)
+	for _, provider := range s.providers {
+		for _, flag := range provider.FeatureFlags() {
+			if _, ok := flags[flag.Name]; !ok {
+				flags[flag.Name] = flag
+			}
+		}
+	}
+	return flags
+}
+
+// gatewayReleased indica si la pasarela está liberada para el rack; sin flags o sin flag
+// gateway.<nombre> definido la pasarela se considera liberada
+func gatewayReleased(ctx context.Context, flags ports.FeatureFlags, gatewayName string, rackID int) bool {
+	if flags == nil {
+		return true
+	}
+
+	if flags.Enabled(ctx, model.GatewayFeatureFlag(gatewayName), model.FeatureFlagSubject{RackID: rackID}, true) {
+		return true
+	}
+
+	slog.InfoContext(ctx, "🚩 Payment gateway not released by feature flag", "gatewayName", gatewayName, "rackId", rackID)
+	return false
+}
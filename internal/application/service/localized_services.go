@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/domain/ports"
+	"context"
+)
+
+// LocalizedPaymentInfraService reemplaza el mensaje de las respuestas del backend, que llegan en
+// idiomas mezclados, por el mensaje de estado del catálogo en el idioma de la solicitud. El
+// mensaje original se conserva en Detail para no perder el motivo de un fallo del backend. Los
+// resultados se copian antes de modificarlos porque pueden venir de la caché.
+type LocalizedPaymentInfraService struct {
+	ports.PaymentInfraService
+}
+
+// NewLocalizedPaymentInfraService envuelve el servicio de infraestructura de pagos
+func NewLocalizedPaymentInfraService(inner ports.PaymentInfraService) *LocalizedPaymentInfraService {
+	return &LocalizedPaymentInfraService{PaymentInfraService: inner}
+}
+
+// GetPaymentInfraByQrValue implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	paymentInfra, err := s.PaymentInfraService.GetPaymentInfraByQrValue(ctx, qrValue)
+	if err != nil || paymentInfra == nil {
+		return paymentInfra, err
+	}
+
+	localized := *paymentInfra
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessagePaymentInfraFound, i18n.MessagePaymentInfraNotFound)
+	return &localized, nil
+}
+
+// GetAvailableLockers implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
+	lockers, err := s.PaymentInfraService.GetAvailableLockers(ctx, paymentRackID, bookingTimeID, traceID)
+	if err != nil || lockers == nil {
+		return lockers, err
+	}
+
+	localized := *lockers
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessageLockersFound, i18n.MessageLockersNotFound)
+	return &localized, nil
+}
+
+// ValidateDiscountCoupon implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error) {
+	validation, err := s.PaymentInfraService.ValidateDiscountCoupon(ctx, couponCode, rackID, traceID)
+	if err != nil || validation == nil {
+		return validation, err
+	}
+
+	localized := *validation
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessageCouponValid, i18n.MessageCouponInvalid)
+	return &localized, nil
+}
+
+// GeneratePurchaseOrder implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error) {
+	order, err := s.PaymentInfraService.GeneratePurchaseOrder(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID, gatewayName)
+	if err != nil || order == nil {
+		return order, err
+	}
+
+	localized := *order
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessagePurchaseOrderGenerated, i18n.MessagePurchaseOrderNotGenerated)
+	return &localized, nil
+}
+
+// GenerateBooking implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error) {
+	booking, err := s.PaymentInfraService.GenerateBooking(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID)
+	if err != nil || booking == nil {
+		return booking, err
+	}
+
+	localized := *booking
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessageBookingGenerated, i18n.MessageBookingNotGenerated)
+	return &localized, nil
+}
+
+// GetPurchaseOrderByPo implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error) {
+	orderData, err := s.PaymentInfraService.GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+	if err != nil || orderData == nil {
+		return orderData, err
+	}
+	return localizePurchaseOrderData(i18n.FromContext(ctx), orderData), nil
+}
+
+// CheckBookingStatus implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error) {
+	bookingStatus, err := s.PaymentInfraService.CheckBookingStatus(ctx, serviceName, currentCode)
+	if err != nil || bookingStatus == nil {
+		return bookingStatus, err
+	}
+
+	localized := *bookingStatus
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), localized.Status, i18n.MessageBookingFound, i18n.MessageBookingNotFound)
+	return &localized, nil
+}
+
+// ExecuteOpen implementa ports.PaymentInfraService. La apertura falla también cuando el backend
+// informa OPEN_STATUS_ERROR con respuesta OK.
+func (s *LocalizedPaymentInfraService) ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error) {
+	openResult, err := s.PaymentInfraService.ExecuteOpen(ctx, serviceName, currentCode)
+	if err != nil || openResult == nil {
+		return openResult, err
+	}
+
+	status := openResult.Status
+	if openResult.OpenStatus == model.OpenStatusError {
+		status = model.ResponseStatusError
+	}
+
+	localized := *openResult
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(i18n.FromContext(ctx), status, i18n.MessageLockerOpened, i18n.MessageLockerNotOpened)
+	return &localized, nil
+}
+
+// CancelBooking implementa ports.PaymentInfraService
+func (s *LocalizedPaymentInfraService) CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error) {
+	cancellation, err := s.PaymentInfraService.CancelBooking(ctx, request)
+	if err != nil || cancellation == nil {
+		return cancellation, err
+	}
+
+	locale := i18n.FromContext(ctx)
+	localized := *cancellation
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(locale, localized.Status, i18n.MessageBookingCancelled, i18n.MessageBookingNotCancelled)
+
+	if cancellation.Refund != nil {
+		refund := *cancellation.Refund
+		if key, ok := refundMessages[refund.Status]; ok {
+			refund.Detail, refund.Message = refund.Message, i18n.Message(locale, key)
+		}
+		localized.Refund = &refund
+	}
+
+	return &localized, nil
+}
+
+// refundMessages son los mensajes de estado de cada estado de reembolso
+var refundMessages = map[model.RefundStatus]i18n.MessageKey{
+	model.RefundStatusNotRequired: i18n.MessageRefundNotRequired,
+	model.RefundStatusPending:     i18n.MessageRefundPending,
+	model.RefundStatusApproved:    i18n.MessageRefundApproved,
+	model.RefundStatusRejected:    i18n.MessageRefundRejected,
+	model.RefundStatusFailed:      i18n.MessageRefundFailed,
+}
+
+// LocalizedBookingExtensionService localiza el mensaje de la orden de extensión de reserva
+type LocalizedBookingExtensionService struct {
+	ports.BookingExtensionService
+}
+
+// NewLocalizedBookingExtensionService envuelve el servicio de extensión de reservas
+func NewLocalizedBookingExtensionService(inner ports.BookingExtensionService) *LocalizedBookingExtensionService {
+	return &LocalizedBookingExtensionService{BookingExtensionService: inner}
+}
+
+// ExtendBooking implementa ports.BookingExtensionService
+func (s *LocalizedBookingExtensionService) ExtendBooking(ctx context.Context, request model.BookingExtensionRequest) (*model.BookingExtension, error) {
+	extension, err := s.BookingExtensionService.ExtendBooking(ctx, request)
+	if err != nil || extension == nil || extension.Order == nil {
+		return extension, err
+	}
+
+	order := *extension.Order
+	order.Detail, order.Message = order.Message, i18n.StatusMessage(i18n.FromContext(ctx), order.Status, i18n.MessageExtensionOrderGenerated, i18n.MessageExtensionOrderNotGenerated)
+
+	localized := *extension
+	localized.Order = &order
+	return &localized, nil
+}
+
+// LocalizedPurchaseOrderWatchService localiza el mensaje de cada estado emitido de la orden de compra
+type LocalizedPurchaseOrderWatchService struct {
+	ports.PurchaseOrderWatchService
+}
+
+// NewLocalizedPurchaseOrderWatchService envuelve el servicio de seguimiento de órdenes de compra
+func NewLocalizedPurchaseOrderWatchService(inner ports.PurchaseOrderWatchService) *LocalizedPurchaseOrderWatchService {
+	return &LocalizedPurchaseOrderWatchService{PurchaseOrderWatchService: inner}
+}
+
+// WatchPurchaseOrderStatus implementa ports.PurchaseOrderWatchService
+func (s *LocalizedPurchaseOrderWatchService) WatchPurchaseOrderStatus(ctx context.Context, purchaseOrder string, traceID string) (<-chan *model.PurchaseOrderData, error) {
+	updates, err := s.PurchaseOrderWatchService.WatchPurchaseOrderStatus(ctx, purchaseOrder, traceID)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := i18n.FromContext(ctx)
+	localized := make(chan *model.PurchaseOrderData, 1)
+	go func() {
+		defer close(localized)
+		for orderData := range updates {
+			if orderData != nil {
+				orderData = localizePurchaseOrderData(locale, orderData)
+			}
+			select {
+			case localized <- orderData:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return localized, nil
+}
+
+// localizePurchaseOrderData devuelve una copia de la orden de compra con el mensaje localizado
+func localizePurchaseOrderData(locale model.Locale, orderData *model.PurchaseOrderData) *model.PurchaseOrderData {
+	localized := *orderData
+	localized.Detail, localized.Message = localized.Message, i18n.StatusMessage(locale, localized.Status, i18n.MessagePurchaseOrderFound, i18n.MessagePurchaseOrderNotFound)
+	return &localized
+}
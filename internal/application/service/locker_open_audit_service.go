@@ -0,0 +1,67 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/caller"
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// LockerOpenAuditService implementa la consulta administrativa del registro de aperturas de locker
+type LockerOpenAuditService struct {
+	auditLog   ports.LockerOpenAuditLog
+	adminToken string
+}
+
+// NewLockerOpenAuditService crea el servicio de consulta de auditoría. Sin token de administrador
+// configurado la consulta queda deshabilitada para todos.
+func NewLockerOpenAuditService(auditLog ports.LockerOpenAuditLog, adminToken string) *LockerOpenAuditService {
+	return &LockerOpenAuditService{
+		auditLog:   auditLog,
+		adminToken: adminToken,
+	}
+}
+
+// QueryLockerOpenAudit devuelve los intentos de apertura de un serviceName en el rango [from, to)
+func (s *LockerOpenAuditService) QueryLockerOpenAudit(ctx context.Context, filter model.LockerOpenAuditFilter) (*model.LockerOpenAuditPage, error) {
+	// Verificar que el cliente sea administrador
+	if err := authorizeAdmin(ctx, s.adminToken, "lockerOpenAudit"); err != nil {
+		return nil, err
+	}
+
+	if s.auditLog == nil {
+		return nil, exception.ErrAuditDisabled
+	}
+
+	// Validar entrada
+	filter.ServiceName = strings.TrimSpace(filter.ServiceName)
+	if filter.ServiceName == "" {
+		return nil, exception.ErrInvalidServiceName
+	}
+
+	if filter.From.IsZero() || filter.To.IsZero() || !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", exception.ErrInvalidAuditRange)
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = model.DefaultLockerOpenAuditLimit
+	}
+	if filter.Limit < 0 || filter.Limit > model.MaxLockerOpenAuditLimit || filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d and offset must not be negative", exception.ErrInvalidPagination, model.MaxLockerOpenAuditLimit)
+	}
+
+	// Consultar el registro
+	page, err := s.auditLog.Query(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query locker open audit: %w", err)
+	}
+
+	slog.InfoContext(ctx, "🔎 Locker open audit queried", "principal", caller.FromContext(ctx).Principal, "serviceName", filter.ServiceName, "from", filter.From.Format(time.RFC3339), "to", filter.To.Format(time.RFC3339), "total", page.TotalCount)
+
+	return page, nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// outboxDeliveryTimeout limita la entrega de un evento a un sink
+const outboxDeliveryTimeout = 30 * time.Second
+
+// OutboxOptions contiene los ajustes del despachador del outbox
+type OutboxOptions struct {
+	// PollInterval es cada cuánto se revisan los eventos pendientes si no llegan eventos nuevos
+	PollInterval time.Duration
+	// BatchSize es la cantidad máxima de eventos despachados por revisión
+	BatchSize int
+	// InitialBackoff es la espera tras la primera entrega fallida; se duplica hasta MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// OutboxService registra los eventos de dominio en un almacenamiento local antes de que el caso
+// de uso responda, y un despachador en segundo plano los entrega a los sinks con reintentos.
+// Un evento se elimina solo cuando todos los sinks lo recibieron (entrega al menos una vez).
+type OutboxService struct {
+	store   ports.OutboxStore
+	sinks   []ports.EventSink
+	options OutboxOptions
+	metrics ports.MetricsRecorder
+
+	wake     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewOutboxService crea un nuevo outbox de eventos de dominio
+func NewOutboxService(store ports.OutboxStore, sinks []ports.EventSink, options OutboxOptions, metrics ports.MetricsRecorder) (*OutboxService, error) {
+	if options.PollInterval <= 0 || options.BatchSize <= 0 || options.InitialBackoff <= 0 || options.MaxBackoff < options.InitialBackoff {
+		return nil, fmt.Errorf("invalid outbox options: pollInterval=%s, batchSize=%d, initialBackoff=%s, maxBackoff=%s",
+			options.PollInterval, options.BatchSize, options.InitialBackoff, options.MaxBackoff)
+	}
+
+	return &OutboxService{
+		store:   store,
+		sinks:   sinks,
+		options: options,
+		metrics: metrics,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// Start inicia el despachador. Los eventos que quedaron pendientes en una ejecución anterior se entregan primero.
+func (s *OutboxService) Start() {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.options.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			s.dispatch()
+
+			select {
+			case <-s.wake:
+			case <-ticker.C:
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el despachador; los eventos no entregados permanecen en el almacenamiento
+func (s *OutboxService) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+}
+
+// Record guarda los eventos en el outbox y avisa al despachador. Un error al guardar se registra
+// pero no hace fallar el caso de uso, que ya se completó en el backend.
+func (s *OutboxService) Record(ctx context.Context, events ...model.DomainEvent) {
+	_, traceID := tracing.Ensure(ctx, nil)
+	now := time.Now()
+
+	for i := range events {
+		if events[i].ID == "" {
+			events[i].ID = uuid.NewString()
+		}
+		if events[i].OccurredAt.IsZero() {
+			events[i].OccurredAt = now
+		}
+		if events[i].TraceID == "" {
+			events[i].TraceID = traceID
+		}
+	}
+
+	if err := s.store.Append(ctx, events...); err != nil {
+		slog.ErrorContext(ctx, "❌ Failed to record domain events in outbox", "error", err)
+		s.recordMetric("outbox_record_failed_total")
+		return
+	}
+
+	for _, event := range events {
+		slog.DebugContext(ctx, "📤 Domain event recorded", "type", event.Type, "id", event.ID)
+		s.recordMetric("outbox_recorded_total")
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch entrega los eventos pendientes hasta vaciar los que ya vencieron
+func (s *OutboxService) dispatch() {
+	for {
+		entries, err := s.store.Pending(context.Background(), time.Now(), s.options.BatchSize)
+		if err != nil {
+			slog.Error("❌ Failed to read outbox", "error", err)
+			return
+		}
+
+		for _, entry := range entries {
+			select {
+			case <-s.stop:
+				return
+			default:
+			}
+			s.deliver(entry)
+		}
+
+		if len(entries) < s.options.BatchSize {
+			return
+		}
+	}
+}
+
+// deliver entrega un evento a los sinks que aún no lo recibieron y actualiza su estado
+func (s *OutboxService) deliver(entry model.OutboxEntry) {
+	ctx, _ := tracing.Ensure(context.Background(), &entry.Event.TraceID)
+
+	var errs []error
+	for _, sink := range s.sinks {
+		if entry.DeliveredTo(sink.Name()) {
+			continue
+		}
+
+		sinkCtx, cancel := context.WithTimeout(ctx, outboxDeliveryTimeout)
+		err := sink.Deliver(sinkCtx, entry.Event)
+		cancel()
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+			continue
+		}
+		entry.DeliveredSinks = append(entry.DeliveredSinks, sink.Name())
+	}
+
+	if len(errs) == 0 {
+		if err := s.store.MarkDelivered(ctx, entry.Event.ID); err != nil {
+			slog.ErrorContext(ctx, "❌ Failed to mark outbox event as delivered", "id", entry.Event.ID, "error", err)
+			return
+		}
+		s.recordMetric("outbox_delivered_total")
+		return
+	}
+
+	entry.Attempts++
+	entry.LastError = errors.Join(errs...).Error()
+	backoff := s.backoff(entry.Attempts)
+	entry.NextAttemptAt = time.Now().Add(backoff)
+
+	slog.WarnContext(ctx, "⚠️ Outbox delivery failed, retrying",
+		"backoff", backoff, "type", entry.Event.Type, "id", entry.Event.ID, "attempt", entry.Attempts, "error", entry.LastError)
+	s.recordMetric("outbox_delivery_failed_total")
+
+	if err := s.store.Reschedule(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "❌ Failed to reschedule outbox event", "id", entry.Event.ID, "error", err)
+	}
+}
+
+// backoff calcula la espera exponencial para el intento indicado
+func (s *OutboxService) backoff(attempts int) time.Duration {
+	backoff := s.options.InitialBackoff
+	for i := 1; i < attempts && backoff < s.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, s.options.MaxBackoff)
+}
+
+// recordMetric incrementa un contador si hay métricas configuradas
+func (s *OutboxService) recordMetric(name string) {
+	if s.metrics != nil {
+		s.metrics.IncCounter(name)
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// paymentInfraRevalidateTimeout limita la revalidación en segundo plano de una entrada obsoleta
+const paymentInfraRevalidateTimeout = 30 * time.Second
+
+// cachedPaymentInfraByQrValue obtiene la infraestructura desde la caché o el repositorio.
+// Una entrada obsoleta se sirve de inmediato y se revalida en segundo plano. Las entradas se
+// guardan por tenant porque cada tenant puede tener su propio backend.
+func (s *PaymentInfraService) cachedPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	if s.infraCache == nil {
+		return s.repo.GetPaymentInfraByQrValue(ctx, qrValue)
+	}
+
+	qrValue = strings.TrimSpace(qrValue)
+	key := tenancy.Key(ctx, qrValue)
+
+	cached, lookup := s.infraCache.Get(key)
+	switch lookup {
+	case ports.CacheFresh:
+		s.recordMetric("payment_infra_cache_hits_total")
+		return cached, nil
+	case ports.CacheStale:
+		s.recordMetric("payment_infra_cache_stale_hits_total")
+		s.revalidatePaymentInfra(ctx, key, qrValue)
+		return cached, nil
+	}
+
+	s.recordMetric("payment_infra_cache_misses_total")
+	return s.loadPaymentInfra(ctx, key, qrValue)
+}
+
+// loadPaymentInfra consulta el repositorio y guarda el resultado solo si es exitoso
+func (s *PaymentInfraService) loadPaymentInfra(ctx context.Context, key string, qrValue string) (*model.PaymentInfra, error) {
+	paymentInfra, err := s.repo.GetPaymentInfraByQrValue(ctx, qrValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nunca cachear respuestas de error
+	if paymentInfra != nil && paymentInfra.Status != model.ResponseStatusError {
+		s.infraCache.Set(key, paymentInfra)
+	}
+
+	return paymentInfra, nil
+}
+
+// revalidatePaymentInfra refresca en segundo plano una entrada obsoleta, una sola vez por entrada
+func (s *PaymentInfraService) revalidatePaymentInfra(ctx context.Context, key string, qrValue string) {
+	s.revalidateMu.Lock()
+	if s.revalidating[key] {
+		s.revalidateMu.Unlock()
+		return
+	}
+	s.revalidating[key] = true
+	s.revalidateMu.Unlock()
+
+	// La revalidación no depende de la solicitud que la disparó
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), paymentInfraRevalidateTimeout)
+
+	go func() {
+		defer cancel()
+		defer func() {
+			s.revalidateMu.Lock()
+			delete(s.revalidating, key)
+			s.revalidateMu.Unlock()
+		}()
+
+		if _, err := s.loadPaymentInfra(ctx, key, qrValue); err != nil {
+			slog.WarnContext(ctx, "⚠️ Payment infra cache revalidation failed", "qrValue", qrValue, "error", err)
+			s.recordMetric("payment_infra_cache_revalidation_failures_total")
+		}
+	}()
+}
+
+// InvalidatePaymentInfraCache elimina de la caché la entrada de un valor QR, o todas si qrValue es nil.
+// Devuelve la cantidad de entradas eliminadas. Es una operación administrativa: vaciar la caché
+// traslada toda la carga al backend.
+func (s *PaymentInfraService) InvalidatePaymentInfraCache(ctx context.Context, qrValue *string) (int, error) {
+	if err := authorizeAdmin(ctx, s.adminToken, "invalidatePaymentInfraCache"); err != nil {
+		return 0, err
+	}
+
+	if s.infraCache == nil {
+		return 0, nil
+	}
+
+	if qrValue == nil {
+		removed := s.infraCache.Purge()
+		slog.InfoContext(ctx, "🧹 Payment infra cache purged", "entries", removed)
+		return removed, nil
+	}
+
+	trimmed := strings.TrimSpace(*qrValue)
+	if trimmed == "" {
+		return 0, nil
+	}
+
+	if !s.infraCache.Invalidate(tenancy.Key(ctx, trimmed)) {
+		return 0, nil
+	}
+
+	slog.InfoContext(ctx, "🧹 Payment infra cache invalidated", "qrValue", trimmed, "tenant", tenancy.ID(ctx))
+	return 1, nil
+}
+
+// recordMetric incrementa un contador si hay registrador de métricas
+func (s *PaymentInfraService) recordMetric(name string) {
+	if s.metrics != nil {
+		s.metrics.IncCounter(name)
+	}
+}
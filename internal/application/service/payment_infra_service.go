@@ -1,23 +1,68 @@
 package service
 
 import (
+	"bff-graphql-payment/internal/application/caller"
 	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tracing"
 	"bff-graphql-payment/internal/domain/exception"
 	domainException "bff-graphql-payment/internal/domain/exception"
 	"bff-graphql-payment/internal/domain/model"
+	domainService "bff-graphql-payment/internal/domain/service"
 	"context"
+	"log/slog"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // PaymentInfraService implementa los casos de uso de infraestructura de pagos
 type PaymentInfraService struct {
-	repo ports.PaymentInfraRepository
+	repo      ports.PaymentInfraRepository
+	gateways  *domainService.PaymentGatewayRegistry
+	openGuard *domainService.OpenGuard
+	metrics   ports.MetricsRecorder
+
+	// Verificación de QR firmados; nil acepta cualquier valor QR
+	qrVerifier *QRVerifier
+
+	// Comprobantes al usuario tras generar una reserva; nil no envía comprobantes
+	notifier ports.ReceiptNotifier
+
+	// Outbox de eventos de dominio; nil no registra eventos
+	events ports.DomainEventRecorder
+
+	// Registro de auditoría de los intentos de apertura; nil no los registra
+	audit ports.LockerOpenAuditLog
+
+	// Feature flags de liberación gradual de pasarelas y reglas de apertura; nil usa solo la configuración
+	flags ports.FeatureFlags
+
+	// Token de las operaciones administrativas; vacío las deshabilita
+	adminToken string
+
+	// Caché read-through de GetPaymentInfraByQrValue; nil la desactiva
+	infraCache   ports.PaymentInfraCache
+	revalidateMu sync.Mutex
+	revalidating map[string]bool
 }
 
 // NewPaymentInfraService crea un nuevo servicio de infraestructura de pagos
-func NewPaymentInfraService(repo ports.PaymentInfraRepository) *PaymentInfraService {
+func NewPaymentInfraService(repo ports.PaymentInfraRepository, gateways *domainService.PaymentGatewayRegistry, openGuard *domainService.OpenGuard, qrVerifier *QRVerifier, infraCache ports.PaymentInfraCache, notifier ports.ReceiptNotifier, events ports.DomainEventRecorder, audit ports.LockerOpenAuditLog, flags ports.FeatureFlags, adminToken string, metrics ports.MetricsRecorder) *PaymentInfraService {
 	return &PaymentInfraService{
-		repo: repo,
+		repo:         repo,
+		gateways:     gateways,
+		openGuard:    openGuard,
+		metrics:      metrics,
+		qrVerifier:   qrVerifier,
+		infraCache:   infraCache,
+		notifier:     notifier,
+		events:       events,
+		audit:        audit,
+		flags:        flags,
+		adminToken:   adminToken,
+		revalidating: make(map[string]bool),
 	}
 }
 
@@ -28,15 +73,50 @@ func (s *PaymentInfraService) GetPaymentInfraByQrValue(ctx context.Context, qrVa
 		return nil, exception.ErrInvalidPaymentRackID
 	}
 
-	// Llamar al repositorio
-	paymentInfra, err := s.repo.GetPaymentInfraByQrValue(ctx, qrValue)
+	// Verificar la firma del QR y obtener el valor con el que lo identifica el backend
+	qrValue, claims, err := s.verifyQR(ctx, qrValue)
 	if err != nil {
 		return nil, err
 	}
 
+	// Consultar a través de la caché
+	paymentInfra, err := s.cachedPaymentInfraByQrValue(ctx, qrValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// Un QR firmado solo es válido para el rack y la instalación que firmó
+	if claims != nil && paymentInfra != nil && paymentInfra.Status != model.ResponseStatusError && !claims.Matches(paymentInfra) {
+		slog.WarnContext(ctx, "🚫 Signed QR does not match payment rack", "kid", claims.KeyID, "rackId", claims.RackID, "installationId", claims.InstallationID)
+		s.recordMetric("qr_rejected_total")
+		return nil, exception.ErrQRSignatureInvalid
+	}
+
 	return paymentInfra, nil
 }
 
+// verifyQR aplica la verificación de QR firmados, si está configurada
+func (s *PaymentInfraService) verifyQR(ctx context.Context, qrValue string) (string, *model.QRClaims, error) {
+	if s.qrVerifier == nil {
+		return qrValue, nil, nil
+	}
+
+	backendQRValue, claims, err := s.qrVerifier.Verify(qrValue)
+	if err != nil {
+		slog.WarnContext(ctx, "🚫 QR rejected", "error", err)
+		s.recordMetric("qr_rejected_total")
+		return "", nil, err
+	}
+
+	if claims != nil {
+		s.recordMetric("qr_signed_accepted_total")
+	} else if s.qrVerifier.mode == model.QRVerificationMigration {
+		s.recordMetric("qr_legacy_accepted_total")
+	}
+
+	return backendQRValue, claims, nil
+}
+
 // GetAvailableLockers obtiene los lockers disponibles por ID de rack y tiempo de reserva
 func (s *PaymentInfraService) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
 	// Validar entrada
@@ -80,6 +160,22 @@ func (s *PaymentInfraService) ValidateDiscountCoupon(ctx context.Context, coupon
 	return s.repo.ValidateDiscountCoupon(ctx, couponCode, rackID, traceID)
 }
 
+// QuotePaymentGroup cotiza un grupo de lockers del rack aplicando el cupón de descuento, si se indica
+func (s *PaymentInfraService) QuotePaymentGroup(ctx context.Context, rackID int, group model.AvailablePaymentGroup, couponCode *string, traceID string) (*model.PaymentQuote, error) {
+	if couponCode == nil || strings.TrimSpace(*couponCode) == "" {
+		quote := model.NewPaymentQuote(group, nil, nil)
+		return &quote, nil
+	}
+
+	validation, err := s.ValidateDiscountCoupon(ctx, *couponCode, rackID, traceID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := model.NewPaymentQuote(group, couponCode, validation)
+	return &quote, nil
+}
+
 // GeneratePurchaseOrder genera una orden de compra
 func (s *PaymentInfraService) GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error) {
 	// Validar entrada
@@ -107,12 +203,31 @@ func (s *PaymentInfraService) GeneratePurchaseOrder(ctx context.Context, rackIdR
 		return nil, exception.ErrInvalidGatewayName
 	}
 
+	// Validar que la pasarela exista y esté habilitada antes de llamar al backend
+	if _, err := s.gateways.Resolve(gatewayName, rackIdReference); err != nil {
+		return nil, err
+	}
+
+	if !gatewayReleased(ctx, s.flags, gatewayName, rackIdReference) {
+		return nil, exception.ErrPaymentGatewayDisabled
+	}
+
 	// Llamar al repositorio
 	order, err := s.repo.GeneratePurchaseOrder(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID, gatewayName)
 	if err != nil {
 		return nil, err
 	}
 
+	if order.Status == model.ResponseStatusOK {
+		s.recordEvent(ctx, model.DomainEventPurchaseOrderCreated, traceID, map[string]any{
+			"rackId":        rackIdReference,
+			"groupId":       groupID,
+			"gatewayName":   gatewayName,
+			"couponApplied": couponCode != nil,
+			"transactionId": order.TransactionID,
+		})
+	}
+
 	return order, nil
 }
 
@@ -137,6 +252,26 @@ func (s *PaymentInfraService) GenerateBooking(ctx context.Context, rackIdReferen
 		return nil, err
 	}
 
+	if booking.Status == model.ResponseStatusOK {
+		s.recordEvent(ctx, model.DomainEventBookingCreated, traceID, map[string]any{
+			"rackId":        rackIdReference,
+			"groupId":       groupID,
+			"code":          model.MaskCode(booking.Code),
+			"transactionId": booking.TransactionID,
+		})
+	}
+
+	// Enviar el comprobante de la reserva (asíncrono: sus datos se consultan al procesarlo)
+	if s.notifier != nil && booking.Status == model.ResponseStatusOK && booking.Code != "" {
+		s.notifier.NotifyReceipt(ctx, model.Receipt{
+			Event:       model.ReceiptEventBookingGenerated,
+			Email:       userEmail,
+			Phone:       userPhone,
+			BookingCode: booking.Code,
+			TraceID:     traceID,
+		})
+	}
+
 	return booking, nil
 }
 
@@ -191,11 +326,273 @@ func (s *PaymentInfraService) ExecuteOpen(ctx context.Context, serviceName strin
 		return nil, exception.ErrInvalidCurrentCode
 	}
 
+	// Validar el estado de la reserva antes de abrir
+	if err := s.checkBeforeOpen(ctx, serviceName, currentCode); err != nil {
+		s.recordOpenAttempt(ctx, serviceName, currentCode, model.LockerOpenOutcomeRejected, nil, err)
+		return nil, err
+	}
+
 	// Llamar al repositorio
 	openResult, err := s.repo.ExecuteOpen(ctx, serviceName, currentCode)
 	if err != nil {
+		s.recordOpenAttempt(ctx, serviceName, currentCode, model.LockerOpenOutcomeFailed, nil, err)
 		return nil, err
 	}
 
+	outcome := model.LockerOpenOutcomeOpened
+	if openResult.Status != model.ResponseStatusOK || openResult.OpenStatus == model.OpenStatusError {
+		outcome = model.LockerOpenOutcomeFailed
+	}
+
+	s.recordOpenAttempt(ctx, serviceName, currentCode, outcome, openResult, nil)
 	return openResult, nil
 }
+
+// checkBeforeOpen consulta la reserva y evalúa las reglas de apertura del serviceName, si están activas
+func (s *PaymentInfraService) checkBeforeOpen(ctx context.Context, serviceName string, currentCode string) error {
+	if s.openGuard == nil {
+		return nil
+	}
+
+	rules := s.openGuard.RulesFor(serviceName)
+
+	// El flag openGuard, si está definido, libera las reglas de forma gradual por serviceName
+	if s.flags != nil {
+		rules.Enabled = s.flags.Enabled(ctx, model.FeatureFlagOpenGuard, model.FeatureFlagSubject{Key: serviceName}, rules.Enabled)
+	}
+
+	if !rules.Enabled {
+		return nil
+	}
+
+	bookingStatus, err := s.repo.CheckBookingStatus(ctx, serviceName, currentCode)
+	if err != nil {
+		return err
+	}
+
+	var booking *model.BookingStatusData
+	if bookingStatus != nil && bookingStatus.Status != model.ResponseStatusError {
+		booking = bookingStatus.Booking
+	}
+
+	if err := s.openGuard.Evaluate(rules, booking, time.Now()); err != nil {
+		slog.WarnContext(ctx, "🚫 ExecuteOpen rejected by pre-open guard", "serviceName", serviceName, "reason", err)
+		return err
+	}
+
+	return nil
+}
+
+// AvailablePaymentGateways obtiene las pasarelas de pago habilitadas para un rack
+func (s *PaymentInfraService) AvailablePaymentGateways(ctx context.Context, rackID int) ([]model.PaymentGateway, error) {
+	// Validar entrada
+	if rackID <= 0 {
+		return nil, exception.ErrInvalidPaymentRackID
+	}
+
+	gateways := s.gateways.Available(rackID)
+	released := make([]model.PaymentGateway, 0, len(gateways))
+	for _, gateway := range gateways {
+		if gatewayReleased(ctx, s.flags, gateway.Name(), rackID) {
+			released = append(released, gateway)
+		}
+	}
+	return released, nil
+}
+
+// CancelBooking cancela una reserva y, si su orden de compra estaba pagada, solicita el reembolso.
+// Es una operación administrativa: requiere el token de administrador.
+func (s *PaymentInfraService) CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error) {
+	// Verificar que el cliente sea administrador
+	if err := authorizeAdmin(ctx, s.adminToken, "cancelBooking"); err != nil {
+		return nil, err
+	}
+
+	request.ServiceName = strings.TrimSpace(request.ServiceName)
+	request.CurrentCode = strings.TrimSpace(request.CurrentCode)
+	request.PurchaseOrder = strings.TrimSpace(request.PurchaseOrder)
+	request.Reason = strings.TrimSpace(request.Reason)
+
+	// Validar entrada
+	if !request.HasBookingCode() && request.PurchaseOrder == "" {
+		return nil, exception.ErrInvalidBookingReference
+	}
+
+	if request.Reason == "" {
+		return nil, exception.ErrInvalidCancellationReason
+	}
+
+	if strings.TrimSpace(request.TraceID) == "" {
+		return nil, exception.ErrInvalidTraceID
+	}
+
+	// Verificar que el estado de la reserva permita cancelarla
+	state, order, err := s.bookingStateForCancellation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.CanTransitionTo(model.BookingStateCancelled) {
+		slog.WarnContext(ctx, "🚫 CancelBooking rejected", "state", state, "serviceName", request.ServiceName, "purchaseOrder", request.PurchaseOrder)
+		return nil, exception.ErrBookingNotCancellable
+	}
+
+	// Llamar al repositorio
+	cancellation, err := s.repo.CancelBooking(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if cancellation.PurchaseOrder == "" {
+		cancellation.PurchaseOrder = request.PurchaseOrder
+	}
+
+	// La orden asociada solo se conoce tras cancelar cuando la reserva se identificó por código
+	if order == nil && cancellation.PurchaseOrder != "" {
+		order, err = s.repo.GetPurchaseOrderByPo(ctx, cancellation.PurchaseOrder, request.TraceID)
+		if err != nil {
+			slog.WarnContext(ctx, "⚠️ CancelBooking could not load purchase order", "purchaseOrder", cancellation.PurchaseOrder, "error", err)
+		}
+	}
+
+	cancellation.Refund = s.refund(ctx, cancellation.PurchaseOrder, order, request)
+	return cancellation, nil
+}
+
+// bookingStateForCancellation obtiene el estado actual de la reserva a cancelar y, si se indicó, su orden de compra
+func (s *PaymentInfraService) bookingStateForCancellation(ctx context.Context, request model.BookingCancellationRequest) (model.BookingState, *model.PurchaseOrderData, error) {
+	var order *model.PurchaseOrderData
+	if request.PurchaseOrder != "" {
+		var err error
+		order, err = s.repo.GetPurchaseOrderByPo(ctx, request.PurchaseOrder, request.TraceID)
+		if err != nil {
+			return "", nil, err
+		}
+		if order == nil {
+			return "", nil, exception.ErrBookingNotFound
+		}
+	}
+
+	if request.HasBookingCode() {
+		bookingStatus, err := s.repo.CheckBookingStatus(ctx, request.ServiceName, request.CurrentCode)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if bookingStatus == nil || bookingStatus.Status == model.ResponseStatusError || bookingStatus.Booking == nil {
+			return "", nil, exception.ErrBookingNotFound
+		}
+
+		booking := *bookingStatus.Booking
+		if order != nil && booking.PaymentStatus == "" {
+			booking.PaymentStatus = order.OrderStatus
+		}
+		return booking.State(time.Now()), order, nil
+	}
+
+	// Sin código de reserva, el estado se deriva del pago; una orden con un estado desconocido no se cancela
+	state, ok := model.BookingStateForPayment(order.OrderStatus)
+	if !ok {
+		slog.WarnContext(ctx, "⚠️ CancelBooking found an unknown purchase order status", "purchaseOrder", request.PurchaseOrder, "orderStatus", order.OrderStatus)
+		return "", order, exception.ErrBookingNotCancellable
+	}
+	return state, order, nil
+}
+
+// refund solicita el reembolso de la orden si estaba pagada. Un reembolso fallido no revierte
+// la cancelación: se informa como FAILED para que soporte pueda reintentarlo.
+func (s *PaymentInfraService) refund(ctx context.Context, purchaseOrder string, order *model.PurchaseOrderData, request model.BookingCancellationRequest) *model.Refund {
+	if order == nil || order.OrderStatus != model.PurchaseOrderStatusPaid {
+		return &model.Refund{
+			Status:  model.RefundStatusNotRequired,
+			Message: "No refund required",
+		}
+	}
+
+	refund, err := s.repo.RequestRefund(ctx, model.RefundRequest{
+		PurchaseOrder: purchaseOrder,
+		Amount:        order.FinalProductPrice,
+		Reason:        request.Reason,
+		TraceID:       request.TraceID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "❌ Refund request failed", "purchaseOrder", purchaseOrder, "error", err)
+		return &model.Refund{
+			Status:  model.RefundStatusFailed,
+			Amount:  order.FinalProductPrice,
+			Message: err.Error(),
+		}
+	}
+
+	return refund
+}
+
+// recordOpenAttempt registra el intento de apertura en la auditoría y emite LockerOpened o
+// LockerOpenFailed según su resultado. Un error al auditar se registra pero no hace fallar la apertura.
+func (s *PaymentInfraService) recordOpenAttempt(ctx context.Context, serviceName string, currentCode string, outcome model.LockerOpenOutcome, openResult *model.ExecuteOpenResult, openErr error) {
+	info := caller.FromContext(ctx)
+	record := model.LockerOpenAuditRecord{
+		ID:          uuid.NewString(),
+		Timestamp:   time.Now().UTC(),
+		Principal:   info.Principal,
+		ClientIP:    info.ClientIP,
+		UserAgent:   info.UserAgent,
+		ServiceName: serviceName,
+		MaskedCode:  model.MaskCode(currentCode),
+		Outcome:     outcome,
+		TraceID:     tracing.TraceID(ctx),
+	}
+
+	switch {
+	case openErr != nil:
+		record.Reason = openErr.Error()
+	case outcome != model.LockerOpenOutcomeOpened:
+		record.Reason = openResult.Message
+	}
+
+	if openResult != nil {
+		record.OpenStatuses = openResult.ReceivedStatuses
+		if len(record.OpenStatuses) == 0 {
+			record.OpenStatuses = []model.OpenStatus{openResult.OpenStatus}
+		}
+		record.UpstreamTransactionID = openResult.TransactionID
+	}
+
+	if s.audit != nil {
+		if err := s.audit.Append(ctx, record); err != nil {
+			slog.ErrorContext(ctx, "❌ Failed to append locker open audit record", "serviceName", serviceName, "outcome", outcome, "error", err)
+			s.recordMetric("locker_open_audit_failed_total")
+		}
+	}
+
+	data := map[string]any{
+		"serviceName": serviceName,
+		"code":        record.MaskedCode,
+	}
+	if record.Reason != "" {
+		data["reason"] = record.Reason
+	}
+	if openResult != nil {
+		data["openStatus"] = string(openResult.OpenStatus)
+		data["transactionId"] = openResult.TransactionID
+	}
+
+	eventType := model.DomainEventLockerOpenFailed
+	if outcome == model.LockerOpenOutcomeOpened {
+		eventType = model.DomainEventLockerOpened
+	}
+	s.recordEvent(ctx, eventType, "", data)
+}
+
+// recordEvent registra un evento de dominio en el outbox si está configurado
+func (s *PaymentInfraService) recordEvent(ctx context.Context, eventType model.DomainEventType, traceID string, data map[string]any) {
+	if s.events == nil {
+		return
+	}
+
+	s.events.Record(ctx, model.DomainEvent{
+		Type:    eventType,
+		TraceID: traceID,
+		Data:    data,
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PaymentWebhookService implementa el caso de uso de confirmación de pagos notificados por las pasarelas
+type PaymentWebhookService struct {
+	repo      ports.PaymentInfraRepository
+	publisher ports.PaymentEventPublisher
+
+	// locks serializa las notificaciones de una misma orden para que las entregas duplicadas y
+	// concurrentes vean el estado que dejó la anterior
+	locks [paymentWebhookLockStripes]sync.Mutex
+}
+
+// paymentWebhookLockStripes es la cantidad de candados entre los que se reparten las órdenes
+const paymentWebhookLockStripes = 64
+
+// NewPaymentWebhookService crea un nuevo servicio de confirmación de pagos
+func NewPaymentWebhookService(repo ports.PaymentInfraRepository, publisher ports.PaymentEventPublisher) *PaymentWebhookService {
+	return &PaymentWebhookService{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// HandlePaymentResult actualiza el estado de la orden de compra y notifica a los suscriptores.
+// La notificación debe coincidir con la pasarela y el monto de la orden, y solo una orden
+// pendiente cambia de estado; una entrega repetida del estado vigente no tiene efecto.
+func (s *PaymentWebhookService) HandlePaymentResult(ctx context.Context, event *model.PaymentResultEvent) (*model.PurchaseOrderData, error) {
+	// Validar entrada
+	if event == nil {
+		return nil, exception.ErrInvalidPaymentResult
+	}
+
+	if strings.TrimSpace(event.GatewayName) == "" {
+		return nil, exception.ErrInvalidGatewayName
+	}
+
+	if strings.TrimSpace(event.PurchaseOrder) == "" {
+		return nil, exception.ErrInvalidPurchaseOrder
+	}
+
+	orderStatus, ok := event.Result.OrderStatus()
+	if !ok {
+		return nil, exception.ErrInvalidPaymentResult
+	}
+
+	lock := s.lockFor(event.PurchaseOrder)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Cargar la orden para validar la notificación contra ella
+	current, err := s.repo.GetPurchaseOrderByPo(ctx, event.PurchaseOrder, event.TraceID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, exception.ErrPurchaseOrderNotFound
+	}
+
+	if err := matchPaymentResult(current, event, orderStatus); err != nil {
+		slog.WarnContext(ctx, "⚠️ Payment result does not match purchase order",
+			"gateway", event.GatewayName, "orderGateway", current.GatewayName, "purchaseOrder", event.PurchaseOrder,
+			"amount", event.Amount, "orderAmount", current.FinalProductPrice)
+		return nil, err
+	}
+
+	if current.OrderStatus == orderStatus {
+		slog.InfoContext(ctx, "💳 Duplicate payment result ignored", "gateway", event.GatewayName, "purchaseOrder", event.PurchaseOrder, "orderStatus", orderStatus)
+		return current, nil
+	}
+
+	if !current.OrderStatus.CanTransitionTo(orderStatus) {
+		slog.WarnContext(ctx, "🚫 Payment result rejected by purchase order status",
+			"gateway", event.GatewayName, "purchaseOrder", event.PurchaseOrder, "from", current.OrderStatus, "to", orderStatus)
+		return nil, exception.ErrInvalidPaymentTransition
+	}
+
+	// Llamar al repositorio
+	orderData, err := s.repo.UpdatePurchaseOrderStatus(ctx, event.PurchaseOrder, orderStatus, event.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "💳 Payment result processed", "gateway", event.GatewayName, "purchaseOrder", event.PurchaseOrder, "result", event.Result, "orderStatus", orderStatus)
+
+	// Notificar a los suscriptores
+	published := *event
+	published.OrderStatus = orderStatus
+	published.TenantID = tenancy.ID(ctx)
+	if published.OccurredAt.IsZero() {
+		published.OccurredAt = time.Now()
+	}
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, published)
+	}
+
+	return orderData, nil
+}
+
+// matchPaymentResult verifica que la pasarela y el monto notificados correspondan a la orden.
+// Un pago aprobado debe informar el monto; en los demás resultados el monto es opcional.
+func matchPaymentResult(order *model.PurchaseOrderData, event *model.PaymentResultEvent, orderStatus model.PurchaseOrderStatus) error {
+	if order.GatewayName != "" && !strings.EqualFold(order.GatewayName, event.GatewayName) {
+		return exception.ErrPaymentResultMismatch
+	}
+
+	if event.Amount == 0 && orderStatus != model.PurchaseOrderStatusPaid {
+		return nil
+	}
+
+	if event.Amount != order.FinalProductPrice {
+		return exception.ErrPaymentResultMismatch
+	}
+	return nil
+}
+
+// lockFor devuelve el candado que serializa las notificaciones de la orden
+func (s *PaymentWebhookService) lockFor(purchaseOrder string) *sync.Mutex {
+	hash := fnv.New32a()
+	hash.Write([]byte(purchaseOrder))
+	return &s.locks[hash.Sum32()%paymentWebhookLockStripes]
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// PurchaseOrderWatchService implementa el seguimiento del estado de órdenes de compra.
+// Reacciona a los eventos de pago de los webhooks y, como respaldo, consulta
+// periódicamente GetPurchaseOrderByPo con backoff exponencial.
+type PurchaseOrderWatchService struct {
+	repo            ports.PaymentInfraRepository
+	subscriber      ports.PaymentEventSubscriber
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+}
+
+// NewPurchaseOrderWatchService crea un nuevo servicio de seguimiento de órdenes de compra
+func NewPurchaseOrderWatchService(repo ports.PaymentInfraRepository, subscriber ports.PaymentEventSubscriber, minPollInterval time.Duration, maxPollInterval time.Duration) *PurchaseOrderWatchService {
+	return &PurchaseOrderWatchService{
+		repo:            repo,
+		subscriber:      subscriber,
+		minPollInterval: minPollInterval,
+		maxPollInterval: maxPollInterval,
+	}
+}
+
+// WatchPurchaseOrderStatus emite el estado actual de la orden y luego cada cambio hasta un estado terminal
+func (s *PurchaseOrderWatchService) WatchPurchaseOrderStatus(ctx context.Context, purchaseOrder string, traceID string) (<-chan *model.PurchaseOrderData, error) {
+	// Validar entrada
+	if strings.TrimSpace(purchaseOrder) == "" {
+		return nil, exception.ErrInvalidPurchaseOrder
+	}
+
+	if strings.TrimSpace(traceID) == "" {
+		return nil, exception.ErrInvalidTraceID
+	}
+
+	// Suscribirse antes de consultar para no perder eventos entre ambos pasos
+	var events <-chan model.PaymentResultEvent
+	unsubscribe := func() {}
+	if s.subscriber != nil {
+		events, unsubscribe = s.subscriber.Subscribe(purchaseOrder)
+	}
+
+	current, err := s.repo.GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	if current == nil {
+		unsubscribe()
+		return nil, exception.ErrPurchaseOrderNotFound
+	}
+
+	updates := make(chan *model.PurchaseOrderData, 1)
+
+	go func() {
+		defer close(updates)
+		defer unsubscribe()
+
+		if !s.emit(ctx, updates, current) || current.OrderStatus.IsTerminal() {
+			return
+		}
+		lastStatus := current.OrderStatus
+
+		interval := s.minPollInterval
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-events:
+				if !ok {
+					// El broker cerró la suscripción; continuar solo con polling
+					events = nil
+					continue
+				}
+				slog.DebugContext(ctx, "📡 Purchase order event received", "purchaseOrder", purchaseOrder, "result", event.Result)
+
+			case <-timer.C:
+			}
+
+			latest, err := s.repo.GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+			if err != nil {
+				slog.WarnContext(ctx, "⚠️ Purchase order status poll failed", "purchaseOrder", purchaseOrder, "error", err)
+			} else if latest == nil {
+				slog.WarnContext(ctx, "⚠️ Purchase order status poll returned no order", "purchaseOrder", purchaseOrder)
+			} else if latest.OrderStatus != lastStatus {
+				if !s.emit(ctx, updates, latest) || latest.OrderStatus.IsTerminal() {
+					return
+				}
+				lastStatus = latest.OrderStatus
+				interval = s.minPollInterval
+			} else {
+				interval = min(interval*2, s.maxPollInterval)
+			}
+
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(interval)
+		}
+	}()
+
+	return updates, nil
+}
+
+// emit envía la actualización salvo que el contexto se haya cancelado
+func (s *PurchaseOrderWatchService) emit(ctx context.Context, updates chan<- *model.PurchaseOrderData, data *model.PurchaseOrderData) bool {
+	select {
+	case updates <- data:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
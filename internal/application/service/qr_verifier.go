@@ -0,0 +1,228 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SignedQRPrefix identifica un QR firmado: qr1.<payload>.<firma>, ambos en base64url sin relleno.
+// La firma cubre "qr1.<payload>"; cualquier valor sin el prefijo se considera heredado.
+const SignedQRPrefix = "qr1."
+
+// minHMACKeyLength es el largo mínimo de un secreto HMAC-SHA256
+const minHMACKeyLength = 32
+
+// signedQRPayload es el contenido firmado de un QR de rack
+type signedQRPayload struct {
+	KeyID          string `json:"kid"`
+	QRValue        string `json:"qr"`
+	RackID         int    `json:"rack"`
+	InstallationID int    `json:"inst"`
+	ExpiresAt      int64  `json:"exp"`
+}
+
+// QRVerifier verifica los QR firmados de los racks con las claves configuradas. Admite varias
+// claves a la vez para rotarlas: se agrega la nueva, se firman los QR con ella y se retira la anterior.
+type QRVerifier struct {
+	mode model.QRVerificationMode
+	keys map[string]model.QRKey
+	now  func() time.Time
+}
+
+// NewQRVerifier crea un verificador de QR firmados
+func NewQRVerifier(mode model.QRVerificationMode, keys []model.QRKey) (*QRVerifier, error) {
+	switch mode {
+	case model.QRVerificationDisabled, model.QRVerificationMigration, model.QRVerificationEnforced:
+	default:
+		return nil, fmt.Errorf("unknown QR verification mode %q", mode)
+	}
+
+	v := &QRVerifier{
+		mode: mode,
+		keys: make(map[string]model.QRKey, len(keys)),
+		now:  time.Now,
+	}
+
+	for _, key := range keys {
+		if err := validateQRKey(key); err != nil {
+			return nil, err
+		}
+		if _, ok := v.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicated QR key %q", key.ID)
+		}
+		v.keys[key.ID] = key
+	}
+
+	if mode != model.QRVerificationDisabled && len(v.keys) == 0 {
+		return nil, fmt.Errorf("QR verification mode %q requires at least one key", mode)
+	}
+
+	return v, nil
+}
+
+// Verify valida el valor QR recibido. Devuelve el valor QR con el que consultar al backend y,
+// para los QR firmados, sus datos firmados; para los valores heredados aceptados devuelve nil.
+func (v *QRVerifier) Verify(qrValue string) (string, *model.QRClaims, error) {
+	qrValue = strings.TrimSpace(qrValue)
+
+	if v.mode == model.QRVerificationDisabled {
+		return qrValue, nil, nil
+	}
+
+	if !strings.HasPrefix(qrValue, SignedQRPrefix) {
+		if v.mode == model.QRVerificationMigration {
+			return qrValue, nil, nil
+		}
+		return "", nil, exception.ErrQRUnsigned
+	}
+
+	claims, err := v.verifySigned(qrValue)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return claims.QRValue, claims, nil
+}
+
+// verifySigned decodifica el QR firmado, valida la firma con la clave indicada y su vigencia
+func (v *QRVerifier) verifySigned(qrValue string) (*model.QRClaims, error) {
+	payloadSegment, signatureSegment, ok := strings.Cut(strings.TrimPrefix(qrValue, SignedQRPrefix), ".")
+	if !ok || payloadSegment == "" || signatureSegment == "" {
+		return nil, exception.ErrQRMalformed
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(payloadSegment)
+	if err != nil {
+		return nil, exception.ErrQRMalformed
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(signatureSegment)
+	if err != nil {
+		return nil, exception.ErrQRMalformed
+	}
+
+	var payload signedQRPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil || payload.QRValue == "" || payload.ExpiresAt == 0 {
+		return nil, exception.ErrQRMalformed
+	}
+
+	key, ok := v.keys[payload.KeyID]
+	if !ok {
+		return nil, exception.ErrQRKeyUnknown
+	}
+
+	if !verifyQRSignature(key, []byte(SignedQRPrefix+payloadSegment), signature) {
+		return nil, exception.ErrQRSignatureInvalid
+	}
+
+	claims := &model.QRClaims{
+		KeyID:          payload.KeyID,
+		QRValue:        payload.QRValue,
+		RackID:         payload.RackID,
+		InstallationID: payload.InstallationID,
+		ExpiresAt:      time.Unix(payload.ExpiresAt, 0),
+	}
+
+	if claims.Expired(v.now()) {
+		return nil, exception.ErrQRExpired
+	}
+
+	return claims, nil
+}
+
+// SignQR genera un QR firmado. Para HS256 signingKey es el secreto compartido; para EdDSA es la
+// clave privada Ed25519 (64 bytes) o su semilla (32 bytes).
+func SignQR(claims model.QRClaims, algorithm model.QRSignatureAlgorithm, signingKey []byte) (string, error) {
+	payloadJSON, err := json.Marshal(signedQRPayload{
+		KeyID:          claims.KeyID,
+		QRValue:        claims.QRValue,
+		RackID:         claims.RackID,
+		InstallationID: claims.InstallationID,
+		ExpiresAt:      claims.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := SignedQRPrefix + base64.RawURLEncoding.EncodeToString(payloadJSON)
+
+	var signature []byte
+	switch algorithm {
+	case model.QRSignatureHS256:
+		if len(signingKey) < minHMACKeyLength {
+			return "", fmt.Errorf("HMAC key must have at least %d bytes", minHMACKeyLength)
+		}
+		signature = signHMAC(signingKey, []byte(signingInput))
+	case model.QRSignatureEdDSA:
+		privateKey, err := ed25519PrivateKey(signingKey)
+		if err != nil {
+			return "", err
+		}
+		signature = ed25519.Sign(privateKey, []byte(signingInput))
+	default:
+		return "", fmt.Errorf("unknown QR signature algorithm %q", algorithm)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// verifyQRSignature valida la firma con el algoritmo de la clave
+func verifyQRSignature(key model.QRKey, message []byte, signature []byte) bool {
+	switch key.Algorithm {
+	case model.QRSignatureHS256:
+		return hmac.Equal(signHMAC(key.Material, message), signature)
+	case model.QRSignatureEdDSA:
+		return ed25519.Verify(ed25519.PublicKey(key.Material), message, signature)
+	default:
+		return false
+	}
+}
+
+// validateQRKey verifica que la clave tenga identificador y un material válido para su algoritmo
+func validateQRKey(key model.QRKey) error {
+	if strings.TrimSpace(key.ID) == "" || strings.Contains(key.ID, ":") {
+		return fmt.Errorf("invalid QR key id %q", key.ID)
+	}
+
+	switch key.Algorithm {
+	case model.QRSignatureHS256:
+		if len(key.Material) < minHMACKeyLength {
+			return fmt.Errorf("QR key %q: HMAC key must have at least %d bytes", key.ID, minHMACKeyLength)
+		}
+	case model.QRSignatureEdDSA:
+		if len(key.Material) != ed25519.PublicKeySize {
+			return fmt.Errorf("QR key %q: Ed25519 public key must have %d bytes", key.ID, ed25519.PublicKeySize)
+		}
+	default:
+		return fmt.Errorf("QR key %q: unknown algorithm %q", key.ID, key.Algorithm)
+	}
+
+	return nil
+}
+
+// signHMAC calcula HMAC-SHA256
+func signHMAC(secret []byte, message []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+// ed25519PrivateKey acepta la clave privada completa o su semilla
+func ed25519PrivateKey(key []byte) (ed25519.PrivateKey, error) {
+	switch len(key) {
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(key), nil
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(key), nil
+	default:
+		return nil, fmt.Errorf("Ed25519 private key must have %d or %d bytes", ed25519.PrivateKeySize, ed25519.SeedSize)
+	}
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var qrTestNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// qrTestKeys devuelve una clave HS256 y una Ed25519 con la clave privada de esta última
+func qrTestKeys(t *testing.T) (model.QRKey, model.QRKey, ed25519.PrivateKey) {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(bytes.NewReader(bytes.Repeat([]byte{7}, ed25519.SeedSize)))
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	hmacKey := model.QRKey{ID: "k1", Algorithm: model.QRSignatureHS256, Material: bytes.Repeat([]byte{1}, minHMACKeyLength)}
+	edKey := model.QRKey{ID: "k2", Algorithm: model.QRSignatureEdDSA, Material: publicKey}
+	return hmacKey, edKey, privateKey
+}
+
+// newTestQRVerifier crea un verificador con reloj fijo
+func newTestQRVerifier(t *testing.T, mode model.QRVerificationMode, keys ...model.QRKey) *QRVerifier {
+	t.Helper()
+
+	verifier, err := NewQRVerifier(mode, keys)
+	if err != nil {
+		t.Fatalf("NewQRVerifier() error = %v", err)
+	}
+	verifier.now = func() time.Time { return qrTestNow }
+	return verifier
+}
+
+// signTestQR firma el QR123 del rack 1 con la clave y el vencimiento indicados
+func signTestQR(t *testing.T, keyID string, algorithm model.QRSignatureAlgorithm, signingKey []byte, expiresAt time.Time) string {
+	t.Helper()
+
+	qr, err := SignQR(model.QRClaims{KeyID: keyID, QRValue: "QR123", RackID: 1, InstallationID: 2, ExpiresAt: expiresAt}, algorithm, signingKey)
+	if err != nil {
+		t.Fatalf("SignQR() error = %v", err)
+	}
+	return qr
+}
+
+func TestQRVerifierRoundTrip(t *testing.T) {
+	hmacKey, edKey, edPrivateKey := qrTestKeys(t)
+	verifier := newTestQRVerifier(t, model.QRVerificationEnforced, hmacKey, edKey)
+
+	tests := []struct {
+		name       string
+		keyID      string
+		algorithm  model.QRSignatureAlgorithm
+		signingKey []byte
+	}{
+		{name: "HS256", keyID: hmacKey.ID, algorithm: model.QRSignatureHS256, signingKey: hmacKey.Material},
+		{name: "Ed25519 private key", keyID: edKey.ID, algorithm: model.QRSignatureEdDSA, signingKey: edPrivateKey},
+		{name: "Ed25519 seed", keyID: edKey.ID, algorithm: model.QRSignatureEdDSA, signingKey: edPrivateKey.Seed()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresAt := qrTestNow.Add(time.Hour)
+			qr := signTestQR(t, tt.keyID, tt.algorithm, tt.signingKey, expiresAt)
+
+			qrValue, claims, err := verifier.Verify(qr)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if qrValue != "QR123" {
+				t.Errorf("Verify() qrValue = %q, want %q", qrValue, "QR123")
+			}
+			if claims == nil || claims.KeyID != tt.keyID || claims.RackID != 1 || claims.InstallationID != 2 || !claims.ExpiresAt.Equal(expiresAt) {
+				t.Errorf("Verify() claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestQRVerifierRejects(t *testing.T) {
+	hmacKey, edKey, edPrivateKey := qrTestKeys(t)
+	verifier := newTestQRVerifier(t, model.QRVerificationEnforced, hmacKey, edKey)
+
+	valid := signTestQR(t, hmacKey.ID, model.QRSignatureHS256, hmacKey.Material, qrTestNow.Add(time.Hour))
+	payloadSegment, signatureSegment, _ := strings.Cut(strings.TrimPrefix(valid, SignedQRPrefix), ".")
+
+	// Mismo payload con otro valor QR, conservando la firma original
+	tamperedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"kid":"k1","qr":"QR999","rack":1,"inst":2,"exp":1773147600}`))
+
+	// Firma con un bit cambiado
+	signature, _ := base64.RawURLEncoding.DecodeString(signatureSegment)
+	signature[0] ^= 0x01
+	tamperedSignature := base64.RawURLEncoding.EncodeToString(signature)
+
+	// Firma Ed25519 válida presentada con el kid de la clave HMAC
+	edSigned := signTestQR(t, hmacKey.ID, model.QRSignatureEdDSA, edPrivateKey, qrTestNow.Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		qrValue string
+		want    error
+	}{
+		{name: "tampered payload", qrValue: SignedQRPrefix + tamperedPayload + "." + signatureSegment, want: exception.ErrQRSignatureInvalid},
+		{name: "tampered signature", qrValue: SignedQRPrefix + payloadSegment + "." + tamperedSignature, want: exception.ErrQRSignatureInvalid},
+		{name: "algorithm set by the key, not the QR", qrValue: edSigned, want: exception.ErrQRSignatureInvalid},
+		{name: "unknown kid", qrValue: signTestQR(t, "retired", model.QRSignatureHS256, hmacKey.Material, qrTestNow.Add(time.Hour)), want: exception.ErrQRKeyUnknown},
+		{name: "expired", qrValue: signTestQR(t, hmacKey.ID, model.QRSignatureHS256, hmacKey.Material, qrTestNow.Add(-time.Second)), want: exception.ErrQRExpired},
+		{name: "missing signature", qrValue: SignedQRPrefix + payloadSegment, want: exception.ErrQRMalformed},
+		{name: "invalid base64", qrValue: SignedQRPrefix + "!!!." + signatureSegment, want: exception.ErrQRMalformed},
+		{name: "unsigned value", qrValue: "QR123", want: exception.ErrQRUnsigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := verifier.Verify(tt.qrValue)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestQRVerifierModes(t *testing.T) {
+	hmacKey, _, _ := qrTestKeys(t)
+	expired := signTestQR(t, hmacKey.ID, model.QRSignatureHS256, hmacKey.Material, qrTestNow.Add(-time.Hour))
+
+	tests := []struct {
+		name      string
+		mode      model.QRVerificationMode
+		qrValue   string
+		wantValue string
+		wantErr   error
+	}{
+		{name: "enforced rejects unsigned", mode: model.QRVerificationEnforced, qrValue: "QR123", wantErr: exception.ErrQRUnsigned},
+		{name: "migration accepts unsigned", mode: model.QRVerificationMigration, qrValue: " QR123 ", wantValue: "QR123"},
+		{name: "migration still verifies signed", mode: model.QRVerificationMigration, qrValue: expired, wantErr: exception.ErrQRExpired},
+		{name: "disabled passes the value through", mode: model.QRVerificationDisabled, qrValue: expired, wantValue: expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := newTestQRVerifier(t, tt.mode, hmacKey)
+
+			qrValue, claims, err := verifier.Verify(tt.qrValue)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if qrValue != tt.wantValue {
+				t.Errorf("Verify() qrValue = %q, want %q", qrValue, tt.wantValue)
+			}
+			if claims != nil {
+				t.Errorf("Verify() claims = %+v, want nil", claims)
+			}
+		})
+	}
+}
+
+func TestQRVerifierKeyRotation(t *testing.T) {
+	oldKey, _, _ := qrTestKeys(t)
+	newKey := model.QRKey{ID: "k3", Algorithm: model.QRSignatureHS256, Material: bytes.Repeat([]byte{3}, minHMACKeyLength)}
+
+	oldQR := signTestQR(t, oldKey.ID, model.QRSignatureHS256, oldKey.Material, qrTestNow.Add(time.Hour))
+	newQR := signTestQR(t, newKey.ID, model.QRSignatureHS256, newKey.Material, qrTestNow.Add(time.Hour))
+
+	// Durante la rotación ambas claves están vigentes
+	rotating := newTestQRVerifier(t, model.QRVerificationEnforced, oldKey, newKey)
+	for _, qr := range []string{oldQR, newQR} {
+		if _, _, err := rotating.Verify(qr); err != nil {
+			t.Errorf("Verify() during rotation error = %v", err)
+		}
+	}
+
+	// Al retirar la clave anterior sus QR dejan de aceptarse
+	rotated := newTestQRVerifier(t, model.QRVerificationEnforced, newKey)
+	if _, _, err := rotated.Verify(newQR); err != nil {
+		t.Errorf("Verify() new key error = %v", err)
+	}
+	if _, _, err := rotated.Verify(oldQR); !errors.Is(err, exception.ErrQRKeyUnknown) {
+		t.Errorf("Verify() retired key error = %v, want %v", err, exception.ErrQRKeyUnknown)
+	}
+}
+
+func TestNewQRVerifierValidation(t *testing.T) {
+	hmacKey, edKey, _ := qrTestKeys(t)
+
+	tests := []struct {
+		name string
+		mode model.QRVerificationMode
+		keys []model.QRKey
+	}{
+		{name: "unknown mode", mode: model.QRVerificationMode("strict"), keys: []model.QRKey{hmacKey}},
+		{name: "enforced without keys", mode: model.QRVerificationEnforced},
+		{name: "duplicated kid", mode: model.QRVerificationEnforced, keys: []model.QRKey{hmacKey, hmacKey}},
+		{name: "short HMAC secret", mode: model.QRVerificationEnforced, keys: []model.QRKey{{ID: "k1", Algorithm: model.QRSignatureHS256, Material: []byte("short")}}},
+		{name: "invalid Ed25519 key", mode: model.QRVerificationEnforced, keys: []model.QRKey{{ID: "k2", Algorithm: model.QRSignatureEdDSA, Material: edKey.Material[:16]}}},
+		{name: "kid with separator", mode: model.QRVerificationEnforced, keys: []model.QRKey{{ID: "k:1", Algorithm: hmacKey.Algorithm, Material: hmacKey.Material}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewQRVerifier(tt.mode, tt.keys); err == nil {
+				t.Error("NewQRVerifier() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/model"
+	domainService "bff-graphql-payment/internal/domain/service"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// receiptLookupTimeout limita la consulta de la reserva y la orden de compra de un comprobante
+	receiptLookupTimeout = 30 * time.Second
+	// paidReceiptRetention es el tiempo durante el que se ignoran confirmaciones repetidas de una orden
+	paidReceiptRetention = 24 * time.Hour
+)
+
+// ReceiptNotificationOptions contiene los ajustes de envío de comprobantes
+type ReceiptNotificationOptions struct {
+	// Locale es el idioma de los mensajes
+	Locale model.Locale
+	// MaxAttempts es la cantidad máxima de intentos por notificación
+	MaxAttempts int
+	// InitialBackoff es la espera antes del primer reintento; se duplica en cada intento
+	InitialBackoff time.Duration
+	// SendTimeout limita cada intento de envío
+	SendTimeout time.Duration
+	// QueueSize es la cantidad de comprobantes pendientes; al llenarse se descartan los nuevos
+	QueueSize int
+	// Workers es la cantidad de comprobantes procesados simultáneamente
+	Workers int
+}
+
+// ReceiptNotificationService envía comprobantes por correo y SMS tras generarse una reserva o
+// confirmarse un pago. Antes de redactar cada comprobante consulta la reserva y su orden de compra
+// en el backend del tenant que lo originó para completar sus datos. Los envíos son asíncronos y se
+// reintentan con backoff exponencial, por lo que nunca demoran ni hacen fallar el caso de uso que
+// los origina.
+type ReceiptNotificationService struct {
+	repo       ports.PaymentInfraRepository
+	tenants    *domainService.TenantRegistry
+	subscriber ports.PaymentEventSubscriber
+	senders    map[model.NotificationChannel]ports.NotificationSender
+	renderer   *receiptRenderer
+	options    ReceiptNotificationOptions
+	metrics    ports.MetricsRecorder
+
+	// Órdenes (por tenant) cuyo comprobante de pago ya se envió; las pasarelas pueden repetir el webhook
+	mu   sync.Mutex
+	paid map[string]time.Time
+
+	queue       chan model.Receipt
+	unsubscribe func()
+	wg          sync.WaitGroup
+	stopOnce    sync.Once
+	stop        chan struct{}
+}
+
+// NewReceiptNotificationService crea un nuevo servicio de comprobantes
+func NewReceiptNotificationService(repo ports.PaymentInfraRepository, tenants *domainService.TenantRegistry, subscriber ports.PaymentEventSubscriber, senders []ports.NotificationSender, options ReceiptNotificationOptions, metrics ports.MetricsRecorder) (*ReceiptNotificationService, error) {
+	renderer, err := newReceiptRenderer()
+	if err != nil {
+		return nil, err
+	}
+
+	if options.MaxAttempts <= 0 || options.Workers <= 0 || options.QueueSize <= 0 {
+		return nil, fmt.Errorf("invalid receipt notification options: maxAttempts=%d, workers=%d, queueSize=%d", options.MaxAttempts, options.Workers, options.QueueSize)
+	}
+
+	if _, ok := receiptTemplates[options.Locale]; !ok {
+		options.Locale = model.DefaultLocale
+	}
+
+	bySender := make(map[model.NotificationChannel]ports.NotificationSender, len(senders))
+	for _, sender := range senders {
+		bySender[sender.Channel()] = sender
+	}
+
+	return &ReceiptNotificationService{
+		repo:       repo,
+		tenants:    tenants,
+		subscriber: subscriber,
+		senders:    bySender,
+		renderer:   renderer,
+		options:    options,
+		metrics:    metrics,
+		paid:       make(map[string]time.Time),
+		queue:      make(chan model.Receipt, options.QueueSize),
+		stop:       make(chan struct{}),
+	}, nil
+}
+
+// Start inicia los envíos y comienza a escuchar los pagos confirmados. La suscripción es
+// bloqueante para que ninguna confirmación de pago se pierda por un suscriptor lento.
+func (s *ReceiptNotificationService) Start() {
+	for i := 0; i < s.options.Workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+
+	if s.subscriber == nil {
+		return
+	}
+
+	events, unsubscribe := s.subscriber.SubscribeBlocking("")
+	s.unsubscribe = unsubscribe
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for event := range events {
+			if event.OrderStatus == model.PurchaseOrderStatusPaid {
+				s.handlePaymentConfirmed(event)
+			}
+		}
+	}()
+}
+
+// Stop deja de escuchar pagos, envía las notificaciones pendientes y espera a que terminen.
+// Los reintentos en espera se abandonan.
+func (s *ReceiptNotificationService) Stop() {
+	s.stopOnce.Do(func() {
+		if s.unsubscribe != nil {
+			s.unsubscribe()
+		}
+		close(s.stop)
+	})
+	s.wg.Wait()
+}
+
+// NotifyReceipt encola el comprobante con el tenant de la solicitud. La reserva y la orden de
+// compra se consultan al procesarlo, por lo que no demora al caso de uso que lo origina.
+func (s *ReceiptNotificationService) NotifyReceipt(ctx context.Context, receipt model.Receipt) {
+	if receipt.TenantID == "" {
+		receipt.TenantID = tenancy.ID(ctx)
+	}
+	s.enqueue(ctx, receipt)
+}
+
+// enqueue encola el comprobante; devuelve false si se descartó por estar la cola llena
+func (s *ReceiptNotificationService) enqueue(ctx context.Context, receipt model.Receipt) bool {
+	if receipt.Locale == "" {
+		receipt.Locale = s.options.Locale
+	}
+
+	select {
+	case s.queue <- receipt:
+		slog.DebugContext(ctx, "📨 Receipt queued", "event", receipt.Event)
+		return true
+	default:
+		slog.WarnContext(ctx, "⚠️ Notification queue full, dropping receipt", "event", receipt.Event)
+		s.recordMetric("notifications_dropped_total")
+		return false
+	}
+}
+
+// handlePaymentConfirmed encola el comprobante de la orden pagada, salvo que ya se haya enviado
+func (s *ReceiptNotificationService) handlePaymentConfirmed(event model.PaymentResultEvent) {
+	ctx, traceID := tracing.Ensure(context.Background(), &event.TraceID)
+
+	if !s.markPaid(event.TenantID, event.PurchaseOrder) {
+		slog.DebugContext(ctx, "📨 Payment receipt already sent", "purchaseOrder", event.PurchaseOrder, "tenant", event.TenantID)
+		return
+	}
+
+	queued := s.enqueue(ctx, model.Receipt{
+		Event:         model.ReceiptEventPaymentConfirmed,
+		PurchaseOrder: event.PurchaseOrder,
+		Amount:        event.Amount,
+		TraceID:       traceID,
+		TenantID:      event.TenantID,
+	})
+	if !queued {
+		s.unmarkPaid(event.TenantID, event.PurchaseOrder)
+	}
+}
+
+// process completa el comprobante y lo envía por cada canal con destinatario
+func (s *ReceiptNotificationService) process(receipt model.Receipt) {
+	ctx, _ := tracing.Ensure(context.Background(), &receipt.TraceID)
+
+	// Las consultas van al backend del tenant que originó el comprobante, nunca al por defecto
+	if receipt.TenantID != "" {
+		tenant, ok := s.tenants.ByID(receipt.TenantID)
+		if !ok {
+			slog.WarnContext(ctx, "⚠️ Receipt skipped, tenant no longer registered", "event", receipt.Event, "purchaseOrder", receipt.PurchaseOrder, "tenant", receipt.TenantID)
+			s.recordMetric("notifications_failed_total")
+			s.unmarkPaid(receipt.TenantID, receipt.PurchaseOrder)
+			return
+		}
+		ctx = tenancy.WithTenant(ctx, tenant)
+	}
+
+	if err := s.complete(ctx, &receipt); err != nil {
+		slog.WarnContext(ctx, "⚠️ Payment receipt skipped, purchase order not available", "purchaseOrder", receipt.PurchaseOrder, "error", err)
+		s.recordMetric("notifications_failed_total")
+
+		// Permitir que una confirmación repetida vuelva a intentarlo
+		s.unmarkPaid(receipt.TenantID, receipt.PurchaseOrder)
+		return
+	}
+
+	recipients := map[model.NotificationChannel]string{
+		model.NotificationChannelEmail: strings.TrimSpace(receipt.Email),
+		model.NotificationChannelSMS:   strings.TrimSpace(receipt.Phone),
+	}
+
+	for channel, recipient := range recipients {
+		if recipient == "" || s.senders[channel] == nil {
+			continue
+		}
+
+		notification, err := s.renderer.render(receipt, channel)
+		if err != nil {
+			slog.ErrorContext(ctx, "❌ Failed to render receipt", "channel", channel, "event", receipt.Event, "error", err)
+			s.recordMetric("notifications_failed_total")
+			continue
+		}
+
+		s.deliver(ctx, notification)
+	}
+}
+
+// complete consulta la reserva y su orden de compra para completar el código, el locker, la
+// instalación, la vigencia y el monto. Si la reserva no está disponible se envía lo ya conocido;
+// el comprobante de pago requiere la orden, ya que de ella salen sus destinatarios.
+func (s *ReceiptNotificationService) complete(ctx context.Context, receipt *model.Receipt) error {
+	ctx, cancel := context.WithTimeout(ctx, receiptLookupTimeout)
+	defer cancel()
+
+	bookingStatus, err := s.repo.GetBooking(ctx, receipt.BookingCode, receipt.PurchaseOrder, receipt.TraceID)
+	if err == nil && (bookingStatus == nil || bookingStatus.Status == model.ResponseStatusError || bookingStatus.Booking == nil) {
+		err = errors.New("booking not found")
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "⚠️ Receipt booking not available", "event", receipt.Event, "purchaseOrder", receipt.PurchaseOrder, "error", err)
+	} else {
+		booking := bookingStatus.Booking
+		if receipt.BookingCode == "" {
+			receipt.BookingCode = booking.CurrentCode
+		}
+		if receipt.PurchaseOrder == "" {
+			receipt.PurchaseOrder = booking.PurchaseOrder
+		}
+		receipt.LockerNumber = booking.NumberLocker
+		receipt.InstallationName = booking.InstallationName
+		receipt.InitBooking = booking.InitBooking
+		receipt.FinishBooking = booking.FinishBooking
+	}
+
+	if receipt.PurchaseOrder == "" {
+		return nil
+	}
+
+	order, err := s.repo.GetPurchaseOrderByPo(ctx, receipt.PurchaseOrder, receipt.TraceID)
+	if err == nil && (order == nil || order.Status == model.ResponseStatusError) {
+		err = errors.New("purchase order not found")
+	}
+	if err != nil {
+		if receipt.Event == model.ReceiptEventPaymentConfirmed {
+			return err
+		}
+		slog.WarnContext(ctx, "⚠️ Receipt purchase order not available", "event", receipt.Event, "purchaseOrder", receipt.PurchaseOrder, "error", err)
+		return nil
+	}
+
+	if receipt.Email == "" {
+		receipt.Email = order.Email
+	}
+	if receipt.Phone == "" {
+		receipt.Phone = order.Phone
+	}
+	if receipt.LockerNumber == 0 {
+		receipt.LockerNumber = order.LockerPosition
+	}
+	if receipt.InstallationName == "" {
+		receipt.InstallationName = order.InstallationName
+	}
+	if receipt.Amount == 0 {
+		receipt.Amount = order.FinalProductPrice
+	}
+	receipt.ProductName = order.ProductName
+	return nil
+}
+
+// paidKey identifica la orden dentro de su tenant, ya que dos tenants pueden repetir una orden de compra
+func paidKey(tenantID string, purchaseOrder string) string {
+	if tenantID == "" {
+		return purchaseOrder
+	}
+	return tenantID + "|" + purchaseOrder
+}
+
+// markPaid registra la orden del tenant como notificada; devuelve false si ya lo estaba
+func (s *ReceiptNotificationService) markPaid(tenantID string, purchaseOrder string) bool {
+	key := paidKey(tenantID, purchaseOrder)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for order, notifiedAt := range s.paid {
+		if now.Sub(notifiedAt) > paidReceiptRetention {
+			delete(s.paid, order)
+		}
+	}
+
+	if _, ok := s.paid[key]; ok {
+		return false
+	}
+	s.paid[key] = now
+	return true
+}
+
+// unmarkPaid olvida la orden para que una confirmación repetida vuelva a enviar su comprobante
+func (s *ReceiptNotificationService) unmarkPaid(tenantID string, purchaseOrder string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.paid, paidKey(tenantID, purchaseOrder))
+}
+
+// work procesa los comprobantes encolados hasta que se detiene el servicio
+func (s *ReceiptNotificationService) work() {
+	defer s.wg.Done()
+	for {
+		select {
+		case receipt := <-s.queue:
+			s.process(receipt)
+		case <-s.stop:
+			// Vaciar la cola antes de terminar
+			for {
+				select {
+				case receipt := <-s.queue:
+					s.process(receipt)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// deliver envía una notificación reintentando con backoff exponencial
+func (s *ReceiptNotificationService) deliver(ctx context.Context, notification model.Notification) {
+	sender := s.senders[notification.Channel]
+	backoff := s.options.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := s.send(ctx, sender, notification)
+		if err == nil {
+			slog.InfoContext(ctx, "📨 Receipt sent", "channel", notification.Channel, "event", notification.Event, "attempt", attempt)
+			s.recordMetric("notifications_sent_total")
+			return
+		}
+
+		if attempt >= s.options.MaxAttempts {
+			s.fail(ctx, notification, err)
+			return
+		}
+
+		slog.WarnContext(ctx, "⚠️ Receipt delivery failed, retrying", "backoff", backoff, "channel", notification.Channel, "attempt", attempt, "error", err)
+		s.recordMetric("notifications_retried_total")
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-s.stop:
+			s.fail(ctx, notification, fmt.Errorf("service stopped before retrying: %w", err))
+			return
+		}
+	}
+}
+
+// fail registra una notificación que no se pudo entregar
+func (s *ReceiptNotificationService) fail(ctx context.Context, notification model.Notification, err error) {
+	slog.ErrorContext(ctx, "❌ Receipt delivery failed", "channel", notification.Channel, "event", notification.Event, "error", err)
+	s.recordMetric("notifications_failed_total")
+}
+
+// send realiza un intento de envío con su propio límite de tiempo
+func (s *ReceiptNotificationService) send(ctx context.Context, sender ports.NotificationSender, notification model.Notification) error {
+	if s.options.SendTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.options.SendTimeout)
+		defer cancel()
+	}
+	return sender.Send(ctx, notification)
+}
+
+// recordMetric incrementa un contador si hay métricas configuradas
+func (s *ReceiptNotificationService) recordMetric(name string) {
+	if s.metrics != nil {
+		s.metrics.IncCounter(name)
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// receiptTemplate contiene las plantillas de un comprobante para un idioma y evento
+type receiptTemplate struct {
+	subject string
+	email   string
+	sms     string
+}
+
+// receiptTemplates son las plantillas de los comprobantes por idioma y evento
+var receiptTemplates = map[model.Locale]map[model.ReceiptEvent]receiptTemplate{
+	model.LocaleSpanish: {
+		model.ReceiptEventBookingGenerated: {
+			subject: "Tu reserva de locker {{.BookingCode}}",
+			email: `Hola,
+
+Tu reserva fue generada exitosamente.
+
+Código de apertura: {{.BookingCode}}
+{{- template "details-es" .}}
+
+Presenta este código en el kiosco para abrir tu locker.
+`,
+			sms: `Tu codigo de apertura es {{.BookingCode}}{{if .LockerNumber}}, locker {{.LockerNumber}}{{end}}{{if .InstallationName}} en {{.InstallationName}}{{end}}.`,
+		},
+		model.ReceiptEventPaymentConfirmed: {
+			subject: "Comprobante de pago {{.PurchaseOrder}}",
+			email: `Hola,
+
+Recibimos tu pago. Este es tu comprobante.
+
+Orden de compra: {{.PurchaseOrder}}
+{{- if .BookingCode}}
+Código de apertura: {{.BookingCode}}{{end}}
+{{- template "details-es" .}}
+`,
+			sms: `Pago recibido{{if .Amount}} por {{money .Amount}}{{end}}. Orden {{.PurchaseOrder}}{{if .LockerNumber}}, locker {{.LockerNumber}}{{end}}.`,
+		},
+	},
+	model.LocaleEnglish: {
+		model.ReceiptEventBookingGenerated: {
+			subject: "Your locker booking {{.BookingCode}}",
+			email: `Hello,
+
+Your booking was created successfully.
+
+Opening code: {{.BookingCode}}
+{{- template "details-en" .}}
+
+Enter this code at the kiosk to open your locker.
+`,
+			sms: `Your opening code is {{.BookingCode}}{{if .LockerNumber}}, locker {{.LockerNumber}}{{end}}{{if .InstallationName}} at {{.InstallationName}}{{end}}.`,
+		},
+		model.ReceiptEventPaymentConfirmed: {
+			subject: "Payment receipt {{.PurchaseOrder}}",
+			email: `Hello,
+
+We received your payment. This is your receipt.
+
+Purchase order: {{.PurchaseOrder}}
+{{- if .BookingCode}}
+Opening code: {{.BookingCode}}{{end}}
+{{- template "details-en" .}}
+`,
+			sms: `Payment received{{if .Amount}} for {{money .Amount}}{{end}}. Order {{.PurchaseOrder}}{{if .LockerNumber}}, locker {{.LockerNumber}}{{end}}.`,
+		},
+	},
+}
+
+// receiptDetails son los bloques comunes con los datos opcionales del comprobante
+const receiptDetails = `
+{{- define "details-es"}}
+{{- if .ProductName}}
+Producto: {{.ProductName}}{{end}}
+{{- if .LockerNumber}}
+Locker: {{.LockerNumber}}{{end}}
+{{- if .InstallationName}}
+Instalación: {{.InstallationName}}{{end}}
+{{- if .HasTimeWindow}}
+Vigencia: {{datetime .InitBooking}} - {{datetime .FinishBooking}}{{end}}
+{{- if .Amount}}
+Monto: {{money .Amount}}{{end}}
+{{- end}}
+{{- define "details-en"}}
+{{- if .ProductName}}
+Product: {{.ProductName}}{{end}}
+{{- if .LockerNumber}}
+Locker: {{.LockerNumber}}{{end}}
+{{- if .InstallationName}}
+Location: {{.InstallationName}}{{end}}
+{{- if .HasTimeWindow}}
+Valid: {{datetime .InitBooking}} - {{datetime .FinishBooking}}{{end}}
+{{- if .Amount}}
+Amount: {{money .Amount}}{{end}}
+{{- end}}`
+
+// receiptRenderer redacta los comprobantes a partir de las plantillas
+type receiptRenderer struct {
+	location  *time.Location
+	templates map[model.Locale]map[model.ReceiptEvent]*template.Template
+}
+
+// newReceiptRenderer compila las plantillas; las fechas se muestran en la zona horaria de operación
+func newReceiptRenderer() (*receiptRenderer, error) {
+	location, err := time.LoadLocation(model.DefaultTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone: %w", err)
+	}
+
+	renderer := &receiptRenderer{
+		location:  location,
+		templates: make(map[model.Locale]map[model.ReceiptEvent]*template.Template, len(receiptTemplates)),
+	}
+
+	for locale, events := range receiptTemplates {
+		funcs := template.FuncMap{
+			"money":    func(amount int64) string { return formatAmount(locale, amount) },
+			"datetime": func(t time.Time) string { return t.In(location).Format("02-01-2006 15:04") },
+		}
+
+		renderer.templates[locale] = make(map[model.ReceiptEvent]*template.Template, len(events))
+		for event, texts := range events {
+			name := string(locale) + "/" + string(event)
+			tmpl := template.New(name).Funcs(funcs).Option("missingkey=error")
+			for part, text := range map[string]string{"details": receiptDetails, "subject": texts.subject, "email": texts.email, "sms": texts.sms} {
+				if _, err := tmpl.New(part).Parse(text); err != nil {
+					return nil, fmt.Errorf("failed to parse receipt template %s/%s: %w", name, part, err)
+				}
+			}
+			renderer.templates[locale][event] = tmpl
+		}
+	}
+
+	return renderer, nil
+}
+
+// render redacta el comprobante para el canal indicado. Un idioma no soportado usa el idioma por defecto.
+func (r *receiptRenderer) render(receipt model.Receipt, channel model.NotificationChannel) (model.Notification, error) {
+	templates, ok := r.templates[receipt.Locale]
+	if !ok {
+		templates = r.templates[model.DefaultLocale]
+	}
+
+	tmpl, ok := templates[receipt.Event]
+	if !ok {
+		return model.Notification{}, fmt.Errorf("no receipt template for event %q", receipt.Event)
+	}
+
+	notification := model.Notification{
+		Channel: channel,
+		Event:   receipt.Event,
+		TraceID: receipt.TraceID,
+	}
+
+	var err error
+	switch channel {
+	case model.NotificationChannelEmail:
+		notification.Recipient = receipt.Email
+		if notification.Subject, err = execute(tmpl, "subject", receipt); err == nil {
+			notification.Body, err = execute(tmpl, "email", receipt)
+		}
+	case model.NotificationChannelSMS:
+		notification.Recipient = receipt.Phone
+		notification.Body, err = execute(tmpl, "sms", receipt)
+	default:
+		err = fmt.Errorf("unsupported notification channel %q", channel)
+	}
+
+	return notification, err
+}
+
+// execute ejecuta una de las plantillas asociadas
+func execute(tmpl *template.Template, name string, receipt model.Receipt) (string, error) {
+	var out bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&out, name, receipt); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// formatAmount da formato a un monto en pesos chilenos: "$5.000" en español y "CLP 5,000" en inglés
+func formatAmount(locale model.Locale, amount int64) string {
+	digits := strconv.FormatInt(amount, 10)
+	separator, prefix := ".", "$"
+	if locale == model.LocaleEnglish {
+		separator, prefix = ",", "CLP "
+	}
+
+	var out strings.Builder
+	for i, digit := range digits {
+		if i > 0 && digit != '-' && (len(digits)-i)%3 == 0 && digits[i-1] != '-' {
+			out.WriteString(separator)
+		}
+		out.WriteRune(digit)
+	}
+	return prefix + out.String()
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/domain/ports"
+	domainService "bff-graphql-payment/internal/domain/service"
+	"context"
+	"log/slog"
+)
+
+// tenantScope asocia cada caso de uso a su tenant y lo guarda en el contexto, para que el
+// repositorio elija los backends del tenant y las cachés no mezclen datos de distintos tenants
+type tenantScope struct {
+	tenants *domainService.TenantRegistry
+}
+
+// scope obtiene el tenant del caso de uso a partir del tenant de la solicitud y del serviceName
+func (s tenantScope) scope(ctx context.Context, serviceName string) (context.Context, *model.Tenant, error) {
+	current := tenancy.FromContext(ctx)
+
+	tenant, err := s.tenants.Scope(current, serviceName)
+	if err != nil {
+		slog.WarnContext(ctx, "⚠️ Tenant mismatch", "tenant", current.ID, "serviceName", serviceName)
+		return ctx, nil, err
+	}
+
+	if tenant != nil && tenant != current {
+		ctx = tenancy.WithTenant(ctx, tenant)
+	}
+	return ctx, tenant, nil
+}
+
+// checkGateway verifica que la pasarela esté habilitada para el tenant
+func (s tenantScope) checkGateway(ctx context.Context, tenant *model.Tenant, gatewayName string) error {
+	if tenant == nil || tenant.GatewayEnabled(gatewayName) {
+		return nil
+	}
+
+	slog.WarnContext(ctx, "🚫 Payment gateway not enabled for tenant", "tenant", tenant.ID, "gatewayName", gatewayName)
+	return exception.ErrPaymentGatewayDisabled
+}
+
+// TenantScopedPaymentInfraService ejecuta cada caso de uso en el tenant de la solicitud: rechaza
+// serviceName e instalaciones de otros tenants, limita las pasarelas a las del tenant y agrega
+// su marca a la infraestructura de pagos
+type TenantScopedPaymentInfraService struct {
+	ports.PaymentInfraService
+	tenantScope
+}
+
+// NewTenantScopedPaymentInfraService envuelve el servicio de infraestructura de pagos
+func NewTenantScopedPaymentInfraService(inner ports.PaymentInfraService, tenants *domainService.TenantRegistry) *TenantScopedPaymentInfraService {
+	return &TenantScopedPaymentInfraService{
+		PaymentInfraService: inner,
+		tenantScope:         tenantScope{tenants: tenants},
+	}
+}
+
+// GetPaymentInfraByQrValue implementa ports.PaymentInfraService. La instalación solo se conoce
+// después de leer el QR, así que decide la marca y la pertenencia, pero no el backend consultado.
+func (s *TenantScopedPaymentInfraService) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	current := tenancy.FromContext(ctx)
+
+	ctx, tenant, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	paymentInfra, err := s.PaymentInfraService.GetPaymentInfraByQrValue(ctx, qrValue)
+	if err != nil || paymentInfra == nil {
+		return paymentInfra, err
+	}
+
+	if paymentInfra.Installation != nil {
+		if owner, ok := s.tenants.ByInstallation(paymentInfra.Installation.ID); ok {
+			if current != nil && owner.ID != current.ID {
+				slog.WarnContext(ctx, "⚠️ Tenant mismatch", "tenant", current.ID, "installationId", paymentInfra.Installation.ID)
+				return nil, exception.ErrTenantMismatch
+			}
+			tenant = owner
+		}
+	}
+
+	if tenant == nil {
+		return paymentInfra, nil
+	}
+
+	branded := *paymentInfra
+	branding := tenant.Branding
+	branded.Branding = &branding
+	return &branded, nil
+}
+
+// GetAvailableLockers implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.GetAvailableLockers(ctx, paymentRackID, bookingTimeID, traceID)
+}
+
+// ValidateDiscountCoupon implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.ValidateDiscountCoupon(ctx, couponCode, rackID, traceID)
+}
+
+// QuotePaymentGroup implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) QuotePaymentGroup(ctx context.Context, rackID int, group model.AvailablePaymentGroup, couponCode *string, traceID string) (*model.PaymentQuote, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.QuotePaymentGroup(ctx, rackID, group, couponCode, traceID)
+}
+
+// GeneratePurchaseOrder implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error) {
+	ctx, tenant, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGateway(ctx, tenant, gatewayName); err != nil {
+		return nil, err
+	}
+
+	return s.PaymentInfraService.GeneratePurchaseOrder(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID, gatewayName)
+}
+
+// GenerateBooking implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.GenerateBooking(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID)
+}
+
+// GetPurchaseOrderByPo implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+}
+
+// CheckBookingStatus implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error) {
+	ctx, _, err := s.scope(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.CheckBookingStatus(ctx, serviceName, currentCode)
+}
+
+// ExecuteOpen implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error) {
+	ctx, _, err := s.scope(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.ExecuteOpen(ctx, serviceName, currentCode)
+}
+
+// AvailablePaymentGateways implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) AvailablePaymentGateways(ctx context.Context, rackID int) ([]model.PaymentGateway, error) {
+	ctx, tenant, err := s.scope(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	gateways, err := s.PaymentInfraService.AvailablePaymentGateways(ctx, rackID)
+	if err != nil || tenant == nil {
+		return gateways, err
+	}
+
+	enabled := make([]model.PaymentGateway, 0, len(gateways))
+	for _, gateway := range gateways {
+		if tenant.GatewayEnabled(gateway.Name()) {
+			enabled = append(enabled, gateway)
+		}
+	}
+	return enabled, nil
+}
+
+// CancelBooking implementa ports.PaymentInfraService
+func (s *TenantScopedPaymentInfraService) CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error) {
+	ctx, _, err := s.scope(ctx, request.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return s.PaymentInfraService.CancelBooking(ctx, request)
+}
+
+// InvalidatePaymentInfraCache implementa ports.PaymentInfraService; con qrValue solo elimina la
+// entrada del tenant de la solicitud
+func (s *TenantScopedPaymentInfraService) InvalidatePaymentInfraCache(ctx context.Context, qrValue *string) (int, error) {
+	ctx, _, err := s.scope(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	return s.PaymentInfraService.InvalidatePaymentInfraCache(ctx, qrValue)
+}
+
+// TenantScopedBookingExtensionService ejecuta las extensiones de reserva en el tenant de la
+// solicitud y limita las pasarelas a las del tenant
+type TenantScopedBookingExtensionService struct {
+	ports.BookingExtensionService
+	tenantScope
+}
+
+// NewTenantScopedBookingExtensionService envuelve el servicio de extensión de reservas
+func NewTenantScopedBookingExtensionService(inner ports.BookingExtensionService, tenants *domainService.TenantRegistry) *TenantScopedBookingExtensionService {
+	return &TenantScopedBookingExtensionService{
+		BookingExtensionService: inner,
+		tenantScope:             tenantScope{tenants: tenants},
+	}
+}
+
+// ExtendBooking implementa ports.BookingExtensionService
+func (s *TenantScopedBookingExtensionService) ExtendBooking(ctx context.Context, request model.BookingExtensionRequest) (*model.BookingExtension, error) {
+	ctx, tenant, err := s.scope(ctx, request.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGateway(ctx, tenant, request.GatewayName); err != nil {
+		return nil, err
+	}
+
+	return s.BookingExtensionService.ExtendBooking(ctx, request)
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	domainService "bff-graphql-payment/internal/domain/service"
+	"context"
+)
+
+// TenantService implementa la consulta del tenant de la solicitud, con la que el frontend obtiene
+// su marca, sus pasarelas y sus feature flags
+type TenantService struct {
+	tenantScope
+}
+
+// NewTenantService crea el servicio de consulta de tenants
+func NewTenantService(tenants *domainService.TenantRegistry) *TenantService {
+	return &TenantService{tenantScope: tenantScope{tenants: tenants}}
+}
+
+// CurrentTenant devuelve el tenant de la solicitud, el dueño del serviceName o el tenant por
+// defecto, en ese orden; nil si la solicitud no tiene tenant
+func (s *TenantService) CurrentTenant(ctx context.Context, serviceName *string) (*model.Tenant, error) {
+	name := ""
+	if serviceName != nil {
+		name = *serviceName
+	}
+
+	_, tenant, err := s.scope(ctx, name)
+	return tenant, err
+}
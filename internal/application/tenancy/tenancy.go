@@ -0,0 +1,45 @@
+package tenancy
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// Header es el header HTTP (o, en minúsculas, la metadata gRPC) con el que el cliente indica su tenant
+const Header = "X-Tenant-Id"
+
+// RequestResolver obtiene el tenant indicado explícitamente por una solicitud: por su ID o,
+// sin él, por el hostname
+type RequestResolver interface {
+	ResolveRequest(tenantID string, host string) (*model.Tenant, error)
+}
+
+type tenantKey struct{}
+
+// WithTenant devuelve un contexto que lleva el tenant de la solicitud
+func WithTenant(ctx context.Context, tenant *model.Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext devuelve el tenant del contexto, o nil si la solicitud no tiene tenant
+func FromContext(ctx context.Context) *model.Tenant {
+	tenant, _ := ctx.Value(tenantKey{}).(*model.Tenant)
+	return tenant
+}
+
+// ID devuelve el ID del tenant del contexto, o "" si la solicitud no tiene tenant
+func ID(ctx context.Context) string {
+	if tenant := FromContext(ctx); tenant != nil {
+		return tenant.ID
+	}
+	return ""
+}
+
+// Key antepone el ID del tenant del contexto a una clave de caché o de deduplicación, para que
+// los datos de un tenant nunca se compartan con otro
+func Key(ctx context.Context, key string) string {
+	if id := ID(ctx); id != "" {
+		return id + "|" + key
+	}
+	return key
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import "strings"
+
+// maxTraceIDLength limita el largo de un traceId recibido del cliente
+const maxTraceIDLength = 128
+
+// Resolve elige el traceId de una solicitud a partir del valor explícito (header X-Trace-Id o
+// metadata x-trace-id) y del traceparent (W3C) recibidos, o genera uno nuevo. Lo comparten los
+// servidores HTTP y gRPC.
+func Resolve(explicit string, traceparent string) string {
+	if traceID := strings.TrimSpace(explicit); Valid(traceID) {
+		return traceID
+	}
+
+	if traceID, ok := parseTraceparent(traceparent); ok {
+		return traceID
+	}
+
+	return NewTraceID()
+}
+
+// Valid acepta traceIds no vacíos de caracteres visibles y largo acotado
+func Valid(traceID string) bool {
+	if traceID == "" || len(traceID) > maxTraceIDLength {
+		return false
+	}
+
+	for _, c := range traceID {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTraceparent extrae el trace-id de un header traceparent: version-traceid-parentid-flags
+func parseTraceparent(header string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 {
+		return "", false
+	}
+
+	traceID := strings.ToLower(parts[1])
+	if strings.Trim(traceID, "0") == "" || strings.Trim(traceID, "0123456789abcdef") != "" {
+		return "", false
+	}
+
+	return traceID, true
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type traceIDKey struct{}
+
+type reporterKey struct{}
+
+// WithTraceID devuelve un contexto que lleva el traceId de la solicitud
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceID devuelve el traceId del contexto, o "" si no tiene
+func TraceID(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	return traceID
+}
+
+// WithReporter devuelve un contexto que avisa a report cada vez que la solicitud fija un traceId
+// explícito, por ejemplo para devolverlo en la respuesta HTTP
+func WithReporter(ctx context.Context, report func(traceID string)) context.Context {
+	return context.WithValue(ctx, reporterKey{}, report)
+}
+
+// NewTraceID genera un traceId nuevo
+func NewTraceID() string {
+	return uuid.NewString()
+}
+
+// Ensure devuelve el contexto con traceId. Un traceId explícito válido tiene prioridad sobre el
+// del contexto; uno inválido se ignora igual que en Resolve, y si no hay ninguno se genera uno nuevo.
+func Ensure(ctx context.Context, explicit *string) (context.Context, string) {
+	if explicit != nil {
+		if traceID := strings.TrimSpace(*explicit); Valid(traceID) {
+			if report, ok := ctx.Value(reporterKey{}).(func(string)); ok {
+				report(traceID)
+			}
+			return WithTraceID(ctx, traceID), traceID
+		}
+	}
+
+	if traceID := TraceID(ctx); traceID != "" {
+		return ctx, traceID
+	}
+
+	traceID := NewTraceID()
+	return WithTraceID(ctx, traceID), traceID
+}
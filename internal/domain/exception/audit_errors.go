@@ -0,0 +1,15 @@
+package exception
+
+var (
+	// ErrInvalidAuditRange se devuelve cuando el rango de fechas de la consulta de auditoría no es válido
+	ErrInvalidAuditRange = NewDomainError("INVALID_AUDIT_RANGE", "invalid audit time range")
+
+	// ErrInvalidPagination se devuelve cuando limit u offset están fuera de los límites
+	ErrInvalidPagination = NewDomainError("INVALID_PAGINATION", "invalid pagination")
+
+	// ErrAdminRequired se devuelve cuando una operación administrativa no presenta un token de administrador válido
+	ErrAdminRequired = NewDomainError("ADMIN_REQUIRED", "admin token required")
+
+	// ErrAuditDisabled se devuelve cuando se consulta la auditoría sin tener un registro configurado
+	ErrAuditDisabled = NewDomainError("AUDIT_DISABLED", "locker open audit is disabled")
+)
@@ -0,0 +1,18 @@
+package exception
+
+var (
+	// ErrBookingExpired se devuelve cuando la reserva ya terminó su vigencia
+	ErrBookingExpired = NewDomainError("BOOKING_EXPIRED", "booking has expired")
+
+	// ErrBookingNotStarted se devuelve cuando la reserva aún no comienza
+	ErrBookingNotStarted = NewDomainError("BOOKING_NOT_STARTED", "booking has not started yet")
+
+	// ErrOpeningsExhausted se devuelve cuando la reserva no tiene aperturas restantes
+	ErrOpeningsExhausted = NewDomainError("OPENINGS_EXHAUSTED", "booking has no openings left")
+
+	// ErrBookingNotCancellable se devuelve cuando el estado de la reserva no permite cancelarla
+	ErrBookingNotCancellable = NewDomainError("BOOKING_NOT_CANCELLABLE", "booking cannot be cancelled in its current state")
+
+	// ErrBookingNotExtendable se devuelve cuando el estado de la reserva no permite extenderla
+	ErrBookingNotExtendable = NewDomainError("BOOKING_NOT_EXTENDABLE", "booking cannot be extended in its current state")
+)
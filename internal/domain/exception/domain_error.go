@@ -0,0 +1,20 @@
+package exception
+
+// DomainError es un error de dominio con un código estable legible por máquinas
+type DomainError struct {
+	Code    string
+	Message string
+}
+
+// NewDomainError crea un nuevo error de dominio con código
+func NewDomainError(code string, message string) *DomainError {
+	return &DomainError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// Error implementa la interfaz error
+func (e *DomainError) Error() string {
+	return e.Message
+}
@@ -1,68 +1,93 @@
 package exception
 
-import "errors"
-
 var (
 	// ErrPaymentRackNotFound se devuelve cuando no se encuentra un rack de pagos
-	ErrPaymentRackNotFound = errors.New("payment rack not found")
+	ErrPaymentRackNotFound = NewDomainError("PAYMENT_RACK_NOT_FOUND", "payment rack not found")
 
 	// ErrInvalidPaymentRackID se devuelve cuando el ID del rack de pagos es inválido
-	ErrInvalidPaymentRackID = errors.New("invalid payment rack ID")
+	ErrInvalidPaymentRackID = NewDomainError("INVALID_PAYMENT_RACK_ID", "invalid payment rack ID")
 
 	// ErrPaymentInfraServiceUnavailable se devuelve cuando el servicio de infraestructura de pagos no está disponible
-	ErrPaymentInfraServiceUnavailable = errors.New("payment infrastructure service unavailable")
+	ErrPaymentInfraServiceUnavailable = NewDomainError("PAYMENT_INFRA_SERVICE_UNAVAILABLE", "payment infrastructure service unavailable")
 
 	// ErrInvalidBookingTimeID se devuelve cuando el ID del tiempo de reserva es inválido
-	ErrInvalidBookingTimeID = errors.New("invalid booking time ID")
+	ErrInvalidBookingTimeID = NewDomainError("INVALID_BOOKING_TIME_ID", "invalid booking time ID")
 
 	// ErrNoLockersAvailable se devuelve cuando no hay lockers disponibles
-	ErrNoLockersAvailable = errors.New("no lockers available")
+	ErrNoLockersAvailable = NewDomainError("NO_LOCKERS_AVAILABLE", "no lockers available")
 
 	// ErrInvalidCouponCode se devuelve cuando el código de cupón es inválido
-	ErrInvalidCouponCode = errors.New("invalid coupon code")
+	ErrInvalidCouponCode = NewDomainError("INVALID_COUPON_CODE", "invalid coupon code")
 
 	// ErrCouponNotFound se devuelve cuando no se encuentra el cupón
-	ErrCouponNotFound = errors.New("coupon not found")
+	ErrCouponNotFound = NewDomainError("COUPON_NOT_FOUND", "coupon not found")
 
 	// ErrInvalidCoupon se devuelve cuando el cupón es inválido
-	ErrInvalidCoupon = errors.New("invalid coupon")
+	ErrInvalidCoupon = NewDomainError("INVALID_COUPON", "invalid coupon")
 
 	// ErrInvalidGroupID se devuelve cuando el ID del grupo es inválido
-	ErrInvalidGroupID = errors.New("invalid group ID")
+	ErrInvalidGroupID = NewDomainError("INVALID_GROUP_ID", "invalid group ID")
 
 	// ErrInvalidEmail se devuelve cuando el email es inválido
-	ErrInvalidEmail = errors.New("invalid email")
+	ErrInvalidEmail = NewDomainError("INVALID_EMAIL", "invalid email")
 
 	// ErrInvalidPhone se devuelve cuando el teléfono es inválido
-	ErrInvalidPhone = errors.New("invalid phone")
+	ErrInvalidPhone = NewDomainError("INVALID_PHONE", "invalid phone")
 
 	// ErrPurchaseOrderFailed se devuelve cuando falla la generación de la orden de compra
-	ErrPurchaseOrderFailed = errors.New("purchase order generation failed")
+	ErrPurchaseOrderFailed = NewDomainError("PURCHASE_ORDER_FAILED", "purchase order generation failed")
 
 	// ErrInvalidTraceID se devuelve cuando el trace ID es inválido
-	ErrInvalidTraceID = errors.New("invalid trace ID")
+	ErrInvalidTraceID = NewDomainError("INVALID_TRACE_ID", "invalid trace ID")
 
 	// ErrInvalidGatewayName se devuelve cuando el nombre del gateway es inválido
-	ErrInvalidGatewayName = errors.New("invalid gateway name")
+	ErrInvalidGatewayName = NewDomainError("INVALID_GATEWAY_NAME", "invalid gateway name")
 
 	// ErrInvalidPurchaseOrder se devuelve cuando el número de orden de compra es inválido
-	ErrInvalidPurchaseOrder = errors.New("invalid purchase order")
+	ErrInvalidPurchaseOrder = NewDomainError("INVALID_PURCHASE_ORDER", "invalid purchase order")
 
 	// ErrBookingGenerationFailed se devuelve cuando falla la generación de la reserva
-	ErrBookingGenerationFailed = errors.New("booking generation failed")
+	ErrBookingGenerationFailed = NewDomainError("BOOKING_GENERATION_FAILED", "booking generation failed")
 
 	// ErrPurchaseOrderNotFound se devuelve cuando no se encuentra la orden de compra
-	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrPurchaseOrderNotFound = NewDomainError("PURCHASE_ORDER_NOT_FOUND", "purchase order not found")
 
 	// ErrInvalidServiceName se devuelve cuando el nombre del servicio es inválido
-	ErrInvalidServiceName = errors.New("invalid service name")
+	ErrInvalidServiceName = NewDomainError("INVALID_SERVICE_NAME", "invalid service name")
 
 	// ErrInvalidCurrentCode se devuelve cuando el código actual es inválido
-	ErrInvalidCurrentCode = errors.New("invalid current code")
+	ErrInvalidCurrentCode = NewDomainError("INVALID_CURRENT_CODE", "invalid current code")
 
 	// ErrBookingNotFound se devuelve cuando no se encuentra la reserva
-	ErrBookingNotFound = errors.New("booking not found")
+	ErrBookingNotFound = NewDomainError("BOOKING_NOT_FOUND", "booking not found")
 
 	// ErrExecuteOpenFailed se devuelve cuando falla la ejecución de apertura
-	ErrExecuteOpenFailed = errors.New("execute open failed")
+	ErrExecuteOpenFailed = NewDomainError("EXECUTE_OPEN_FAILED", "execute open failed")
+
+	// ErrInvalidPaymentResult se devuelve cuando el resultado de pago notificado es inválido
+	ErrInvalidPaymentResult = NewDomainError("INVALID_PAYMENT_RESULT", "invalid payment result")
+
+	// ErrPaymentResultMismatch se devuelve cuando la pasarela o el monto notificados no coinciden con la orden
+	ErrPaymentResultMismatch = NewDomainError("PAYMENT_RESULT_MISMATCH", "payment result does not match the purchase order")
+
+	// ErrInvalidPaymentTransition se devuelve cuando la orden no puede pasar al estado notificado
+	ErrInvalidPaymentTransition = NewDomainError("INVALID_PAYMENT_TRANSITION", "purchase order cannot change to the notified status")
+
+	// ErrInvalidTimezone se devuelve cuando la zona horaria solicitada no existe
+	ErrInvalidTimezone = NewDomainError("INVALID_TIMEZONE", "invalid timezone")
+
+	// ErrInvalidBookingReference se devuelve cuando no se indica el código de reserva ni la orden de compra
+	ErrInvalidBookingReference = NewDomainError("INVALID_BOOKING_REFERENCE", "booking code or purchase order is required")
+
+	// ErrInvalidCancellationReason se devuelve cuando el motivo de cancelación es inválido
+	ErrInvalidCancellationReason = NewDomainError("INVALID_CANCELLATION_REASON", "invalid cancellation reason")
+
+	// ErrRefundFailed se devuelve cuando falla la solicitud de reembolso
+	ErrRefundFailed = NewDomainError("REFUND_FAILED", "refund request failed")
+
+	// ErrInvalidQRImageFormat se devuelve cuando el formato de imagen QR no es soportado
+	ErrInvalidQRImageFormat = NewDomainError("INVALID_QR_IMAGE_FORMAT", "invalid QR image format")
+
+	// ErrInvalidQRImageSize se devuelve cuando el tamaño de imagen QR está fuera de los límites
+	ErrInvalidQRImageSize = NewDomainError("INVALID_QR_IMAGE_SIZE", "invalid QR image size")
 )
@@ -0,0 +1,12 @@
+package exception
+
+var (
+	// ErrUnknownPaymentGateway se devuelve cuando el gatewayName no corresponde a ninguna pasarela registrada
+	ErrUnknownPaymentGateway = NewDomainError("PAYMENT_GATEWAY_UNKNOWN", "unknown payment gateway")
+
+	// ErrPaymentGatewayDisabled se devuelve cuando la pasarela no está habilitada para la instalación
+	ErrPaymentGatewayDisabled = NewDomainError("PAYMENT_GATEWAY_DISABLED", "payment gateway disabled for this installation")
+
+	// ErrPaymentGatewayAmountNotAccepted se devuelve cuando el monto está fuera de los límites de la pasarela
+	ErrPaymentGatewayAmountNotAccepted = NewDomainError("PAYMENT_GATEWAY_AMOUNT_NOT_ACCEPTED", "amount not accepted by payment gateway")
+)
@@ -0,0 +1,18 @@
+package exception
+
+var (
+	// ErrQRMalformed se devuelve cuando el QR firmado no tiene el formato esperado
+	ErrQRMalformed = NewDomainError("QR_MALFORMED", "malformed signed QR")
+
+	// ErrQRSignatureInvalid se devuelve cuando la firma del QR no es válida o sus datos no corresponden al rack
+	ErrQRSignatureInvalid = NewDomainError("QR_SIGNATURE_INVALID", "invalid QR signature")
+
+	// ErrQRKeyUnknown se devuelve cuando el QR está firmado con una clave no configurada o retirada
+	ErrQRKeyUnknown = NewDomainError("QR_KEY_UNKNOWN", "QR signed with an unknown key")
+
+	// ErrQRExpired se devuelve cuando el QR firmado ya venció
+	ErrQRExpired = NewDomainError("QR_EXPIRED", "QR has expired")
+
+	// ErrQRUnsigned se devuelve cuando se recibe un QR sin firma y solo se aceptan QR firmados
+	ErrQRUnsigned = NewDomainError("QR_UNSIGNED", "unsigned QR values are not accepted")
+)
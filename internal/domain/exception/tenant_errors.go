@@ -0,0 +1,9 @@
+package exception
+
+var (
+	// ErrUnknownTenant se devuelve cuando la solicitud indica un tenant que no está registrado
+	ErrUnknownTenant = NewDomainError("UNKNOWN_TENANT", "unknown tenant")
+
+	// ErrTenantMismatch se devuelve cuando el serviceName o la instalación pertenecen a otro tenant
+	ErrTenantMismatch = NewDomainError("TENANT_MISMATCH", "resource belongs to another tenant")
+)
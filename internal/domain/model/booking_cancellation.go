@@ -0,0 +1,65 @@
+package model
+
+// BookingCancellationRequest representa la solicitud de cancelación de una reserva.
+// La reserva se identifica por su código (ServiceName + CurrentCode) o por su orden de compra.
+type BookingCancellationRequest struct {
+	ServiceName   string
+	CurrentCode   string
+	PurchaseOrder string
+	Reason        string
+	TraceID       string
+}
+
+// HasBookingCode indica si la solicitud identifica la reserva por su código
+func (r BookingCancellationRequest) HasBookingCode() bool {
+	return r.ServiceName != "" && r.CurrentCode != ""
+}
+
+// CancellationStatus enumeración de estados de cancelación de una reserva
+type CancellationStatus string
+
+const (
+	CancellationStatusCancelled CancellationStatus = "CANCELLED"
+	CancellationStatusFailed    CancellationStatus = "FAILED"
+)
+
+// BookingCancellation representa el resultado de cancelar una reserva
+type BookingCancellation struct {
+	TransactionID string
+	Message       string
+	Detail        string
+	Status        ResponseStatus
+	BookingID     int
+	// PurchaseOrder es la orden de compra asociada a la reserva; vacía si no tiene
+	PurchaseOrder      string
+	CancellationStatus CancellationStatus
+	Refund             *Refund
+}
+
+// RefundRequest representa la solicitud de reembolso de una orden de compra
+type RefundRequest struct {
+	PurchaseOrder string
+	Amount        int64
+	Reason        string
+	TraceID       string
+}
+
+// RefundStatus enumeración de estados de un reembolso
+type RefundStatus string
+
+const (
+	RefundStatusNotRequired RefundStatus = "NOT_REQUIRED"
+	RefundStatusPending     RefundStatus = "PENDING"
+	RefundStatusApproved    RefundStatus = "APPROVED"
+	RefundStatusRejected    RefundStatus = "REJECTED"
+	RefundStatusFailed      RefundStatus = "FAILED"
+)
+
+// Refund representa el resultado de solicitar un reembolso
+type Refund struct {
+	RefundID string
+	Status   RefundStatus
+	Amount   int64
+	Message  string
+	Detail   string
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Límites del tamaño en píxeles de las imágenes QR
+const (
+	MinQRImageSize     = 64
+	MaxQRImageSize     = 1024
+	DefaultQRImageSize = 256
+)
+
+// QRImageFormat formato de imagen de un código QR
+type QRImageFormat string
+
+const (
+	QRImageFormatPNG QRImageFormat = "PNG"
+	QRImageFormatSVG QRImageFormat = "SVG"
+)
+
+// MimeType devuelve el tipo MIME del formato
+func (f QRImageFormat) MimeType() string {
+	if f == QRImageFormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+// BookingCodeQRRequest representa la solicitud de QR del código de apertura de una reserva
+type BookingCodeQRRequest struct {
+	Code   string
+	Format QRImageFormat
+	Size   int
+	// ServiceName y ExpiresAt se incluyen opcionalmente en el contenido del QR
+	ServiceName string
+	ExpiresAt   *time.Time
+}
+
+// Payload devuelve el contenido del QR: el código tal cual o, si se incluyen datos adicionales,
+// "code=<código>&exp=<unix>&svc=<serviceName>" para que el lector del locker los valide
+func (r BookingCodeQRRequest) Payload() string {
+	if r.ServiceName == "" && r.ExpiresAt == nil {
+		return r.Code
+	}
+
+	values := url.Values{}
+	values.Set("code", r.Code)
+	if r.ServiceName != "" {
+		values.Set("svc", r.ServiceName)
+	}
+	if r.ExpiresAt != nil {
+		values.Set("exp", strconv.FormatInt(r.ExpiresAt.Unix(), 10))
+	}
+	return values.Encode()
+}
+
+// QRImage representa un código QR renderizado
+type QRImage struct {
+	Format  QRImageFormat
+	Size    int
+	Payload string
+	Content []byte
+}
@@ -0,0 +1,94 @@
+package model
+
+import "time"
+
+// ExtendFrom devuelve la fecha resultante de sumar este tiempo de reserva a from
+func (bt PaymentBookingTime) ExtendFrom(from time.Time) time.Time {
+	switch bt.UnitMeasurement {
+	case UnitMeasurementHour:
+		return from.Add(time.Duration(bt.Amount) * time.Hour)
+	case UnitMeasurementDay:
+		return from.AddDate(0, 0, bt.Amount)
+	case UnitMeasurementWeek:
+		return from.AddDate(0, 0, 7*bt.Amount)
+	case UnitMeasurementMonth:
+		return from.AddDate(0, bt.Amount, 0)
+	default:
+		return from
+	}
+}
+
+// BookingExtensionRequest representa la solicitud de extender una reserva comprando tiempo adicional
+type BookingExtensionRequest struct {
+	ServiceName   string
+	CurrentCode   string
+	BookingTimeID int
+	GatewayName   string
+	TraceID       string
+}
+
+// BookingExtensionOption representa un tiempo de reserva del rack con su precio de extensión
+type BookingExtensionOption struct {
+	BookingTime PaymentBookingTime
+	Price       int64
+}
+
+// BookingExtensionOptions representa las opciones de extensión disponibles para una reserva
+type BookingExtensionOptions struct {
+	BookingID int
+	RackID    int
+	Options   []BookingExtensionOption
+}
+
+// Option devuelve la opción de extensión correspondiente al tiempo de reserva
+func (o *BookingExtensionOptions) Option(bookingTimeID int) (BookingExtensionOption, bool) {
+	for _, option := range o.Options {
+		if option.BookingTime.ID == bookingTimeID {
+			return option, true
+		}
+	}
+	return BookingExtensionOption{}, false
+}
+
+// BookingExtensionOrderRequest representa la solicitud de orden de compra ligada a una reserva existente
+type BookingExtensionOrderRequest struct {
+	BookingID     int
+	ServiceName   string
+	CurrentCode   string
+	BookingTimeID int
+	Amount        int64
+	GatewayName   string
+	TraceID       string
+}
+
+// BookingExtensionOrder representa la orden de compra generada para extender una reserva
+type BookingExtensionOrder struct {
+	TransactionID string
+	Message       string
+	Detail        string
+	Status        ResponseStatus
+	TraceID       string
+	PurchaseOrder string
+	URL           string
+}
+
+// BookingExtension representa una extensión de reserva pendiente de pago
+type BookingExtension struct {
+	Order                *BookingExtensionOrder
+	BookingTime          PaymentBookingTime
+	Price                int64
+	CurrentFinishBooking time.Time
+	// NewFinishBooking es la fecha de término estimada si el pago se confirma ahora
+	NewFinishBooking time.Time
+}
+
+// PendingBookingExtension representa una extensión a aplicar cuando se confirme el pago de su orden
+type PendingBookingExtension struct {
+	PurchaseOrder string
+	ServiceName   string
+	CurrentCode   string
+	BookingTime   PaymentBookingTime
+	TraceID       string
+	// CreatedAt es cuándo se generó la orden; las extensiones sin resolver vencen tras un plazo
+	CreatedAt time.Time
+}
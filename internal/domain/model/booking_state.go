@@ -0,0 +1,91 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// BookingState enumeración del ciclo de vida de una reserva
+type BookingState string
+
+const (
+	BookingStatePendingPayment BookingState = "PENDING_PAYMENT"
+	BookingStateActive         BookingState = "ACTIVE"
+	BookingStateExpired        BookingState = "EXPIRED"
+	BookingStateCancelled      BookingState = "CANCELLED"
+	BookingStateCompleted      BookingState = "COMPLETED"
+)
+
+// bookingStateTransitions define las transiciones válidas entre estados de reserva
+var bookingStateTransitions = map[BookingState][]BookingState{
+	BookingStatePendingPayment: {BookingStateActive, BookingStateCancelled, BookingStateExpired},
+	BookingStateActive:         {BookingStateCompleted, BookingStateExpired, BookingStateCancelled},
+	BookingStateExpired:        {},
+	BookingStateCancelled:      {},
+	BookingStateCompleted:      {},
+}
+
+// CanTransitionTo indica si la reserva puede pasar del estado actual al indicado
+func (s BookingState) CanTransitionTo(next BookingState) bool {
+	return slices.Contains(bookingStateTransitions[s], next)
+}
+
+// IsTerminal indica si la reserva ya no cambiará de estado
+func (s BookingState) IsTerminal() bool {
+	transitions, ok := bookingStateTransitions[s]
+	return ok && len(transitions) == 0
+}
+
+// State deriva el estado de la reserva a partir del pago, las aperturas restantes y su vigencia
+func (b *BookingStatusData) State(now time.Time) BookingState {
+	if state, ok := BookingStateForPayment(b.PaymentStatus); ok && state != BookingStateActive {
+		return state
+	}
+
+	if b.Openings <= 0 {
+		return BookingStateCompleted
+	}
+
+	if !b.FinishBooking.IsZero() && !now.Before(b.FinishBooking) {
+		return BookingStateExpired
+	}
+
+	return BookingStateActive
+}
+
+// BookingStateForPayment deriva el estado de la reserva solo a partir del estado de su orden de compra.
+// Una orden pagada se considera activa; el resto de las reglas requiere los datos de la reserva.
+func BookingStateForPayment(status PurchaseOrderStatus) (BookingState, bool) {
+	switch status {
+	case PurchaseOrderStatusPending:
+		return BookingStatePendingPayment, true
+	case PurchaseOrderStatusRejected, PurchaseOrderStatusExpired, PurchaseOrderStatusRefunded:
+		return BookingStateCancelled, true
+	case PurchaseOrderStatusPaid:
+		return BookingStateActive, true
+	default:
+		return "", false
+	}
+}
+
+// RemainingTime devuelve el tiempo que falta para el fin de la reserva (cero si ya terminó)
+func (b *BookingStatusData) RemainingTime(now time.Time) time.Duration {
+	if b.State(now).IsTerminal() {
+		return 0
+	}
+
+	if b.FinishBooking.IsZero() || !now.Before(b.FinishBooking) {
+		return 0
+	}
+	return b.FinishBooking.Sub(now)
+}
+
+// HasStarted indica si la ventana de la reserva ya comenzó
+func (b *BookingStatusData) HasStarted(now time.Time) bool {
+	return b.InitBooking.IsZero() || !now.Before(b.InitBooking)
+}
+
+// CanOpen indica si el locker puede abrirse ahora: reserva activa, ya iniciada y con aperturas restantes
+func (b *BookingStatusData) CanOpen(now time.Time) bool {
+	return b.State(now) == BookingStateActive && b.HasStarted(now)
+}
@@ -0,0 +1,297 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBookingStateCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from BookingState
+		to   BookingState
+		want bool
+	}{
+		{name: "payment confirmed activates", from: BookingStatePendingPayment, to: BookingStateActive, want: true},
+		{name: "payment rejected cancels", from: BookingStatePendingPayment, to: BookingStateCancelled, want: true},
+		{name: "unpaid booking expires", from: BookingStatePendingPayment, to: BookingStateExpired, want: true},
+		{name: "unpaid booking cannot complete", from: BookingStatePendingPayment, to: BookingStateCompleted, want: false},
+		{name: "last opening completes", from: BookingStateActive, to: BookingStateCompleted, want: true},
+		{name: "active booking expires", from: BookingStateActive, to: BookingStateExpired, want: true},
+		{name: "active booking is cancelled", from: BookingStateActive, to: BookingStateCancelled, want: true},
+		{name: "active booking cannot go back to pending", from: BookingStateActive, to: BookingStatePendingPayment, want: false},
+		{name: "expired booking cannot be reactivated", from: BookingStateExpired, to: BookingStateActive, want: false},
+		{name: "expired booking cannot be cancelled", from: BookingStateExpired, to: BookingStateCancelled, want: false},
+		{name: "cancelled booking cannot be reactivated", from: BookingStateCancelled, to: BookingStateActive, want: false},
+		{name: "cancelled booking cannot be paid again", from: BookingStateCancelled, to: BookingStatePendingPayment, want: false},
+		{name: "completed booking cannot be reopened", from: BookingStateCompleted, to: BookingStateActive, want: false},
+		{name: "completed booking cannot be cancelled", from: BookingStateCompleted, to: BookingStateCancelled, want: false},
+		{name: "same state is not a transition", from: BookingStateActive, to: BookingStateActive, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo(%q) from %q = %v, want %v", tt.to, tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookingStateTerminalStatesHaveNoTransitions(t *testing.T) {
+	states := []BookingState{
+		BookingStatePendingPayment,
+		BookingStateActive,
+		BookingStateExpired,
+		BookingStateCancelled,
+		BookingStateCompleted,
+	}
+
+	for _, from := range []BookingState{BookingStateExpired, BookingStateCancelled, BookingStateCompleted} {
+		for _, to := range states {
+			if from.CanTransitionTo(to) {
+				t.Errorf("CanTransitionTo(%q) from terminal %q = true, want false", to, from)
+			}
+		}
+	}
+}
+
+func TestBookingStateCanTransitionToUnknown(t *testing.T) {
+	tests := []struct {
+		name string
+		from BookingState
+		to   BookingState
+	}{
+		{name: "from unknown state", from: BookingState("UNKNOWN"), to: BookingStateActive},
+		{name: "from empty state", from: "", to: BookingStateActive},
+		{name: "to unknown state", from: BookingStateActive, to: BookingState("UNKNOWN")},
+		{name: "to empty state", from: BookingStatePendingPayment, to: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.from.CanTransitionTo(tt.to) {
+				t.Errorf("CanTransitionTo(%q) from %q = true, want false", tt.to, tt.from)
+			}
+		})
+	}
+}
+
+func TestBookingStateIsTerminal(t *testing.T) {
+	tests := []struct {
+		state BookingState
+		want  bool
+	}{
+		{state: BookingStatePendingPayment, want: false},
+		{state: BookingStateActive, want: false},
+		{state: BookingStateExpired, want: true},
+		{state: BookingStateCancelled, want: true},
+		{state: BookingStateCompleted, want: true},
+		{state: BookingState("UNKNOWN"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			if got := tt.state.IsTerminal(); got != tt.want {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookingStateForPayment(t *testing.T) {
+	tests := []struct {
+		status PurchaseOrderStatus
+		want   BookingState
+		wantOK bool
+	}{
+		{status: PurchaseOrderStatusPending, want: BookingStatePendingPayment, wantOK: true},
+		{status: PurchaseOrderStatusPaid, want: BookingStateActive, wantOK: true},
+		{status: PurchaseOrderStatusRejected, want: BookingStateCancelled, wantOK: true},
+		{status: PurchaseOrderStatusExpired, want: BookingStateCancelled, wantOK: true},
+		{status: PurchaseOrderStatusRefunded, want: BookingStateCancelled, wantOK: true},
+		{status: "", want: "", wantOK: false},
+		{status: PurchaseOrderStatus("CHARGEBACK"), want: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			got, ok := BookingStateForPayment(tt.status)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("BookingStateForPayment() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBookingStatusDataState(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		booking BookingStatusData
+		want    BookingState
+	}{
+		{
+			name:    "pending payment",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPending, Openings: 2, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStatePendingPayment,
+		},
+		{
+			name:    "rejected payment cancels the booking",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusRejected, Openings: 2, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateCancelled,
+		},
+		{
+			name:    "refunded payment cancels the booking",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusRefunded, Openings: 2, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateCancelled,
+		},
+		{
+			name:    "paid and within the window",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 2, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateActive,
+		},
+		{
+			name:    "unknown payment status uses the booking data",
+			booking: BookingStatusData{Openings: 1, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateActive,
+		},
+		{
+			name:    "no openings left",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 0, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateCompleted,
+		},
+		{
+			name:    "finished exactly now",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 2, FinishBooking: now},
+			want:    BookingStateExpired,
+		},
+		{
+			name:    "finished in the past",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 2, FinishBooking: now.Add(-time.Minute)},
+			want:    BookingStateExpired,
+		},
+		{
+			name:    "no finish date",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 2},
+			want:    BookingStateActive,
+		},
+		{
+			name:    "expired purchase order cancels the booking",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusExpired, Openings: 2, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateCancelled,
+		},
+		{
+			name:    "pending payment wins over an elapsed window",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPending, Openings: 2, FinishBooking: now.Add(-time.Hour)},
+			want:    BookingStatePendingPayment,
+		},
+		{
+			name:    "rejected payment wins over no openings left",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusRejected, Openings: 0, FinishBooking: now.Add(-time.Hour)},
+			want:    BookingStateCancelled,
+		},
+		{
+			name:    "no openings left wins over an elapsed window",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 0, FinishBooking: now.Add(-time.Hour)},
+			want:    BookingStateCompleted,
+		},
+		{
+			name:    "negative openings count as none left",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: -1, FinishBooking: now.Add(time.Hour)},
+			want:    BookingStateCompleted,
+		},
+		{
+			name:    "window not started yet is still active",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPaid, Openings: 2, InitBooking: now.Add(time.Hour), FinishBooking: now.Add(2 * time.Hour)},
+			want:    BookingStateActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.booking.State(now); got != tt.want {
+				t.Errorf("State() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookingStatusDataCanOpen(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		booking BookingStatusData
+		want    bool
+	}{
+		{
+			name:    "active and started",
+			booking: BookingStatusData{Openings: 1, InitBooking: now.Add(-time.Hour), FinishBooking: now.Add(time.Hour)},
+			want:    true,
+		},
+		{
+			name:    "not started yet",
+			booking: BookingStatusData{Openings: 1, InitBooking: now.Add(time.Minute), FinishBooking: now.Add(time.Hour)},
+			want:    false,
+		},
+		{
+			name:    "expired",
+			booking: BookingStatusData{Openings: 1, InitBooking: now.Add(-2 * time.Hour), FinishBooking: now.Add(-time.Hour)},
+			want:    false,
+		},
+		{
+			name:    "pending payment",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusPending, Openings: 1, FinishBooking: now.Add(time.Hour)},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.booking.CanOpen(now); got != tt.want {
+				t.Errorf("CanOpen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookingStatusDataRemainingTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		booking BookingStatusData
+		want    time.Duration
+	}{
+		{
+			name:    "active",
+			booking: BookingStatusData{Openings: 1, FinishBooking: now.Add(90 * time.Minute)},
+			want:    90 * time.Minute,
+		},
+		{
+			name:    "terminal state",
+			booking: BookingStatusData{PaymentStatus: PurchaseOrderStatusRejected, Openings: 1, FinishBooking: now.Add(time.Hour)},
+			want:    0,
+		},
+		{
+			name:    "expired",
+			booking: BookingStatusData{Openings: 1, FinishBooking: now.Add(-time.Hour)},
+			want:    0,
+		},
+		{
+			name:    "no finish date",
+			booking: BookingStatusData{Openings: 1},
+			want:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.booking.RemainingTime(now); got != tt.want {
+				t.Errorf("RemainingTime() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
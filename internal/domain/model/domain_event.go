@@ -0,0 +1,67 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DomainEventType tipo de evento de dominio emitido por el BFF
+type DomainEventType string
+
+const (
+	DomainEventPurchaseOrderCreated DomainEventType = "PurchaseOrderCreated"
+	DomainEventBookingCreated       DomainEventType = "BookingCreated"
+	DomainEventLockerOpened         DomainEventType = "LockerOpened"
+	DomainEventLockerOpenFailed     DomainEventType = "LockerOpenFailed"
+)
+
+// DomainEvent representa un hecho ocurrido en un caso de uso. Data solo contiene valores
+// serializables a JSON y nunca datos personales ni códigos de apertura completos.
+type DomainEvent struct {
+	ID         string
+	Type       DomainEventType
+	OccurredAt time.Time
+	TraceID    string
+	Data       map[string]any
+}
+
+// OutboxEntry es un evento de dominio pendiente de entrega a los sinks
+type OutboxEntry struct {
+	Event         DomainEvent
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// DeliveredSinks son los sinks que ya recibieron el evento; no se les vuelve a entregar
+	DeliveredSinks []string
+}
+
+// DeliveredTo indica si el sink ya recibió el evento
+func (e OutboxEntry) DeliveredTo(sink string) bool {
+	for _, delivered := range e.DeliveredSinks {
+		if delivered == sink {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskCode oculta un código de apertura (o un teléfono) dejando visibles sus últimos 3 caracteres
+func MaskCode(code string) string {
+	code = strings.TrimSpace(code)
+	if len(code) <= 3 {
+		return strings.Repeat("*", len(code))
+	}
+	return strings.Repeat("*", len(code)-3) + code[len(code)-3:]
+}
+
+// MaskEmail oculta un correo dejando visibles la primera letra y el dominio
+func MaskEmail(email string) string {
+	email = strings.TrimSpace(email)
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return strings.Repeat("*", len(email))
+	}
+	first, size := utf8.DecodeRuneInString(local)
+	return string(first) + strings.Repeat("*", utf8.RuneCountInString(local[size:])) + "@" + domain
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Nombres de los feature flags que el BFF evalúa
+const (
+	// FeatureFlagOpenGuard activa las reglas previas a la apertura; si está definido reemplaza
+	// al "enabled" de las reglas del serviceName
+	FeatureFlagOpenGuard = "openGuard"
+	// FeatureFlagGatewayPrefix antecede al gatewayName; si el flag está definido, la pasarela
+	// solo se ofrece a los sujetos para los que está activo
+	FeatureFlagGatewayPrefix = "gateway."
+	// FeatureFlagMockPrefix antecede al nombre de una operación del backend; si está activo la
+	// operación responde con mocks aunque USE_MOCK esté desactivado
+	FeatureFlagMockPrefix = "mock."
+)
+
+// GatewayFeatureFlag devuelve el nombre del feature flag que libera una pasarela
+func GatewayFeatureFlag(gatewayName string) string {
+	return FeatureFlagGatewayPrefix + strings.ToLower(strings.TrimSpace(gatewayName))
+}
+
+// MockFeatureFlag devuelve el nombre del feature flag que simula una operación del backend
+func MockFeatureFlag(operation string) string {
+	return FeatureFlagMockPrefix + operation
+}
+
+// FeatureFlag es un flag de liberación gradual. Un flag desactivado está apagado para todos; uno
+// activado aplica sus segmentos (tenants, instalaciones y racks) y luego su porcentaje.
+type FeatureFlag struct {
+	Name        string
+	Description string
+	Enabled     bool
+	// Percentage es el porcentaje de sujetos (0 a 100) para los que el flag está activo
+	Percentage int
+	// Segmentos; una lista vacía no restringe. Si hay varias, el sujeto debe cumplirlas todas.
+	Tenants         []string
+	InstallationIDs []int
+	RackIDs         []int
+}
+
+// FeatureFlagSubject es el sujeto para el que se evalúa un flag
+type FeatureFlagSubject struct {
+	TenantID       string
+	InstallationID int
+	RackID         int
+	// Key es la clave estable del reparto por porcentaje (p. ej. el ID del dispositivo); vacía
+	// usa el rack, la instalación o el tenant, en ese orden
+	Key string
+}
+
+// FeatureFlagValue es el resultado de evaluar un flag para un sujeto
+type FeatureFlagValue struct {
+	Name    string
+	Enabled bool
+}
+
+// Evaluate indica si el flag está activo para el sujeto. El reparto por porcentaje es
+// determinista: un mismo sujeto obtiene siempre el mismo resultado para un mismo flag.
+func (f FeatureFlag) Evaluate(subject FeatureFlagSubject) bool {
+	if !f.Enabled {
+		return false
+	}
+
+	if len(f.Tenants) > 0 && !containsTenant(f.Tenants, subject.TenantID) {
+		return false
+	}
+
+	if len(f.InstallationIDs) > 0 && !slices.Contains(f.InstallationIDs, subject.InstallationID) {
+		return false
+	}
+
+	if len(f.RackIDs) > 0 && !slices.Contains(f.RackIDs, subject.RackID) {
+		return false
+	}
+
+	if f.Percentage >= 100 {
+		return true
+	}
+
+	key := subject.bucketKey()
+	if key == "" || f.Percentage <= 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(f.Name + ":" + key))
+	return int(hash.Sum32()%100) < f.Percentage
+}
+
+// bucketKey devuelve la clave del sujeto para el reparto por porcentaje
+func (s FeatureFlagSubject) bucketKey() string {
+	switch {
+	case s.Key != "":
+		return s.Key
+	case s.RackID > 0:
+		return "rack:" + strconv.Itoa(s.RackID)
+	case s.InstallationID > 0:
+		return "installation:" + strconv.Itoa(s.InstallationID)
+	case s.TenantID != "":
+		return "tenant:" + s.TenantID
+	default:
+		return ""
+	}
+}
+
+// containsTenant indica si el tenant está en la lista, sin distinguir mayúsculas
+func containsTenant(tenants []string, tenantID string) bool {
+	if tenantID == "" {
+		return false
+	}
+
+	for _, tenant := range tenants {
+		if strings.EqualFold(strings.TrimSpace(tenant), tenantID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// LockerOpenOutcome resultado final de un intento de apertura de locker
+type LockerOpenOutcome string
+
+const (
+	// LockerOpenOutcomeOpened el backend confirmó la apertura
+	LockerOpenOutcomeOpened LockerOpenOutcome = "OPENED"
+	// LockerOpenOutcomeFailed el backend falló o informó un error de apertura
+	LockerOpenOutcomeFailed LockerOpenOutcome = "FAILED"
+	// LockerOpenOutcomeRejected el BFF rechazó la apertura antes de llamar al backend
+	LockerOpenOutcomeRejected LockerOpenOutcome = "REJECTED"
+)
+
+// Límites de la paginación del registro de aperturas
+const (
+	DefaultLockerOpenAuditLimit = 50
+	MaxLockerOpenAuditLimit     = 500
+)
+
+// LockerOpenAuditRecord es el registro inmutable de un intento de apertura de locker
+type LockerOpenAuditRecord struct {
+	ID          string
+	Timestamp   time.Time
+	Principal   string
+	ClientIP    string
+	UserAgent   string
+	ServiceName string
+	MaskedCode  string
+	// OpenStatuses son los estados de apertura recibidos del backend, en orden
+	OpenStatuses          []OpenStatus
+	Outcome               LockerOpenOutcome
+	Reason                string
+	UpstreamTransactionID string
+	TraceID               string
+}
+
+// LockerOpenAuditFilter selecciona registros de apertura de un serviceName en [From, To)
+type LockerOpenAuditFilter struct {
+	ServiceName string
+	From        time.Time
+	To          time.Time
+	// Outcome vacío incluye todos los resultados
+	Outcome LockerOpenOutcome
+	Limit   int
+	Offset  int
+}
+
+// Matches indica si el registro cumple el filtro
+func (f LockerOpenAuditFilter) Matches(record LockerOpenAuditRecord) bool {
+	return record.ServiceName == f.ServiceName &&
+		!record.Timestamp.Before(f.From) && record.Timestamp.Before(f.To) &&
+		(f.Outcome == "" || record.Outcome == f.Outcome)
+}
+
+// LockerOpenAuditPage es una página de registros de apertura, del más reciente al más antiguo
+type LockerOpenAuditPage struct {
+	Records     []LockerOpenAuditRecord
+	TotalCount  int
+	Limit       int
+	Offset      int
+	HasNextPage bool
+}
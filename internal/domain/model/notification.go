@@ -0,0 +1,82 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// NotificationChannel canal por el que se envía una notificación al usuario
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelSMS   NotificationChannel = "SMS"
+)
+
+// ReceiptEvent hecho que origina un comprobante para el usuario
+type ReceiptEvent string
+
+const (
+	ReceiptEventBookingGenerated ReceiptEvent = "BOOKING_GENERATED"
+	ReceiptEventPaymentConfirmed ReceiptEvent = "PAYMENT_CONFIRMED"
+)
+
+// Locale idioma de los mensajes enviados al usuario
+type Locale string
+
+const (
+	LocaleSpanish Locale = "es"
+	LocaleEnglish Locale = "en"
+)
+
+// DefaultLocale es el idioma de los mensajes cuando no se indica otro
+const DefaultLocale = LocaleSpanish
+
+// ParseLocale interpreta un idioma ("es", "en-US", "EN"); devuelve false si no está soportado
+func ParseLocale(value string) (Locale, bool) {
+	language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(value)), "-")
+	switch Locale(language) {
+	case LocaleSpanish, LocaleEnglish:
+		return Locale(language), true
+	default:
+		return "", false
+	}
+}
+
+// Receipt contiene los datos del comprobante enviado tras una reserva o un pago confirmado.
+// Antes de redactarlo se completa con la reserva y su orden de compra; los campos que sigan
+// vacíos se omiten del mensaje.
+type Receipt struct {
+	Event            ReceiptEvent
+	Locale           Locale
+	Email            string
+	Phone            string
+	BookingCode      string
+	PurchaseOrder    string
+	ProductName      string
+	LockerNumber     int
+	InstallationName string
+	InitBooking      time.Time
+	FinishBooking    time.Time
+	// Amount es el monto pagado en pesos chilenos
+	Amount  int64
+	TraceID string
+	// TenantID es el tenant que originó el comprobante, para consultar su backend; "" sin tenant
+	TenantID string
+}
+
+// HasTimeWindow indica si el comprobante incluye la vigencia de la reserva
+func (r Receipt) HasTimeWindow() bool {
+	return !r.InitBooking.IsZero() && !r.FinishBooking.IsZero()
+}
+
+// Notification es un mensaje ya redactado para un destinatario y canal
+type Notification struct {
+	Channel   NotificationChannel
+	Event     ReceiptEvent
+	Recipient string
+	// Subject solo aplica al correo electrónico
+	Subject string
+	Body    string
+	TraceID string
+}
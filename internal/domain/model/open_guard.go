@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// OpenGuardRules define las validaciones previas a la apertura de un locker
+type OpenGuardRules struct {
+	// Enabled activa la consulta del estado de la reserva antes de abrir
+	Enabled bool
+	// EnforceWindow rechaza aperturas fuera de la ventana initBooking–finishBooking
+	EnforceWindow bool
+	// EnforceOpenings rechaza aperturas cuando la reserva no tiene aperturas restantes
+	EnforceOpenings bool
+	// EarlyOpenTolerance permite abrir este tiempo antes de initBooking
+	EarlyOpenTolerance time.Duration
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// PaymentResult enumeración de resultados de pago informados por una pasarela
+type PaymentResult string
+
+const (
+	PaymentResultApproved PaymentResult = "APPROVED"
+	PaymentResultRejected PaymentResult = "REJECTED"
+	PaymentResultPending  PaymentResult = "PENDING"
+	PaymentResultExpired  PaymentResult = "EXPIRED"
+)
+
+// OrderStatus devuelve el estado de orden de compra que corresponde al resultado de pago
+func (r PaymentResult) OrderStatus() (PurchaseOrderStatus, bool) {
+	switch r {
+	case PaymentResultApproved:
+		return PurchaseOrderStatusPaid, true
+	case PaymentResultRejected:
+		return PurchaseOrderStatusRejected, true
+	case PaymentResultPending:
+		return PurchaseOrderStatusPending, true
+	case PaymentResultExpired:
+		return PurchaseOrderStatusExpired, true
+	default:
+		return "", false
+	}
+}
+
+// PaymentResultEvent representa la notificación de resultado de pago de una pasarela
+type PaymentResultEvent struct {
+	GatewayName          string
+	PurchaseOrder        string
+	Result               PaymentResult
+	OrderStatus          PurchaseOrderStatus
+	Amount               int64
+	GatewayTransactionID string
+	OccurredAt           time.Time
+	TraceID              string
+	// TenantID es el tenant de la solicitud que recibió la notificación; "" sin tenant
+	TenantID string
+}
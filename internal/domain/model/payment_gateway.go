@@ -0,0 +1,76 @@
+package model
+
+import "slices"
+
+// PaymentGateway representa una pasarela de pago disponible para generar órdenes de compra
+type PaymentGateway interface {
+	// Name es el gatewayName que se envía al payment-manager
+	Name() string
+	DisplayName() string
+	LogoURL() string
+	SupportedCurrencies() []string
+	// MinAmount y MaxAmount delimitan el monto aceptado; MaxAmount en 0 significa sin máximo
+	MinAmount() int64
+	MaxAmount() int64
+	// IsEnabledFor indica si la pasarela está habilitada en la instalación del rack indicado
+	IsEnabledFor(rackID int) bool
+}
+
+// StaticPaymentGateway es una pasarela de pago definida por configuración
+type StaticPaymentGateway struct {
+	GatewayName     string
+	Display         string
+	Logo            string
+	Currencies      []string
+	Min             int64
+	Max             int64
+	Enabled         bool
+	EnabledRackIDs  []int // vacío = habilitada en todas las instalaciones
+	DisabledRackIDs []int
+}
+
+// Name implementa PaymentGateway.Name
+func (g *StaticPaymentGateway) Name() string {
+	return g.GatewayName
+}
+
+// DisplayName implementa PaymentGateway.DisplayName
+func (g *StaticPaymentGateway) DisplayName() string {
+	return g.Display
+}
+
+// LogoURL implementa PaymentGateway.LogoURL
+func (g *StaticPaymentGateway) LogoURL() string {
+	return g.Logo
+}
+
+// SupportedCurrencies implementa PaymentGateway.SupportedCurrencies
+func (g *StaticPaymentGateway) SupportedCurrencies() []string {
+	return slices.Clone(g.Currencies)
+}
+
+// MinAmount implementa PaymentGateway.MinAmount
+func (g *StaticPaymentGateway) MinAmount() int64 {
+	return g.Min
+}
+
+// MaxAmount implementa PaymentGateway.MaxAmount
+func (g *StaticPaymentGateway) MaxAmount() int64 {
+	return g.Max
+}
+
+// IsEnabledFor implementa PaymentGateway.IsEnabledFor
+func (g *StaticPaymentGateway) IsEnabledFor(rackID int) bool {
+	if !g.Enabled || slices.Contains(g.DisabledRackIDs, rackID) {
+		return false
+	}
+	return len(g.EnabledRackIDs) == 0 || slices.Contains(g.EnabledRackIDs, rackID)
+}
+
+// AcceptsAmount indica si el monto está dentro de los límites de la pasarela
+func AcceptsAmount(gateway PaymentGateway, amount int64) bool {
+	if amount < gateway.MinAmount() {
+		return false
+	}
+	return gateway.MaxAmount() == 0 || amount <= gateway.MaxAmount()
+}
@@ -1,14 +1,19 @@
 package model
 
+import "time"
+
 // PaymentInfra representa el agregado de datos de infraestructura de pagos
 type PaymentInfra struct {
 	TransactionID string
 	Message       string
+	Detail        string
 	Status        ResponseStatus
 	TraceID       string
 	PaymentRack   *PaymentRack
 	Installation  *PaymentInstallation
 	BookingTimes  []PaymentBookingTime
+	// Branding es la marca del tenant de la instalación; nil sin tenant
+	Branding *TenantBranding
 }
 
 // PaymentRack representa una entidad de rack de pagos
@@ -60,6 +65,7 @@ const (
 type AvailableLockers struct {
 	TransactionID   string
 	Message         string
+	Detail          string
 	Status          ResponseStatus
 	TraceID         string
 	AvailableGroups []AvailablePaymentGroup
@@ -78,6 +84,7 @@ type AvailablePaymentGroup struct {
 type DiscountCouponValidation struct {
 	TransactionID      string
 	Message            string
+	Detail             string
 	Status             ResponseStatus
 	TraceID            string
 	DiscountPercentage float64
@@ -87,6 +94,7 @@ type DiscountCouponValidation struct {
 type PurchaseOrder struct {
 	TransactionID string
 	Message       string
+	Detail        string
 	Status        ResponseStatus
 	TraceID       string
 	URL           string
@@ -96,6 +104,7 @@ type PurchaseOrder struct {
 type Booking struct {
 	TransactionID string
 	Message       string
+	Detail        string
 	Status        ResponseStatus
 	TraceID       string
 	Code          string
@@ -105,6 +114,7 @@ type Booking struct {
 type PurchaseOrderData struct {
 	TransactionID      string
 	Message            string
+	Detail             string
 	Status             ResponseStatus
 	TraceID            string
 	CouponID           int
@@ -120,40 +130,55 @@ type PurchaseOrderData struct {
 	LockerPosition     int
 	InstallationName   string
 	DeviceSerieNum     string
-	OrderStatus        string
+	OrderStatus        PurchaseOrderStatus
+	// GatewayName es la pasarela con la que se generó la orden; vacío si el backend no la informa
+	GatewayName string
 }
 
 // BookingStatusCheck representa el resultado de verificar el estado de una reserva
 type BookingStatusCheck struct {
 	TransactionID string
 	Message       string
+	Detail        string
 	Status        ResponseStatus
 	Booking       *BookingStatusData
 }
 
+// DefaultTimezone es la zona horaria de operación en que el backend informa las fechas de reserva
+const DefaultTimezone = "America/Santiago"
+
 // BookingStatusData representa los datos completos de una reserva
 type BookingStatusData struct {
 	ID                     int
 	ConfigurationBookingID int
-	InitBooking            string
-	FinishBooking          string
+	InitBooking            time.Time
+	FinishBooking          time.Time
 	InstallationName       string
 	NumberLocker           int
 	DeviceID               string
 	CurrentCode            string
-	Openings               int
-	ServiceName            string
-	EmailRecipient         string
-	CreatedAt              string
-	UpdatedAt              string
+	// Openings es la cantidad de aperturas que le quedan a la reserva
+	Openings       int
+	ServiceName    string
+	EmailRecipient string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// PaymentStatus es el estado del pago asociado; vacío cuando el backend no lo informa
+	// (las reservas se generan tras el pago, por lo que se asume pagada)
+	PaymentStatus PurchaseOrderStatus
+	// PurchaseOrder es la orden con que se pagó la reserva; vacía cuando el backend no la informa
+	PurchaseOrder string
 }
 
 // ExecuteOpenResult representa el resultado de ejecutar la apertura de un locker
 type ExecuteOpenResult struct {
 	TransactionID string
 	Message       string
+	Detail        string
 	Status        ResponseStatus
 	OpenStatus    OpenStatus
+	// ReceivedStatuses son todos los estados de apertura recibidos del backend, en orden
+	ReceivedStatuses []OpenStatus
 }
 
 // OpenStatus enumeración de estados de apertura de locker
@@ -167,3 +192,37 @@ const (
 	OpenStatusError       OpenStatus = "OPEN_STATUS_ERROR"
 	OpenStatusSuccess     OpenStatus = "OPEN_STATUS_SUCCESS"
 )
+
+// PurchaseOrderStatus enumeración de estados de una orden de compra
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusPending  PurchaseOrderStatus = "PENDING"
+	PurchaseOrderStatusPaid     PurchaseOrderStatus = "PAID"
+	PurchaseOrderStatusRejected PurchaseOrderStatus = "REJECTED"
+	PurchaseOrderStatusExpired  PurchaseOrderStatus = "EXPIRED"
+	PurchaseOrderStatusRefunded PurchaseOrderStatus = "REFUNDED"
+)
+
+// CanTransitionTo indica si la orden puede pasar al estado indicado. Solo una orden pendiente
+// cambia de estado por un resultado de pago; un estado vacío se considera pendiente.
+func (s PurchaseOrderStatus) CanTransitionTo(next PurchaseOrderStatus) bool {
+	switch s {
+	case "", PurchaseOrderStatusPending:
+		return next != ""
+	case PurchaseOrderStatusPaid:
+		return next == PurchaseOrderStatusRefunded
+	default:
+		return false
+	}
+}
+
+// IsTerminal indica si la orden ya no cambiará de estado
+func (s PurchaseOrderStatus) IsTerminal() bool {
+	switch s {
+	case PurchaseOrderStatusPaid, PurchaseOrderStatusRejected, PurchaseOrderStatusExpired, PurchaseOrderStatusRefunded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+import "math"
+
+// PaymentQuote representa el precio de un grupo de lockers con el descuento del cupón aplicado
+type PaymentQuote struct {
+	GroupID            int
+	CouponCode         *string
+	CouponApplied      bool
+	Price              float64
+	DiscountPercentage float64
+	DiscountAmount     float64
+	Total              float64
+}
+
+// NewPaymentQuote cotiza el grupo con el resultado de la validación del cupón.
+// Sin validación, o si el cupón no otorga descuento, se cotiza el precio de lista.
+func NewPaymentQuote(group AvailablePaymentGroup, couponCode *string, validation *DiscountCouponValidation) PaymentQuote {
+	quote := PaymentQuote{
+		GroupID:    group.GroupID,
+		CouponCode: couponCode,
+		Price:      group.Price,
+		Total:      group.Price,
+	}
+
+	if validation == nil || validation.Status != ResponseStatusOK || validation.DiscountPercentage <= 0 {
+		return quote
+	}
+
+	// Los montos se expresan en pesos, sin decimales
+	quote.CouponApplied = true
+	quote.DiscountPercentage = math.Min(validation.DiscountPercentage, 100)
+	quote.DiscountAmount = math.Round(group.Price * quote.DiscountPercentage / 100)
+	quote.Total = group.Price - quote.DiscountAmount
+	return quote
+}
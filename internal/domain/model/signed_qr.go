@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// QRVerificationMode define cómo se aceptan los valores QR de los racks
+type QRVerificationMode string
+
+const (
+	// QRVerificationDisabled acepta cualquier valor QR sin verificar firma
+	QRVerificationDisabled QRVerificationMode = "disabled"
+	// QRVerificationMigration verifica los QR firmados y sigue aceptando los valores heredados sin firma
+	QRVerificationMigration QRVerificationMode = "migration"
+	// QRVerificationEnforced solo acepta QR firmados y vigentes
+	QRVerificationEnforced QRVerificationMode = "enforced"
+)
+
+// QRSignatureAlgorithm algoritmo de firma de un QR
+type QRSignatureAlgorithm string
+
+const (
+	QRSignatureHS256 QRSignatureAlgorithm = "HS256"
+	QRSignatureEdDSA QRSignatureAlgorithm = "EdDSA"
+)
+
+// QRKey es una clave de verificación de QR firmados. El algoritmo lo define la clave,
+// nunca el QR, para que un QR no pueda elegir cómo se verifica.
+type QRKey struct {
+	ID        string
+	Algorithm QRSignatureAlgorithm
+	// Material es el secreto HMAC o la clave pública Ed25519
+	Material []byte
+}
+
+// QRClaims son los datos firmados de un QR de rack
+type QRClaims struct {
+	KeyID string
+	// QRValue es el valor QR con el que el backend identifica al rack
+	QRValue        string
+	RackID         int
+	InstallationID int
+	ExpiresAt      time.Time
+}
+
+// Expired indica si el QR ya no es vigente
+func (c QRClaims) Expired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+// Matches indica si la infraestructura devuelta por el backend corresponde al rack y la instalación firmados
+func (c QRClaims) Matches(paymentInfra *PaymentInfra) bool {
+	if paymentInfra == nil || paymentInfra.PaymentRack == nil || paymentInfra.PaymentRack.ID != c.RackID {
+		return false
+	}
+	return paymentInfra.Installation == nil || paymentInfra.Installation.ID == c.InstallationID
+}
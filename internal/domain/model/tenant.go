@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Tenant es un operador de lockers atendido por el BFF. Cada tenant tiene sus propios backends,
+// pasarelas de pago, feature flags, marca y límites de solicitudes.
+type Tenant struct {
+	ID          string
+	DisplayName string
+	// Hosts son los hostnames por los que llegan las solicitudes del tenant
+	Hosts []string
+	// ServiceNames son los serviceName de las reservas del tenant
+	ServiceNames []string
+	// InstallationIDs son las instalaciones del tenant
+	InstallationIDs []int
+	Backend         TenantBackend
+	// Gateways son los gatewayName habilitados; vacío habilita todas las pasarelas registradas
+	Gateways  []string
+	Features  map[string]bool
+	Branding  TenantBranding
+	RateLimit TenantRateLimit
+}
+
+// TenantBackend contiene los backends del tenant; los valores vacíos usan los globales
+type TenantBackend struct {
+	PaymentServiceAddress string
+	PaymentServiceTimeout time.Duration
+	BookingServiceAddress string
+	BookingServiceTimeout time.Duration
+}
+
+// IsDefault indica si el tenant usa el backend global sin cambios
+func (b TenantBackend) IsDefault() bool {
+	return b == TenantBackend{}
+}
+
+// TenantBranding contiene la marca que el frontend aplica a las pantallas del tenant
+type TenantBranding struct {
+	LogoURL        string
+	PrimaryColor   string
+	SecondaryColor string
+}
+
+// TenantRateLimit contiene el límite de solicitudes por cliente del tenant
+type TenantRateLimit struct {
+	// RequestsPerSecond en 0 usa el límite global
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// GatewayEnabled indica si la pasarela está habilitada para el tenant
+func (t *Tenant) GatewayEnabled(gatewayName string) bool {
+	if len(t.Gateways) == 0 {
+		return true
+	}
+
+	for _, name := range t.Gateways {
+		if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(gatewayName)) {
+			return true
+		}
+	}
+	return false
+}
+
+// FeatureEnabled indica si un feature flag está activo para el tenant; si el tenant no lo
+// define se usa fallback
+func (t *Tenant) FeatureEnabled(name string, fallback bool) bool {
+	if enabled, ok := t.Features[name]; ok {
+		return enabled
+	}
+	return fallback
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// BookingCodeQRService define el caso de uso para mostrar el código de apertura de una reserva como QR
+type BookingCodeQRService interface {
+	RenderBookingCode(ctx context.Context, request model.BookingCodeQRRequest) (*model.QRImage, error)
+}
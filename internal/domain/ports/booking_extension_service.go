@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// BookingExtensionService define el caso de uso para extender reservas comprando tiempo adicional
+type BookingExtensionService interface {
+	ExtendBooking(ctx context.Context, request model.BookingExtensionRequest) (*model.BookingExtension, error)
+}
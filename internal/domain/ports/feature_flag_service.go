@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// FeatureFlagService define el caso de uso de consulta de feature flags para los frontends
+type FeatureFlagService interface {
+	FeatureFlags(ctx context.Context, subject model.FeatureFlagSubject) ([]model.FeatureFlagValue, error)
+}
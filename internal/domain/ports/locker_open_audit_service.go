@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// LockerOpenAuditService define el caso de uso de consulta del registro de aperturas de locker
+type LockerOpenAuditService interface {
+	QueryLockerOpenAudit(ctx context.Context, filter model.LockerOpenAuditFilter) (*model.LockerOpenAuditPage, error)
+}
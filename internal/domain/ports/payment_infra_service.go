@@ -10,9 +10,13 @@ type PaymentInfraService interface {
 	GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error)
 	GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error)
 	ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error)
+	QuotePaymentGroup(ctx context.Context, rackID int, group model.AvailablePaymentGroup, couponCode *string, traceID string) (*model.PaymentQuote, error)
 	GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error)
 	GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error)
 	GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error)
 	CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error)
 	ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error)
+	AvailablePaymentGateways(ctx context.Context, rackID int) ([]model.PaymentGateway, error)
+	CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error)
+	InvalidatePaymentInfraCache(ctx context.Context, qrValue *string) (int, error)
 }
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// PaymentWebhookService define el caso de uso para procesar notificaciones de pasarelas de pago
+type PaymentWebhookService interface {
+	HandlePaymentResult(ctx context.Context, event *model.PaymentResultEvent) (*model.PurchaseOrderData, error)
+}
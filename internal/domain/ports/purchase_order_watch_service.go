@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// PurchaseOrderWatchService define el caso de uso para seguir los cambios de estado de una orden de compra.
+// El canal emite cada cambio de estado y se cierra tras un estado terminal o al cancelar ctx.
+type PurchaseOrderWatchService interface {
+	WatchPurchaseOrderStatus(ctx context.Context, purchaseOrder string, traceID string) (<-chan *model.PurchaseOrderData, error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+)
+
+// TenantService define el caso de uso de consulta del tenant de la solicitud
+type TenantService interface {
+	CurrentTenant(ctx context.Context, serviceName *string) (*model.Tenant, error)
+}
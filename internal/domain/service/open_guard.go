@@ -0,0 +1,75 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OpenGuard evalúa las reglas de dominio previas a la apertura de un locker, configurables por serviceName
+type OpenGuard struct {
+	mu        sync.RWMutex
+	defaults  model.OpenGuardRules
+	byService map[string]model.OpenGuardRules
+}
+
+// NewOpenGuard crea un guard con reglas por defecto y reglas específicas por serviceName
+func NewOpenGuard(defaults model.OpenGuardRules, byService map[string]model.OpenGuardRules) *OpenGuard {
+	guard := &OpenGuard{}
+	guard.Replace(defaults, byService)
+	return guard
+}
+
+// Replace reemplaza atómicamente todas las reglas
+func (g *OpenGuard) Replace(defaults model.OpenGuardRules, byService map[string]model.OpenGuardRules) {
+	normalized := make(map[string]model.OpenGuardRules, len(byService))
+	for serviceName, rules := range byService {
+		normalized[normalizeServiceName(serviceName)] = rules
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.defaults = defaults
+	g.byService = normalized
+}
+
+// RulesFor devuelve las reglas aplicables al serviceName
+func (g *OpenGuard) RulesFor(serviceName string) model.OpenGuardRules {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if rules, ok := g.byService[normalizeServiceName(serviceName)]; ok {
+		return rules
+	}
+	return g.defaults
+}
+
+// Evaluate verifica que la reserva pueda abrirse ahora según las reglas indicadas
+func (g *OpenGuard) Evaluate(rules model.OpenGuardRules, booking *model.BookingStatusData, now time.Time) error {
+	if booking == nil {
+		return exception.ErrBookingNotFound
+	}
+
+	if rules.EnforceWindow {
+		if !booking.InitBooking.IsZero() && now.Before(booking.InitBooking.Add(-rules.EarlyOpenTolerance)) {
+			return exception.ErrBookingNotStarted
+		}
+
+		if !booking.FinishBooking.IsZero() && !now.Before(booking.FinishBooking) {
+			return exception.ErrBookingExpired
+		}
+	}
+
+	if rules.EnforceOpenings && booking.Openings <= 0 {
+		return exception.ErrOpeningsExhausted
+	}
+
+	return nil
+}
+
+// normalizeServiceName normaliza el serviceName para las búsquedas
+func normalizeServiceName(serviceName string) string {
+	return strings.ToLower(strings.TrimSpace(serviceName))
+}
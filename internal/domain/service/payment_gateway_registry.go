@@ -0,0 +1,71 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"strings"
+	"sync"
+)
+
+// PaymentGatewayRegistry mantiene las pasarelas de pago disponibles indexadas por gatewayName
+type PaymentGatewayRegistry struct {
+	mu       sync.RWMutex
+	gateways []model.PaymentGateway
+	byName   map[string]model.PaymentGateway
+}
+
+// NewPaymentGatewayRegistry crea un registro con las pasarelas indicadas
+func NewPaymentGatewayRegistry(gateways ...model.PaymentGateway) *PaymentGatewayRegistry {
+	registry := &PaymentGatewayRegistry{}
+	registry.Replace(gateways...)
+	return registry
+}
+
+// Replace reemplaza atómicamente todas las pasarelas registradas
+func (r *PaymentGatewayRegistry) Replace(gateways ...model.PaymentGateway) {
+	byName := make(map[string]model.PaymentGateway, len(gateways))
+	for _, gateway := range gateways {
+		byName[normalizeGatewayName(gateway.Name())] = gateway
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gateways = append([]model.PaymentGateway(nil), gateways...)
+	r.byName = byName
+}
+
+// Resolve devuelve la pasarela si existe y está habilitada para el rack
+func (r *PaymentGatewayRegistry) Resolve(gatewayName string, rackID int) (model.PaymentGateway, error) {
+	r.mu.RLock()
+	gateway, ok := r.byName[normalizeGatewayName(gatewayName)]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, exception.ErrUnknownPaymentGateway
+	}
+
+	if !gateway.IsEnabledFor(rackID) {
+		return nil, exception.ErrPaymentGatewayDisabled
+	}
+
+	return gateway, nil
+}
+
+// Available devuelve las pasarelas habilitadas para el rack, en orden de registro
+func (r *PaymentGatewayRegistry) Available(rackID int) []model.PaymentGateway {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	available := make([]model.PaymentGateway, 0, len(r.gateways))
+	for _, gateway := range r.gateways {
+		if gateway.IsEnabledFor(rackID) {
+			available = append(available, gateway)
+		}
+	}
+	return available
+}
+
+// normalizeGatewayName compara gatewayName sin distinguir mayúsculas ni espacios
+func normalizeGatewayName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
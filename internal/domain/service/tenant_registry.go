@@ -0,0 +1,159 @@
+package service
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"net"
+	"strings"
+	"sync"
+)
+
+// TenantRegistry mantiene los tenants indexados por ID, hostname, serviceName e instalación.
+// Los tenants registrados no se modifican; Replace los reemplaza todos a la vez.
+type TenantRegistry struct {
+	mu             sync.RWMutex
+	tenants        []*model.Tenant
+	byID           map[string]*model.Tenant
+	byHost         map[string]*model.Tenant
+	byServiceName  map[string]*model.Tenant
+	byInstallation map[int]*model.Tenant
+	defaultTenant  *model.Tenant
+}
+
+// NewTenantRegistry crea un registro con los tenants indicados. defaultID es el tenant de las
+// solicitudes que no se pueden asociar a ninguno; vacío las deja sin tenant.
+func NewTenantRegistry(defaultID string, tenants ...model.Tenant) *TenantRegistry {
+	registry := &TenantRegistry{}
+	registry.Replace(defaultID, tenants...)
+	return registry
+}
+
+// Replace reemplaza atómicamente todos los tenants registrados
+func (r *TenantRegistry) Replace(defaultID string, tenants ...model.Tenant) {
+	registered := make([]*model.Tenant, 0, len(tenants))
+	byID := make(map[string]*model.Tenant, len(tenants))
+	byHost := make(map[string]*model.Tenant)
+	byServiceName := make(map[string]*model.Tenant)
+	byInstallation := make(map[int]*model.Tenant)
+
+	for _, tenant := range tenants {
+		registered = append(registered, &tenant)
+		byID[normalizeTenantID(tenant.ID)] = &tenant
+		for _, host := range tenant.Hosts {
+			byHost[normalizeHost(host)] = &tenant
+		}
+		for _, serviceName := range tenant.ServiceNames {
+			byServiceName[normalizeServiceName(serviceName)] = &tenant
+		}
+		for _, installationID := range tenant.InstallationIDs {
+			byInstallation[installationID] = &tenant
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tenants = registered
+	r.byID = byID
+	r.byHost = byHost
+	r.byServiceName = byServiceName
+	r.byInstallation = byInstallation
+	r.defaultTenant = byID[normalizeTenantID(defaultID)]
+}
+
+// All devuelve los tenants registrados, en orden de registro
+func (r *TenantRegistry) All() []*model.Tenant {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*model.Tenant(nil), r.tenants...)
+}
+
+// ByID devuelve el tenant con el ID indicado
+func (r *TenantRegistry) ByID(id string) (*model.Tenant, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tenant, ok := r.byID[normalizeTenantID(id)]
+	return tenant, ok
+}
+
+// ByHost devuelve el tenant que atiende el hostname; el puerto se ignora
+func (r *TenantRegistry) ByHost(host string) (*model.Tenant, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tenant, ok := r.byHost[normalizeHost(host)]
+	return tenant, ok
+}
+
+// ByServiceName devuelve el tenant dueño del serviceName
+func (r *TenantRegistry) ByServiceName(serviceName string) (*model.Tenant, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tenant, ok := r.byServiceName[normalizeServiceName(serviceName)]
+	return tenant, ok
+}
+
+// ByInstallation devuelve el tenant dueño de la instalación
+func (r *TenantRegistry) ByInstallation(installationID int) (*model.Tenant, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tenant, ok := r.byInstallation[installationID]
+	return tenant, ok
+}
+
+// Default devuelve el tenant por defecto, si está configurado
+func (r *TenantRegistry) Default() (*model.Tenant, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultTenant, r.defaultTenant != nil
+}
+
+// ResolveRequest obtiene el tenant indicado explícitamente por la solicitud: primero por su ID
+// (un ID desconocido es un error) y luego por el hostname. Devuelve nil si no indica ninguno.
+func (r *TenantRegistry) ResolveRequest(tenantID string, host string) (*model.Tenant, error) {
+	if strings.TrimSpace(tenantID) != "" {
+		tenant, ok := r.ByID(tenantID)
+		if !ok {
+			return nil, exception.ErrUnknownTenant
+		}
+		return tenant, nil
+	}
+
+	if tenant, ok := r.ByHost(host); ok {
+		return tenant, nil
+	}
+	return nil, nil
+}
+
+// Scope obtiene el tenant de un caso de uso a partir del tenant de la solicitud (current, puede
+// ser nil) y del serviceName (puede ser vacío). Un serviceName de otro tenant es un error; sin
+// tenant de la solicitud se usa el dueño del serviceName y, si no hay, el tenant por defecto.
+func (r *TenantRegistry) Scope(current *model.Tenant, serviceName string) (*model.Tenant, error) {
+	if strings.TrimSpace(serviceName) != "" {
+		if owner, ok := r.ByServiceName(serviceName); ok {
+			if current != nil && owner.ID != current.ID {
+				return nil, exception.ErrTenantMismatch
+			}
+			return owner, nil
+		}
+	}
+
+	if current != nil {
+		return current, nil
+	}
+
+	tenant, _ := r.Default()
+	return tenant, nil
+}
+
+// normalizeTenantID compara IDs de tenant sin distinguir mayúsculas ni espacios
+func normalizeTenantID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// normalizeHost compara hostnames sin puerto y sin distinguir mayúsculas
+func normalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
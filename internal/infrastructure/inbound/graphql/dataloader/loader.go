@@ -0,0 +1,120 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchFunc obtiene los resultados de un lote de claves únicas, en el mismo orden que keys
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]V, []error)
+
+// Loader agrupa las claves pedidas dentro de una ventana corta (o hasta completar el lote)
+// y las obtiene con una sola invocación de BatchFunc. Los resultados se memorizan por
+// solicitud: los field resolvers que piden la misma clave comparten una sola llamada.
+// El lote se obtiene con el contexto de quien pidió la primera clave, pero sin su cancelación:
+// si esa solicitud se abandona, los demás que esperan el lote igual reciben su resultado.
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	pending *batch[K, V]
+}
+
+// result es el resultado, posiblemente aún en curso, de una clave
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// batch es un lote de claves a la espera de ser despachado
+type batch[K comparable, V any] struct {
+	ctx     context.Context
+	keys    []K
+	results []*result[V]
+	timer   *time.Timer
+}
+
+// NewLoader crea un loader que despacha los lotes tras wait o al alcanzar maxBatch claves
+func NewLoader[K comparable, V any](fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	if maxBatch <= 0 {
+		maxBatch = 1
+	}
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  make(map[K]*result[V]),
+	}
+}
+
+// Load devuelve el resultado de la clave, obteniéndolo solo la primera vez
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.results[key] = res
+		l.enqueue(ctx, key, res)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// enqueue agrega la clave al lote pendiente; debe llamarse con mu tomado
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, res *result[V]) {
+	if l.pending == nil {
+		b := &batch[K, V]{ctx: context.WithoutCancel(ctx)}
+		b.timer = time.AfterFunc(l.wait, func() { l.dispatchPending(b) })
+		l.pending = b
+	}
+
+	b := l.pending
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, res)
+
+	if len(b.keys) >= l.maxBatch {
+		b.timer.Stop()
+		l.pending = nil
+		go l.dispatch(b)
+	}
+}
+
+// dispatchPending despacha el lote al vencer su ventana, si no se despachó antes por tamaño
+func (l *Loader[K, V]) dispatchPending(b *batch[K, V]) {
+	l.mu.Lock()
+	if l.pending != b {
+		l.mu.Unlock()
+		return
+	}
+	l.pending = nil
+	l.mu.Unlock()
+
+	l.dispatch(b)
+}
+
+// dispatch obtiene el lote y entrega cada resultado a quienes lo esperan
+func (l *Loader[K, V]) dispatch(b *batch[K, V]) {
+	values, errs := l.fetch(b.ctx, b.keys)
+
+	for i, res := range b.results {
+		if i < len(values) {
+			res.value = values[i]
+		}
+		if i < len(errs) {
+			res.err = errs[i]
+		}
+		close(res.done)
+	}
+}
@@ -0,0 +1,247 @@
+package dataloader
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/domain/ports"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingService es un caso de uso falso que cuenta las consultas por valor QR e idioma
+type countingService struct {
+	ports.PaymentInfraService
+
+	mu    sync.Mutex
+	calls map[PaymentInfraKey]int
+}
+
+func newCountingService() *countingService {
+	return &countingService{calls: make(map[PaymentInfraKey]int)}
+}
+
+func (s *countingService) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	locale := i18n.FromContext(ctx)
+
+	s.mu.Lock()
+	s.calls[PaymentInfraKey{QRValue: qrValue, Locale: locale}]++
+	s.mu.Unlock()
+
+	return &model.PaymentInfra{Message: fmt.Sprintf("%s/%s", qrValue, locale)}, nil
+}
+
+func (s *countingService) total() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	total := 0
+	for _, calls := range s.calls {
+		total += calls
+	}
+	return total
+}
+
+// recordingFetch es una BatchFunc que registra los lotes recibidos
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (f *recordingFetch) fetch(ctx context.Context, keys []int) ([]string, []error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, slices.Clone(keys))
+	f.mu.Unlock()
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = fmt.Sprintf("value-%d", key)
+	}
+	return values, make([]error, len(keys))
+}
+
+func (f *recordingFetch) recorded() [][]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.batches)
+}
+
+// loadAll pide las claves en paralelo y devuelve los resultados en el mismo orden
+func loadAll(t *testing.T, loader *Loader[int, string], keys []int) []string {
+	t.Helper()
+
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], errs[i] = loader.Load(context.Background(), key)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Load(%d) error = %v", keys[i], err)
+		}
+	}
+	return values
+}
+
+func TestLoaderDeduplicatesKeys(t *testing.T) {
+	fetch := &recordingFetch{}
+	loader := NewLoader(fetch.fetch, 10*time.Millisecond, 100)
+
+	values := loadAll(t, loader, []int{7, 7, 7, 7, 7})
+
+	for _, value := range values {
+		if value != "value-7" {
+			t.Errorf("Load() = %q, want %q", value, "value-7")
+		}
+	}
+
+	batches := fetch.recorded()
+	if len(batches) != 1 || !slices.Equal(batches[0], []int{7}) {
+		t.Errorf("batches = %v, want [[7]]", batches)
+	}
+
+	// Una clave ya obtenida se sirve sin volver a consultar
+	if _, err := loader.Load(context.Background(), 7); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := len(fetch.recorded()); got != 1 {
+		t.Errorf("batches after repeated load = %d, want 1", got)
+	}
+}
+
+func TestLoaderBatchesKeysWithinWindow(t *testing.T) {
+	fetch := &recordingFetch{}
+	loader := NewLoader(fetch.fetch, 100*time.Millisecond, 100)
+
+	values := loadAll(t, loader, []int{1, 2, 3, 2, 1})
+
+	want := []string{"value-1", "value-2", "value-3", "value-2", "value-1"}
+	if !slices.Equal(values, want) {
+		t.Errorf("Load() values = %v, want %v", values, want)
+	}
+
+	batches := fetch.recorded()
+	if len(batches) != 1 {
+		t.Fatalf("batches = %v, want a single batch", batches)
+	}
+
+	keys := slices.Sorted(slices.Values(batches[0]))
+	if !slices.Equal(keys, []int{1, 2, 3}) {
+		t.Errorf("batch keys = %v, want [1 2 3]", keys)
+	}
+}
+
+func TestLoaderDispatchesFullBatches(t *testing.T) {
+	fetch := &recordingFetch{}
+	// Una ventana larga: solo el tamaño del lote puede despacharlo a tiempo
+	loader := NewLoader(fetch.fetch, time.Hour, 2)
+
+	loadAll(t, loader, []int{1, 2, 3, 4})
+
+	batches := fetch.recorded()
+	if len(batches) != 2 {
+		t.Fatalf("batches = %v, want 2 batches", batches)
+	}
+	for _, batch := range batches {
+		if len(batch) != 2 {
+			t.Errorf("batch = %v, want 2 keys", batch)
+		}
+	}
+}
+
+func TestLoaderIgnoresFirstCallerCancellation(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetchCtxErr := make(chan error, 1)
+	loader := NewLoader(func(ctx context.Context, keys []int) ([]string, []error) {
+		close(started)
+		<-release
+		fetchCtxErr <- ctx.Err()
+		return []string{"value"}, make([]error, len(keys))
+	}, time.Millisecond, 100)
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := loader.Load(firstCtx, 1)
+		firstDone <- err
+	}()
+
+	// Abandonar la primera solicitud mientras su lote está en curso
+	<-started
+	cancel()
+	if err := <-firstDone; err != context.Canceled {
+		t.Errorf("first Load() error = %v, want %v", err, context.Canceled)
+	}
+
+	secondDone := make(chan error, 1)
+	go func() {
+		value, err := loader.Load(context.Background(), 1)
+		if err == nil && value != "value" {
+			err = fmt.Errorf("value = %q, want %q", value, "value")
+		}
+		secondDone <- err
+	}()
+
+	close(release)
+	if err := <-fetchCtxErr; err != nil {
+		t.Errorf("fetch context error = %v, want nil", err)
+	}
+	if err := <-secondDone; err != nil {
+		t.Errorf("second Load() error = %v, want nil", err)
+	}
+}
+
+func TestLoadersPaymentInfraByLocale(t *testing.T) {
+	service := newCountingService()
+	loaders := NewLoaders(service, Options{Wait: 10 * time.Millisecond, MaxBatch: 100})
+
+	keys := []PaymentInfraKey{
+		{QRValue: "QR1", Locale: model.LocaleSpanish},
+		{QRValue: "QR1", Locale: model.LocaleSpanish},
+		{QRValue: "QR1", Locale: model.LocaleEnglish},
+		{QRValue: "QR2", Locale: model.LocaleSpanish},
+	}
+
+	messages := make([]string, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			infra, err := loaders.PaymentInfra.Load(context.Background(), key)
+			if err != nil {
+				t.Errorf("Load(%v) error = %v", key, err)
+				return
+			}
+			messages[i] = infra.Message
+		}()
+	}
+	wg.Wait()
+
+	want := []string{"QR1/es", "QR1/es", "QR1/en", "QR2/es"}
+	if !slices.Equal(messages, want) {
+		t.Errorf("messages = %v, want %v", messages, want)
+	}
+
+	// Cada clave única (valor, idioma) se consulta una sola vez
+	if got := service.total(); got != 3 {
+		t.Errorf("service calls = %d, want 3", got)
+	}
+	for key, calls := range service.calls {
+		if calls != 1 {
+			t.Errorf("service calls for %v = %d, want 1", key, calls)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package dataloader
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/domain/ports"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options controla cómo se agrupan las claves de cada loader
+type Options struct {
+	// Wait es la ventana durante la que se acumulan claves antes de despachar el lote
+	Wait time.Duration
+	// MaxBatch despacha el lote de inmediato al alcanzar esta cantidad de claves
+	MaxBatch int
+}
+
+// Las claves incluyen el idioma porque el caso de uso localiza los mensajes de la respuesta:
+// dos campos de la misma solicitud pueden pedir la misma clave en idiomas distintos.
+
+// AvailableLockersKey identifica una consulta de lockers disponibles
+type AvailableLockersKey struct {
+	PaymentRackID int
+	BookingTimeID int
+	TraceID       string
+	Locale        model.Locale
+}
+
+// PaymentInfraKey identifica una consulta de infraestructura por valor QR
+type PaymentInfraKey struct {
+	QRValue string
+	Locale  model.Locale
+}
+
+// BookingKey identifica una reserva por su código
+type BookingKey struct {
+	ServiceName string
+	CurrentCode string
+	Locale      model.Locale
+}
+
+// Loaders agrupa los loaders de una solicitud HTTP
+type Loaders struct {
+	AvailableLockers *Loader[AvailableLockersKey, *model.AvailableLockers]
+	PaymentInfra     *Loader[PaymentInfraKey, *model.PaymentInfra]
+	BookingStatus    *Loader[BookingKey, *model.BookingStatusCheck]
+}
+
+// NewLoaders crea los loaders de una solicitud sobre el caso de uso. El backend no expone
+// operaciones por lote, así que cada lote consulta sus claves únicas en paralelo: los loaders
+// evitan consultas repetidas, pero no reducen las llamadas al backend a una por lote.
+func NewLoaders(paymentInfraService ports.PaymentInfraService, options Options) *Loaders {
+	return &Loaders{
+		AvailableLockers: NewLoader(fanOut(func(ctx context.Context, key AvailableLockersKey) (*model.AvailableLockers, error) {
+			return paymentInfraService.GetAvailableLockers(i18n.WithLocale(ctx, key.Locale), key.PaymentRackID, key.BookingTimeID, key.TraceID)
+		}), options.Wait, options.MaxBatch),
+		PaymentInfra: NewLoader(fanOut(func(ctx context.Context, key PaymentInfraKey) (*model.PaymentInfra, error) {
+			return paymentInfraService.GetPaymentInfraByQrValue(i18n.WithLocale(ctx, key.Locale), key.QRValue)
+		}), options.Wait, options.MaxBatch),
+		BookingStatus: NewLoader(fanOut(func(ctx context.Context, key BookingKey) (*model.BookingStatusCheck, error) {
+			return paymentInfraService.CheckBookingStatus(i18n.WithLocale(ctx, key.Locale), key.ServiceName, key.CurrentCode)
+		}), options.Wait, options.MaxBatch),
+	}
+}
+
+// fanOut convierte una consulta por clave en una BatchFunc que consulta el lote en paralelo.
+// Es deduplicación, no batching: cada clave única del lote sigue siendo una llamada al backend.
+func fanOut[K comparable, V any](fetch func(ctx context.Context, key K) (V, error)) BatchFunc[K, V] {
+	return func(ctx context.Context, keys []K) ([]V, []error) {
+		values := make([]V, len(keys))
+		errs := make([]error, len(keys))
+
+		var wg sync.WaitGroup
+		for i, key := range keys {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				values[i], errs[i] = fetch(ctx, key)
+			}()
+		}
+		wg.Wait()
+
+		return values, errs
+	}
+}
+
+type loadersKey struct{}
+
+// Middleware adjunta loaders nuevos al contexto de cada solicitud. Las conexiones WebSocket
+// se omiten porque su contexto dura toda la suscripción y los resultados quedarían obsoletos.
+func Middleware(paymentInfraService ports.PaymentInfraService, options Options) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), loadersKey{}, NewLoaders(paymentInfraService, options))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// For devuelve los loaders de la solicitud, o nil si el contexto no los tiene
+func For(ctx context.Context) *Loaders {
+	loaders, _ := ctx.Value(loadersKey{}).(*Loaders)
+	return loaders
+}
@@ -2,8 +2,13 @@ package mapper
 
 import (
 	"bff-graphql-payment/graph/model"
+	"bff-graphql-payment/internal/domain/exception"
 	domainModel "bff-graphql-payment/internal/domain/model"
+	"encoding/base64"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 )
 
 // PaymentInfraGraphQLMapper maneja el mapeo entre modelos de dominio y DTOs de GraphQL
@@ -15,7 +20,7 @@ func NewPaymentInfraGraphQLMapper() *PaymentInfraGraphQLMapper {
 }
 
 // ToGraphQLResponse mapea el modelo de dominio al modelo de respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToGraphQLResponse(paymentInfra *domainModel.PaymentInfra) *model.PaymentInfraResponse {
+func (m *PaymentInfraGraphQLMapper) ToGraphQLResponse(paymentInfra *domainModel.PaymentInfra, traceID string) *model.PaymentInfraResponse {
 	if paymentInfra == nil {
 		return nil
 	}
@@ -23,8 +28,9 @@ func (m *PaymentInfraGraphQLMapper) ToGraphQLResponse(paymentInfra *domainModel.
 	response := &model.PaymentInfraResponse{
 		TransactionID: paymentInfra.TransactionID,
 		Message:       paymentInfra.Message,
+		Detail:        optionalString(paymentInfra.Detail),
 		Status:        m.mapResponseStatus(paymentInfra.Status),
-		TraceID:       paymentInfra.TraceID,
+		TraceID:       traceID,
 		BookingTimes:  []*model.PaymentBookingTime{},
 	}
 
@@ -34,6 +40,7 @@ func (m *PaymentInfraGraphQLMapper) ToGraphQLResponse(paymentInfra *domainModel.
 			ID:          paymentInfra.PaymentRack.ID,
 			Description: paymentInfra.PaymentRack.Description,
 			Address:     paymentInfra.PaymentRack.Address,
+			TraceID:     traceID,
 		}
 	}
 
@@ -49,14 +56,24 @@ func (m *PaymentInfraGraphQLMapper) ToGraphQLResponse(paymentInfra *domainModel.
 		}
 	}
 
-	// Mapear tiempos de reserva
+	// Mapear marca del tenant
+	if paymentInfra.Branding != nil {
+		response.Branding = m.toTenantBranding(*paymentInfra.Branding)
+	}
+
+	// Mapear tiempos de reserva, ligados al rack para resolver sus grupos disponibles
 	for _, bt := range paymentInfra.BookingTimes {
-		response.BookingTimes = append(response.BookingTimes, &model.PaymentBookingTime{
+		bookingTime := &model.PaymentBookingTime{
 			ID:              bt.ID,
 			Name:            bt.Name,
 			UnitMeasurement: m.mapUnitMeasurement(bt.UnitMeasurement),
 			Amount:          bt.Amount,
-		})
+			TraceID:         traceID,
+		}
+		if paymentInfra.PaymentRack != nil {
+			bookingTime.RackID = paymentInfra.PaymentRack.ID
+		}
+		response.BookingTimes = append(response.BookingTimes, bookingTime)
 	}
 
 	return response
@@ -91,34 +108,73 @@ func (m *PaymentInfraGraphQLMapper) mapUnitMeasurement(unit domainModel.UnitMeas
 }
 
 // ToAvailableLockersByRackIDAndBookingTimeResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToAvailableLockersByRackIDAndBookingTimeResponse(lockers *domainModel.AvailableLockers) *model.AvailableLockersByRackIDAndBookingTimeResponse {
+func (m *PaymentInfraGraphQLMapper) ToAvailableLockersByRackIDAndBookingTimeResponse(lockers *domainModel.AvailableLockers, paymentRackID int, traceID string) *model.AvailableLockersByRackIDAndBookingTimeResponse {
 	if lockers == nil {
 		return nil
 	}
 
-	response := &model.AvailableLockersByRackIDAndBookingTimeResponse{
+	return &model.AvailableLockersByRackIDAndBookingTimeResponse{
 		TransactionID:   lockers.TransactionID,
 		Message:         lockers.Message,
+		Detail:          optionalString(lockers.Detail),
 		Status:          m.mapResponseStatus(lockers.Status),
-		TraceID:         lockers.TraceID,
-		AvailableGroups: []*model.AvailablePaymentGroup{},
+		TraceID:         traceID,
+		AvailableGroups: m.ToAvailablePaymentGroups(lockers, paymentRackID, traceID),
+	}
+}
+
+// ToAvailablePaymentGroups mapea los grupos disponibles, ligados al rack para cotizarlos
+func (m *PaymentInfraGraphQLMapper) ToAvailablePaymentGroups(lockers *domainModel.AvailableLockers, paymentRackID int, traceID string) []*model.AvailablePaymentGroup {
+	groups := []*model.AvailablePaymentGroup{}
+	if lockers == nil {
+		return groups
 	}
 
 	for _, group := range lockers.AvailableGroups {
-		response.AvailableGroups = append(response.AvailableGroups, &model.AvailablePaymentGroup{
+		groups = append(groups, &model.AvailablePaymentGroup{
 			GroupID:     group.GroupID,
 			Name:        group.Name,
 			Price:       group.Price,
 			Description: group.Description,
 			ImageURL:    group.ImageURL,
+			RackID:      paymentRackID,
+			TraceID:     traceID,
 		})
 	}
 
-	return response
+	return groups
+}
+
+// ToAvailablePaymentGroup mapea el grupo GraphQL al modelo de dominio
+func (m *PaymentInfraGraphQLMapper) ToAvailablePaymentGroup(group *model.AvailablePaymentGroup) domainModel.AvailablePaymentGroup {
+	return domainModel.AvailablePaymentGroup{
+		GroupID:     group.GroupID,
+		Name:        group.Name,
+		Price:       group.Price,
+		Description: group.Description,
+		ImageURL:    group.ImageURL,
+	}
+}
+
+// ToPaymentQuote mapea la cotización de dominio a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) ToPaymentQuote(quote *domainModel.PaymentQuote) *model.PaymentQuote {
+	if quote == nil {
+		return nil
+	}
+
+	return &model.PaymentQuote{
+		GroupID:            quote.GroupID,
+		CouponCode:         quote.CouponCode,
+		CouponApplied:      quote.CouponApplied,
+		Price:              quote.Price,
+		DiscountPercentage: quote.DiscountPercentage,
+		DiscountAmount:     quote.DiscountAmount,
+		Total:              quote.Total,
+	}
 }
 
 // ToValidateCouponResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToValidateCouponResponse(validation *domainModel.DiscountCouponValidation) *model.ValidateDiscountCouponResponse {
+func (m *PaymentInfraGraphQLMapper) ToValidateCouponResponse(validation *domainModel.DiscountCouponValidation, traceID string) *model.ValidateDiscountCouponResponse {
 	if validation == nil {
 		return nil
 	}
@@ -126,14 +182,15 @@ func (m *PaymentInfraGraphQLMapper) ToValidateCouponResponse(validation *domainM
 	return &model.ValidateDiscountCouponResponse{
 		TransactionID:      validation.TransactionID,
 		Message:            validation.Message,
+		Detail:             optionalString(validation.Detail),
 		Status:             m.mapResponseStatus(validation.Status),
-		TraceID:            validation.TraceID,
+		TraceID:            traceID,
 		DiscountPercentage: validation.DiscountPercentage,
 	}
 }
 
 // ToPurchaseOrderResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderResponse(order *domainModel.PurchaseOrder) *model.GeneratePurchaseOrderResponse {
+func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderResponse(order *domainModel.PurchaseOrder, traceID string) *model.GeneratePurchaseOrderResponse {
 	if order == nil {
 		return nil
 	}
@@ -141,14 +198,15 @@ func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderResponse(order *domainModel.P
 	return &model.GeneratePurchaseOrderResponse{
 		TransactionID: order.TransactionID,
 		Message:       order.Message,
+		Detail:        optionalString(order.Detail),
 		Status:        m.mapResponseStatus(order.Status),
-		TraceID:       order.TraceID,
+		TraceID:       traceID,
 		URL:           order.URL,
 	}
 }
 
 // ToBookingResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToBookingResponse(booking *domainModel.Booking) *model.GenerateBookingResponse {
+func (m *PaymentInfraGraphQLMapper) ToBookingResponse(booking *domainModel.Booking, traceID string) *model.GenerateBookingResponse {
 	if booking == nil {
 		return nil
 	}
@@ -156,44 +214,55 @@ func (m *PaymentInfraGraphQLMapper) ToBookingResponse(booking *domainModel.Booki
 	return &model.GenerateBookingResponse{
 		TransactionID: booking.TransactionID,
 		Message:       booking.Message,
+		Detail:        optionalString(booking.Detail),
 		Status:        m.mapResponseStatus(booking.Status),
-		TraceID:       booking.TraceID,
+		TraceID:       traceID,
 		Code:          booking.Code,
 	}
 }
 
 // ToPurchaseOrderDataResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderDataResponse(orderData *domainModel.PurchaseOrderData) *model.PurchaseOrderResponse {
+func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderDataResponse(orderData *domainModel.PurchaseOrderData, traceID string) *model.PurchaseOrderResponse {
 	if orderData == nil {
 		return nil
 	}
 
 	return &model.PurchaseOrderResponse{
-		TransactionID: orderData.TransactionID,
-		Message:       orderData.Message,
-		Status:        m.mapResponseStatus(orderData.Status),
-		TraceID:       orderData.TraceID,
-		PurchaseOrderData: &model.PurchaseOrderData{
-			CouponID:           orderData.CouponID,
-			BookingReference:   orderData.BookingReference,
-			Oc:                 orderData.OC,
-			Email:              orderData.Email,
-			Phone:              orderData.Phone,
-			Discount:           orderData.Discount,
-			ProductPrice:       orderData.ProductPrice,
-			FinalProductPrice:  fmt.Sprintf("%d", orderData.FinalProductPrice),
-			ProductName:        orderData.ProductName,
-			ProductDescription: orderData.ProductDescription,
-			LockerPosition:     orderData.LockerPosition,
-			InstallationName:   orderData.InstallationName,
-			DeviceSerieNum:     orderData.DeviceSerieNum,
-			Status:             orderData.OrderStatus,
-		},
+		TransactionID:     orderData.TransactionID,
+		Message:           orderData.Message,
+		Detail:            optionalString(orderData.Detail),
+		Status:            m.mapResponseStatus(orderData.Status),
+		TraceID:           traceID,
+		PurchaseOrderData: m.ToPurchaseOrderData(orderData),
+	}
+}
+
+// ToPurchaseOrderData mapea los datos de dominio de una orden de compra a GraphQL
+func (m *PaymentInfraGraphQLMapper) ToPurchaseOrderData(orderData *domainModel.PurchaseOrderData) *model.PurchaseOrderData {
+	if orderData == nil {
+		return nil
+	}
+
+	return &model.PurchaseOrderData{
+		CouponID:           orderData.CouponID,
+		BookingReference:   orderData.BookingReference,
+		Oc:                 orderData.OC,
+		Email:              orderData.Email,
+		Phone:              orderData.Phone,
+		Discount:           orderData.Discount,
+		ProductPrice:       orderData.ProductPrice,
+		FinalProductPrice:  fmt.Sprintf("%d", orderData.FinalProductPrice),
+		ProductName:        orderData.ProductName,
+		ProductDescription: orderData.ProductDescription,
+		LockerPosition:     orderData.LockerPosition,
+		InstallationName:   orderData.InstallationName,
+		DeviceSerieNum:     orderData.DeviceSerieNum,
+		Status:             string(orderData.OrderStatus),
 	}
 }
 
-// ToBookingStatusResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToBookingStatusResponse(bookingStatus *domainModel.BookingStatusCheck) *model.CheckBookingStatusResponse {
+// ToBookingStatusResponse mapea el modelo de dominio a respuesta GraphQL, expresando las fechas en la zona horaria indicada
+func (m *PaymentInfraGraphQLMapper) ToBookingStatusResponse(bookingStatus *domainModel.BookingStatusCheck, location *time.Location, traceID string) *model.CheckBookingStatusResponse {
 	if bookingStatus == nil {
 		return nil
 	}
@@ -201,15 +270,18 @@ func (m *PaymentInfraGraphQLMapper) ToBookingStatusResponse(bookingStatus *domai
 	response := &model.CheckBookingStatusResponse{
 		TransactionID: bookingStatus.TransactionID,
 		Message:       bookingStatus.Message,
+		Detail:        optionalString(bookingStatus.Detail),
 		Status:        m.mapResponseStatus(bookingStatus.Status),
+		TraceID:       traceID,
 	}
 
 	if bookingStatus.Booking != nil {
+		now := time.Now()
 		response.Booking = &model.BookingStatusData{
 			ID:                     bookingStatus.Booking.ID,
 			ConfigurationBookingID: bookingStatus.Booking.ConfigurationBookingID,
-			InitBooking:            bookingStatus.Booking.InitBooking,
-			FinishBooking:          bookingStatus.Booking.FinishBooking,
+			InitBooking:            bookingStatus.Booking.InitBooking.In(location),
+			FinishBooking:          bookingStatus.Booking.FinishBooking.In(location),
 			InstallationName:       bookingStatus.Booking.InstallationName,
 			NumberLocker:           bookingStatus.Booking.NumberLocker,
 			DeviceID:               bookingStatus.Booking.DeviceID,
@@ -217,16 +289,158 @@ func (m *PaymentInfraGraphQLMapper) ToBookingStatusResponse(bookingStatus *domai
 			Openings:               bookingStatus.Booking.Openings,
 			ServiceName:            bookingStatus.Booking.ServiceName,
 			EmailRecipient:         bookingStatus.Booking.EmailRecipient,
-			CreatedAt:              bookingStatus.Booking.CreatedAt,
-			UpdatedAt:              bookingStatus.Booking.UpdatedAt,
+			CreatedAt:              bookingStatus.Booking.CreatedAt.In(location),
+			UpdatedAt:              bookingStatus.Booking.UpdatedAt.In(location),
+			State:                  m.mapBookingState(bookingStatus.Booking.State(now)),
+			RemainingTime:          int(bookingStatus.Booking.RemainingTime(now).Seconds()),
+			CanOpen:                bookingStatus.Booking.CanOpen(now),
 		}
 	}
 
 	return response
 }
 
+// ToBookingCancellationRequest mapea la entrada GraphQL a la solicitud de cancelación de dominio
+func (m *PaymentInfraGraphQLMapper) ToBookingCancellationRequest(input model.CancelBookingInput, traceID string) domainModel.BookingCancellationRequest {
+	request := domainModel.BookingCancellationRequest{
+		Reason:  input.Reason,
+		TraceID: traceID,
+	}
+
+	if input.ServiceName != nil {
+		request.ServiceName = *input.ServiceName
+	}
+	if input.CurrentCode != nil {
+		request.CurrentCode = *input.CurrentCode
+	}
+	if input.PurchaseOrder != nil {
+		request.PurchaseOrder = *input.PurchaseOrder
+	}
+
+	return request
+}
+
+// ToCancelBookingResponse mapea el resultado de cancelación de dominio a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) ToCancelBookingResponse(cancellation *domainModel.BookingCancellation, traceID string) *model.CancelBookingResponse {
+	if cancellation == nil {
+		return nil
+	}
+
+	response := &model.CancelBookingResponse{
+		TransactionID:      cancellation.TransactionID,
+		Message:            cancellation.Message,
+		Detail:             optionalString(cancellation.Detail),
+		Status:             m.mapResponseStatus(cancellation.Status),
+		TraceID:            traceID,
+		BookingID:          cancellation.BookingID,
+		CancellationStatus: model.CancellationStatusFailed,
+		Refund:             &model.Refund{Status: model.RefundStatusNotRequired},
+	}
+
+	if cancellation.PurchaseOrder != "" {
+		response.PurchaseOrder = &cancellation.PurchaseOrder
+	}
+
+	if cancellation.CancellationStatus == domainModel.CancellationStatusCancelled {
+		response.CancellationStatus = model.CancellationStatusCancelled
+	}
+
+	if cancellation.Refund != nil {
+		// Un estado de reembolso desconocido se informa como pendiente
+		refundStatus := model.RefundStatus(cancellation.Refund.Status)
+		if !refundStatus.IsValid() {
+			refundStatus = model.RefundStatusPending
+		}
+
+		response.Refund = &model.Refund{
+			Status:  refundStatus,
+			Amount:  int(cancellation.Refund.Amount),
+			Message: cancellation.Refund.Message,
+			Detail:  optionalString(cancellation.Refund.Detail),
+		}
+		if cancellation.Refund.RefundID != "" {
+			response.Refund.RefundID = &cancellation.Refund.RefundID
+		}
+	}
+
+	return response
+}
+
+// ToBookingExtensionRequest mapea la entrada GraphQL a la solicitud de extensión de dominio
+func (m *PaymentInfraGraphQLMapper) ToBookingExtensionRequest(input model.ExtendBookingInput, traceID string) domainModel.BookingExtensionRequest {
+	return domainModel.BookingExtensionRequest{
+		ServiceName:   input.ServiceName,
+		CurrentCode:   input.CurrentCode,
+		BookingTimeID: input.BookingTimeID,
+		GatewayName:   input.GatewayName,
+		TraceID:       traceID,
+	}
+}
+
+// ToExtendBookingResponse mapea la extensión de dominio a respuesta GraphQL, expresando las fechas en la zona horaria indicada
+func (m *PaymentInfraGraphQLMapper) ToExtendBookingResponse(extension *domainModel.BookingExtension, location *time.Location, traceID string) *model.ExtendBookingResponse {
+	if extension == nil {
+		return nil
+	}
+
+	response := &model.ExtendBookingResponse{
+		BookingTime: &model.PaymentBookingTime{
+			ID:              extension.BookingTime.ID,
+			Name:            extension.BookingTime.Name,
+			UnitMeasurement: m.mapUnitMeasurement(extension.BookingTime.UnitMeasurement),
+			Amount:          extension.BookingTime.Amount,
+			TraceID:         traceID,
+		},
+		TraceID:              traceID,
+		Price:                int(extension.Price),
+		CurrentFinishBooking: extension.CurrentFinishBooking.In(location),
+		NewFinishBooking:     extension.NewFinishBooking.In(location),
+	}
+
+	if extension.Order != nil {
+		response.TransactionID = extension.Order.TransactionID
+		response.Message = extension.Order.Message
+		response.Detail = optionalString(extension.Order.Detail)
+		response.Status = m.mapResponseStatus(extension.Order.Status)
+		response.PurchaseOrder = extension.Order.PurchaseOrder
+		response.URL = extension.Order.URL
+	}
+
+	return response
+}
+
+// ToLocation resuelve la zona horaria solicitada por el cliente; sin valor se usa la del backend
+func (m *PaymentInfraGraphQLMapper) ToLocation(timezone *string) (*time.Location, error) {
+	name := domainModel.DefaultTimezone
+	if timezone != nil && strings.TrimSpace(*timezone) != "" {
+		name = strings.TrimSpace(*timezone)
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", exception.ErrInvalidTimezone, name)
+	}
+	return location, nil
+}
+
+// mapBookingState mapea el estado de reserva de dominio a GraphQL
+func (m *PaymentInfraGraphQLMapper) mapBookingState(state domainModel.BookingState) model.BookingState {
+	switch state {
+	case domainModel.BookingStatePendingPayment:
+		return model.BookingStatePendingPayment
+	case domainModel.BookingStateExpired:
+		return model.BookingStateExpired
+	case domainModel.BookingStateCancelled:
+		return model.BookingStateCancelled
+	case domainModel.BookingStateCompleted:
+		return model.BookingStateCompleted
+	default:
+		return model.BookingStateActive
+	}
+}
+
 // ToExecuteOpenResponse mapea el modelo de dominio a respuesta GraphQL
-func (m *PaymentInfraGraphQLMapper) ToExecuteOpenResponse(openResult *domainModel.ExecuteOpenResult) *model.ExecuteOpenResponse {
+func (m *PaymentInfraGraphQLMapper) ToExecuteOpenResponse(openResult *domainModel.ExecuteOpenResult, traceID string) *model.ExecuteOpenResponse {
 	if openResult == nil {
 		return nil
 	}
@@ -234,7 +448,9 @@ func (m *PaymentInfraGraphQLMapper) ToExecuteOpenResponse(openResult *domainMode
 	return &model.ExecuteOpenResponse{
 		TransactionID: openResult.TransactionID,
 		Message:       openResult.Message,
+		Detail:        optionalString(openResult.Detail),
 		Status:        m.mapResponseStatus(openResult.Status),
+		TraceID:       traceID,
 		OpenStatus:    m.mapOpenStatusToGraphQL(openResult.OpenStatus),
 	}
 }
@@ -258,3 +474,178 @@ func (m *PaymentInfraGraphQLMapper) mapOpenStatusToGraphQL(status domainModel.Op
 		return model.OpenStatusOpenStatusUnspecified
 	}
 }
+
+// ToPaymentGateways mapea las pasarelas de pago de dominio a GraphQL
+func (m *PaymentInfraGraphQLMapper) ToPaymentGateways(gateways []domainModel.PaymentGateway) []*model.PaymentGateway {
+	response := make([]*model.PaymentGateway, 0, len(gateways))
+
+	for _, gateway := range gateways {
+		response = append(response, &model.PaymentGateway{
+			Name:                gateway.Name(),
+			DisplayName:         gateway.DisplayName(),
+			LogoURL:             gateway.LogoURL(),
+			SupportedCurrencies: gateway.SupportedCurrencies(),
+			MinAmount:           int(gateway.MinAmount()),
+			MaxAmount:           int(gateway.MaxAmount()),
+		})
+	}
+
+	return response
+}
+
+// ToBookingCodeQRRequest mapea los argumentos de codeQr a la solicitud de dominio
+func (m *PaymentInfraGraphQLMapper) ToBookingCodeQRRequest(code string, format *model.QRImageFormat, size *int, serviceName *string, expiresAt *time.Time) domainModel.BookingCodeQRRequest {
+	request := domainModel.BookingCodeQRRequest{Code: code, ExpiresAt: expiresAt}
+	if format != nil {
+		request.Format = domainModel.QRImageFormat(*format)
+	}
+	if size != nil {
+		request.Size = *size
+	}
+	if serviceName != nil {
+		request.ServiceName = *serviceName
+	}
+	return request
+}
+
+// ToQRImage mapea la imagen QR de dominio a respuesta GraphQL (contenido en base64)
+func (m *PaymentInfraGraphQLMapper) ToQRImage(image *domainModel.QRImage) *model.QRImage {
+	if image == nil {
+		return nil
+	}
+
+	data := base64.StdEncoding.EncodeToString(image.Content)
+	return &model.QRImage{
+		Format:   model.QRImageFormat(image.Format),
+		MimeType: image.Format.MimeType(),
+		Size:     image.Size,
+		Payload:  image.Payload,
+		Data:     data,
+		DataURI:  "data:" + image.Format.MimeType() + ";base64," + data,
+	}
+}
+
+// ToLockerOpenAuditFilter mapea los argumentos de lockerOpenAudit al filtro de dominio
+func (m *PaymentInfraGraphQLMapper) ToLockerOpenAuditFilter(serviceName string, from time.Time, to time.Time, outcome *model.LockerOpenOutcome, limit *int, offset *int) domainModel.LockerOpenAuditFilter {
+	filter := domainModel.LockerOpenAuditFilter{ServiceName: serviceName, From: from, To: to}
+	if outcome != nil {
+		filter.Outcome = domainModel.LockerOpenOutcome(*outcome)
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+	return filter
+}
+
+// ToLockerOpenAuditPage mapea la página de auditoría de dominio a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) ToLockerOpenAuditPage(page *domainModel.LockerOpenAuditPage) *model.LockerOpenAuditPage {
+	if page == nil {
+		return nil
+	}
+
+	records := make([]*model.LockerOpenAuditRecord, 0, len(page.Records))
+	for _, record := range page.Records {
+		statuses := make([]model.OpenStatus, 0, len(record.OpenStatuses))
+		for _, status := range record.OpenStatuses {
+			statuses = append(statuses, m.mapOpenStatusToGraphQL(status))
+		}
+
+		response := &model.LockerOpenAuditRecord{
+			ID:           record.ID,
+			Timestamp:    record.Timestamp,
+			Principal:    record.Principal,
+			ClientIP:     record.ClientIP,
+			UserAgent:    record.UserAgent,
+			ServiceName:  record.ServiceName,
+			MaskedCode:   record.MaskedCode,
+			OpenStatuses: statuses,
+			Outcome:      model.LockerOpenOutcome(record.Outcome),
+			TraceID:      record.TraceID,
+		}
+		if record.Reason != "" {
+			response.Reason = &record.Reason
+		}
+		if record.UpstreamTransactionID != "" {
+			response.UpstreamTransactionID = &record.UpstreamTransactionID
+		}
+		records = append(records, response)
+	}
+
+	return &model.LockerOpenAuditPage{
+		Records:     records,
+		TotalCount:  page.TotalCount,
+		Limit:       page.Limit,
+		Offset:      page.Offset,
+		HasNextPage: page.HasNextPage,
+	}
+}
+
+// ToTenant mapea el tenant de dominio a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) ToTenant(tenant *domainModel.Tenant) *model.Tenant {
+	if tenant == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(tenant.Features))
+	for name := range tenant.Features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	features := make([]*model.TenantFeature, 0, len(names))
+	for _, name := range names {
+		features = append(features, &model.TenantFeature{Name: name, Enabled: tenant.Features[name]})
+	}
+
+	return &model.Tenant{
+		ID:          tenant.ID,
+		DisplayName: tenant.DisplayName,
+		Branding:    m.toTenantBranding(tenant.Branding),
+		Gateways:    append([]string{}, tenant.Gateways...),
+		Features:    features,
+	}
+}
+
+// toTenantBranding mapea la marca de un tenant a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) toTenantBranding(branding domainModel.TenantBranding) *model.TenantBranding {
+	return &model.TenantBranding{
+		LogoURL:        branding.LogoURL,
+		PrimaryColor:   branding.PrimaryColor,
+		SecondaryColor: branding.SecondaryColor,
+	}
+}
+
+// ToFeatureFlagSubject mapea los argumentos de la query featureFlags al sujeto de dominio
+func (m *PaymentInfraGraphQLMapper) ToFeatureFlagSubject(rackID *int, installationID *int, key *string) domainModel.FeatureFlagSubject {
+	var subject domainModel.FeatureFlagSubject
+	if rackID != nil {
+		subject.RackID = *rackID
+	}
+	if installationID != nil {
+		subject.InstallationID = *installationID
+	}
+	if key != nil {
+		subject.Key = *key
+	}
+	return subject
+}
+
+// ToFeatureFlags mapea los feature flags evaluados a respuesta GraphQL
+func (m *PaymentInfraGraphQLMapper) ToFeatureFlags(values []domainModel.FeatureFlagValue) []*model.FeatureFlag {
+	flags := make([]*model.FeatureFlag, 0, len(values))
+	for _, value := range values {
+		flags = append(flags, &model.FeatureFlag{Name: value.Name, Enabled: value.Enabled})
+	}
+	return flags
+}
+
+// optionalString convierte un texto vacío en un campo GraphQL nulo
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,89 @@
+package persisted
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeOperationNotAllowed es el código de extensions.code de las operaciones rechazadas
+const ErrCodeOperationNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+// Allowlist resuelve las operaciones del manifiesto enviadas solo por hash y, en modo
+// estricto, rechaza toda operación que no esté en el manifiesto. Debe registrarse antes
+// de la extensión APQ para que los hashes del manifiesto no dependan de su caché.
+type Allowlist struct {
+	manifest   Manifest
+	strict     bool
+	onRejected func()
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = (*Allowlist)(nil)
+
+// NewAllowlist crea la extensión de allowlist; onRejected se invoca por cada operación rechazada
+func NewAllowlist(manifest Manifest, strict bool, onRejected func()) *Allowlist {
+	if manifest == nil {
+		manifest = Manifest{}
+	}
+	return &Allowlist{
+		manifest:   manifest,
+		strict:     strict,
+		onRejected: onRejected,
+	}
+}
+
+// ExtensionName implementa graphql.HandlerExtension
+func (a *Allowlist) ExtensionName() string {
+	return "OperationAllowlist"
+}
+
+// Validate implementa graphql.HandlerExtension
+func (a *Allowlist) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters implementa graphql.OperationParameterMutator
+func (a *Allowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := persistedQueryHash(rawParams)
+
+	// Operación enviada solo por hash: tomar el texto del manifiesto
+	if rawParams.Query == "" && hash != "" {
+		if query, ok := a.manifest[hash]; ok {
+			rawParams.Query = query
+		}
+	}
+
+	if !a.strict {
+		return nil
+	}
+
+	if rawParams.Query != "" {
+		hash = ComputeHash(rawParams.Query)
+	}
+
+	if _, ok := a.manifest[hash]; ok {
+		return nil
+	}
+
+	slog.WarnContext(ctx, "🚫 Operation rejected by allowlist", "operationName", rawParams.OperationName, "hash", hash)
+	if a.onRejected != nil {
+		a.onRejected()
+	}
+
+	err := gqlerror.Errorf("operation is not in the allowlist")
+	errcode.Set(err, ErrCodeOperationNotAllowed)
+	return err
+}
+
+// persistedQueryHash devuelve el hash de la extensión persistedQuery, o "" si no viene
+func persistedQueryHash(rawParams *graphql.RawParams) string {
+	extension, _ := rawParams.Extensions["persistedQuery"].(map[string]any)
+	hash, _ := extension["sha256Hash"].(string)
+	return hash
+}
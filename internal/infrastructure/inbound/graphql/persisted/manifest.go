@@ -0,0 +1,39 @@
+package persisted
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Manifest asocia el hash SHA-256 (hex) de cada operación permitida con su texto
+type Manifest map[string]string
+
+// ComputeHash calcula el hash de una operación igual que los clientes APQ
+func ComputeHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadManifest lee el manifiesto JSON generado por cmd/allowlist y verifica sus hashes
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allowlist manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse allowlist manifest %s: %w", path, err)
+	}
+
+	for hash, query := range manifest {
+		if ComputeHash(query) != hash {
+			return nil, fmt.Errorf("allowlist manifest %s: hash %s does not match its operation", path, hash)
+		}
+	}
+
+	return manifest, nil
+}
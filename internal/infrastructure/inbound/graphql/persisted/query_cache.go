@@ -0,0 +1,12 @@
+package persisted
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+)
+
+// NewQueryCache crea la caché en memoria de las consultas APQ. Cualquier graphql.Cache[string]
+// (por ejemplo una compartida entre réplicas) puede usarse en su lugar.
+func NewQueryCache(size int) graphql.Cache[string] {
+	return lru.New[string](size)
+}
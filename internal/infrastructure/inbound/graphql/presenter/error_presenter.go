@@ -0,0 +1,25 @@
+package presenter
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter reemplaza el texto de los errores de dominio por su texto en el idioma de la
+// solicitud y agrega su código estable en extensions.code
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	if code, message, ok := i18n.Error(i18n.FromContext(ctx), err); ok {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Message = message
+		gqlErr.Extensions["code"] = code
+	}
+
+	return gqlErr
+}
@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// localeArgument es el argumento, directo o dentro de input, con el que una operación elige el idioma
+const localeArgument = "locale"
+
+// LocaleRootFieldMiddleware aplica el argumento locale de cada campo raíz al contexto antes de
+// resolverlo, para que tanto los mensajes de la respuesta como los errores usen ese idioma. Sin
+// argumento se mantiene el idioma del header Accept-Language.
+func LocaleRootFieldMiddleware(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	fieldCtx := graphql.GetRootFieldContext(ctx)
+	if fieldCtx == nil || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	args := fieldCtx.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+
+	locale, _ := args[localeArgument].(string)
+	if input, ok := args["input"].(map[string]any); ok && locale == "" {
+		locale, _ = input[localeArgument].(string)
+	}
+
+	if locale != "" {
+		ctx, _ = i18n.Resolve(ctx, &locale)
+	}
+	return next(ctx)
+}
@@ -1,8 +1,14 @@
 package resolver
 
 import (
+	"bff-graphql-payment/graph/model"
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/application/tracing"
 	"bff-graphql-payment/internal/domain/ports"
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/dataloader"
 	"bff-graphql-payment/internal/infrastructure/inbound/graphql/mapper"
+	"context"
+	"fmt"
 )
 
 // This file will not be regenerated automatically.
@@ -10,14 +16,56 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	paymentInfraService ports.PaymentInfraService
-	mapper              *mapper.PaymentInfraGraphQLMapper
+	paymentInfraService       ports.PaymentInfraService
+	purchaseOrderWatchService ports.PurchaseOrderWatchService
+	bookingExtensionService   ports.BookingExtensionService
+	bookingCodeQRService      ports.BookingCodeQRService
+	lockerOpenAuditService    ports.LockerOpenAuditService
+	tenantService             ports.TenantService
+	featureFlagService        ports.FeatureFlagService
+	mapper                    *mapper.PaymentInfraGraphQLMapper
 }
 
 // NewResolver crea un nuevo resolver con dependencias
-func NewResolver(paymentInfraService ports.PaymentInfraService) *Resolver {
+func NewResolver(paymentInfraService ports.PaymentInfraService, purchaseOrderWatchService ports.PurchaseOrderWatchService, bookingExtensionService ports.BookingExtensionService, bookingCodeQRService ports.BookingCodeQRService, lockerOpenAuditService ports.LockerOpenAuditService, tenantService ports.TenantService, featureFlagService ports.FeatureFlagService) *Resolver {
 	return &Resolver{
-		paymentInfraService: paymentInfraService,
-		mapper:              mapper.NewPaymentInfraGraphQLMapper(),
+		paymentInfraService:       paymentInfraService,
+		purchaseOrderWatchService: purchaseOrderWatchService,
+		bookingExtensionService:   bookingExtensionService,
+		bookingCodeQRService:      bookingCodeQRService,
+		lockerOpenAuditService:    lockerOpenAuditService,
+		tenantService:             tenantService,
+		featureFlagService:        featureFlagService,
+		mapper:                    mapper.NewPaymentInfraGraphQLMapper(),
 	}
 }
+
+// availableGroups resuelve los grupos disponibles de un tiempo de reserva del rack. Usa el
+// dataloader de la solicitud para no repetir la consulta entre field resolvers.
+func (r *Resolver) availableGroups(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) ([]*model.AvailablePaymentGroup, error) {
+	// Continuar con el traceId del padre
+	ctx, traceID = tracing.Ensure(ctx, &traceID)
+
+	// Llamar al caso de uso
+	lockers, err := r.loaders(ctx).AvailableLockers.Load(ctx, dataloader.AvailableLockersKey{
+		PaymentRackID: paymentRackID,
+		BookingTimeID: bookingTimeID,
+		TraceID:       traceID,
+		Locale:        i18n.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available lockers: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToAvailablePaymentGroups(lockers, paymentRackID, traceID), nil
+}
+
+// loaders devuelve los dataloaders de la solicitud. Sin ellos (por ejemplo en suscripciones)
+// se usan loaders que despachan cada clave de inmediato.
+func (r *Resolver) loaders(ctx context.Context) *dataloader.Loaders {
+	if loaders := dataloader.For(ctx); loaders != nil {
+		return loaders
+	}
+	return dataloader.NewLoaders(r.paymentInfraService, dataloader.Options{MaxBatch: 1})
+}
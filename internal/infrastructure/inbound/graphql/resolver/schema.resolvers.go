@@ -7,12 +7,51 @@ package resolver
 import (
 	"bff-graphql-payment/graph/generated"
 	"bff-graphql-payment/graph/model"
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/application/tracing"
+	domainModel "bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/infrastructure/inbound/graphql/dataloader"
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 )
 
+// Quote is the resolver for the quote field.
+func (r *availablePaymentGroupResolver) Quote(ctx context.Context, obj *model.AvailablePaymentGroup, couponCode *string) (*model.PaymentQuote, error) {
+	// Continuar con el traceId del padre
+	ctx, traceID := tracing.Ensure(ctx, &obj.TraceID)
+
+	// Llamar al caso de uso
+	quote, err := r.paymentInfraService.QuotePaymentGroup(ctx, obj.RackID, r.mapper.ToAvailablePaymentGroup(obj), couponCode, traceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote payment group: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToPaymentQuote(quote), nil
+}
+
+// CodeQR is the resolver for the codeQr field.
+func (r *generateBookingResponseResolver) CodeQR(ctx context.Context, obj *model.GenerateBookingResponse, format *model.QRImageFormat, size *int, serviceName *string, expiresAt *time.Time) (*model.QRImage, error) {
+	// Continuar con el traceId del padre
+	ctx, _ = tracing.Ensure(ctx, &obj.TraceID)
+
+	// Llamar al caso de uso
+	image, err := r.bookingCodeQRService.RenderBookingCode(ctx, r.mapper.ToBookingCodeQRRequest(obj.Code, format, size, serviceName, expiresAt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render booking code QR: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToQRImage(image), nil
+}
+
 // GeneratePurchaseOrder is the resolver for the generatePurchaseOrder field.
 func (r *mutationResolver) GeneratePurchaseOrder(ctx context.Context, input model.GeneratePurchaseOrderInput) (*model.GeneratePurchaseOrderResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Normalizar couponCode: si es un puntero a string vacío, convertir a nil
 	couponCode := input.CouponCode
 	if couponCode != nil && *couponCode == "" {
@@ -24,23 +63,25 @@ func (r *mutationResolver) GeneratePurchaseOrder(ctx context.Context, input mode
 	if couponCode != nil {
 		couponCodeLog = fmt.Sprintf("\"%s\"", *couponCode)
 	}
-	fmt.Printf("🔷 GraphQL Resolver - GeneratePurchaseOrder: rackId=%d, groupId=%d, couponCode=%s, email=%s, phone=%s, traceId=%s, gateway=%s\n",
-		input.RackIDReference, input.GroupID, couponCodeLog, input.UserEmail, input.UserPhone, input.TraceID, input.GatewayName)
+	slog.DebugContext(ctx, "🔷 GraphQL Resolver - GeneratePurchaseOrder", "rackId", input.RackIDReference, "groupId", input.GroupID, "couponCode", couponCodeLog, "email", domainModel.MaskEmail(input.UserEmail), "phone", domainModel.MaskCode(input.UserPhone), "gateway", input.GatewayName)
 
 	// Llamar al caso de uso
-	order, err := r.paymentInfraService.GeneratePurchaseOrder(ctx, input.RackIDReference, input.GroupID, couponCode, input.UserEmail, input.UserPhone, input.TraceID, input.GatewayName)
+	order, err := r.paymentInfraService.GeneratePurchaseOrder(ctx, input.RackIDReference, input.GroupID, couponCode, input.UserEmail, input.UserPhone, traceID, input.GatewayName)
 	if err != nil {
-		fmt.Printf("❌ GraphQL Resolver - GeneratePurchaseOrder failed: %v\n", err)
+		slog.ErrorContext(ctx, "❌ GraphQL Resolver - GeneratePurchaseOrder failed", "error", err)
 		return nil, fmt.Errorf("failed to generate purchase order: %w", err)
 	}
 
-	fmt.Printf("✅ GraphQL Resolver - GeneratePurchaseOrder succeeded\n")
+	slog.InfoContext(ctx, "✅ GraphQL Resolver - GeneratePurchaseOrder succeeded")
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToPurchaseOrderResponse(order), nil
+	return r.mapper.ToPurchaseOrderResponse(order, traceID), nil
 }
 
 // GenerateBooking is the resolver for the generateBooking field.
 func (r *mutationResolver) GenerateBooking(ctx context.Context, input model.GenerateBookingInput) (*model.GenerateBookingResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Normalizar couponCode: si es un puntero a string vacío, convertir a nil
 	couponCode := input.CouponCode
 	if couponCode != nil && *couponCode == "" {
@@ -48,107 +89,295 @@ func (r *mutationResolver) GenerateBooking(ctx context.Context, input model.Gene
 	}
 
 	// Llamar al caso de uso
-	booking, err := r.paymentInfraService.GenerateBooking(ctx, input.RackIDReference, input.GroupID, couponCode, input.UserEmail, input.UserPhone, input.TraceID)
+	booking, err := r.paymentInfraService.GenerateBooking(ctx, input.RackIDReference, input.GroupID, couponCode, input.UserEmail, input.UserPhone, traceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate booking: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToBookingResponse(booking), nil
+	return r.mapper.ToBookingResponse(booking, traceID), nil
 }
 
 // ExecuteOpen is the resolver for the executeOpen field.
 func (r *mutationResolver) ExecuteOpen(ctx context.Context, input model.ExecuteOpenInput) (*model.ExecuteOpenResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Log de entrada
-	fmt.Printf("🔷 GraphQL Resolver - ExecuteOpen REQUEST: serviceName=%s, currentCode=%s\n",
-		input.ServiceName, input.CurrentCode)
+	slog.DebugContext(ctx, "🔷 GraphQL Resolver - ExecuteOpen REQUEST", "serviceName", input.ServiceName, "currentCode", input.CurrentCode)
 
 	// Llamar al caso de uso
 	openResult, err := r.paymentInfraService.ExecuteOpen(ctx, input.ServiceName, input.CurrentCode)
 	if err != nil {
-		fmt.Printf("❌ GraphQL Resolver - ExecuteOpen FAILED: %v\n", err)
+		slog.ErrorContext(ctx, "❌ GraphQL Resolver - ExecuteOpen FAILED", "error", err)
 		return nil, fmt.Errorf("failed to execute open: %w", err)
 	}
 
 	// Log de la respuesta del dominio (antes del mapeo)
-	fmt.Printf("📦 GraphQL Resolver - ExecuteOpen DOMAIN RESPONSE: transactionId=%s, status=%v, openStatus=%v, message=%s\n",
-		openResult.TransactionID, openResult.Status, openResult.OpenStatus, openResult.Message)
+	slog.DebugContext(ctx, "📦 GraphQL Resolver - ExecuteOpen DOMAIN RESPONSE", "transactionId", openResult.TransactionID, "status", openResult.Status, "openStatus", openResult.OpenStatus, "message", openResult.Message)
 
 	// Mapear a respuesta GraphQL
-	graphQLResponse := r.mapper.ToExecuteOpenResponse(openResult)
+	graphQLResponse := r.mapper.ToExecuteOpenResponse(openResult, traceID)
 
 	// Log de la respuesta final que se enviará al frontend
-	fmt.Printf("📦 GraphQL Resolver - ExecuteOpen GRAPHQL RESPONSE TO FRONTEND: transactionId=%s, status=%v, openStatus=%v, message=%s\n",
-		graphQLResponse.TransactionID, graphQLResponse.Status, graphQLResponse.OpenStatus, graphQLResponse.Message)
+	slog.DebugContext(ctx, "📦 GraphQL Resolver - ExecuteOpen GRAPHQL RESPONSE TO FRONTEND", "transactionId", graphQLResponse.TransactionID, "status", graphQLResponse.Status, "openStatus", graphQLResponse.OpenStatus, "message", graphQLResponse.Message)
 
 	return graphQLResponse, nil
 }
 
+// CancelBooking is the resolver for the cancelBooking field.
+func (r *mutationResolver) CancelBooking(ctx context.Context, input model.CancelBookingInput) (*model.CancelBookingResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
+	// Llamar al caso de uso
+	cancellation, err := r.paymentInfraService.CancelBooking(ctx, r.mapper.ToBookingCancellationRequest(input, traceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel booking: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToCancelBookingResponse(cancellation, traceID), nil
+}
+
+// ExtendBooking is the resolver for the extendBooking field.
+func (r *mutationResolver) ExtendBooking(ctx context.Context, input model.ExtendBookingInput) (*model.ExtendBookingResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
+	// Resolver la zona horaria de presentación
+	location, err := r.mapper.ToLocation(input.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Llamar al caso de uso
+	extension, err := r.bookingExtensionService.ExtendBooking(ctx, r.mapper.ToBookingExtensionRequest(input, traceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to extend booking: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToExtendBookingResponse(extension, location, traceID), nil
+}
+
+// InvalidatePaymentInfraCache is the resolver for the invalidatePaymentInfraCache field.
+func (r *mutationResolver) InvalidatePaymentInfraCache(ctx context.Context, qrValue *string) (int, error) {
+	// Llamar al caso de uso
+	removed, err := r.paymentInfraService.InvalidatePaymentInfraCache(ctx, qrValue)
+	if err != nil {
+		return 0, fmt.Errorf("failed to invalidate payment infra cache: %w", err)
+	}
+
+	return removed, nil
+}
+
+// AvailableGroups is the resolver for the availableGroups field.
+func (r *paymentBookingTimeResolver) AvailableGroups(ctx context.Context, obj *model.PaymentBookingTime) ([]*model.AvailablePaymentGroup, error) {
+	return r.availableGroups(ctx, obj.RackID, obj.ID, obj.TraceID)
+}
+
+// AvailableGroups is the resolver for the availableGroups field.
+func (r *paymentRackResolver) AvailableGroups(ctx context.Context, obj *model.PaymentRack, bookingTimeID int) ([]*model.AvailablePaymentGroup, error) {
+	return r.availableGroups(ctx, obj.ID, bookingTimeID, obj.TraceID)
+}
+
 // GetPaymentInfraByQRValue is the resolver for the getPaymentInfraByQrValue field.
 func (r *queryResolver) GetPaymentInfraByQRValue(ctx context.Context, input model.GetPaymentInfraByQRValueInput) (*model.PaymentInfraResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Llamar al caso de uso
-	paymentInfra, err := r.paymentInfraService.GetPaymentInfraByQrValue(ctx, input.QRValue)
+	paymentInfra, err := r.loaders(ctx).PaymentInfra.Load(ctx, dataloader.PaymentInfraKey{
+		QRValue: input.QRValue,
+		Locale:  i18n.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get payment infrastructure: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToGraphQLResponse(paymentInfra), nil
+	return r.mapper.ToGraphQLResponse(paymentInfra, traceID), nil
 }
 
 // GetAvailableLockersByRackIDAndBookingTime is the resolver for the getAvailableLockersByRackIDAndBookingTime field.
 func (r *queryResolver) GetAvailableLockersByRackIDAndBookingTime(ctx context.Context, input model.GetAvailableLockersByRackIDAndBookingTimeInput) (*model.AvailableLockersByRackIDAndBookingTimeResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Llamar al caso de uso
-	lockers, err := r.paymentInfraService.GetAvailableLockers(ctx, input.PaymentRackID, input.BookingTimeID, input.TraceID)
+	lockers, err := r.paymentInfraService.GetAvailableLockers(ctx, input.PaymentRackID, input.BookingTimeID, traceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get available lockers: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToAvailableLockersByRackIDAndBookingTimeResponse(lockers), nil
+	return r.mapper.ToAvailableLockersByRackIDAndBookingTimeResponse(lockers, input.PaymentRackID, traceID), nil
 }
 
 // ValidateDiscountCoupon is the resolver for the validateDiscountCoupon field.
 func (r *queryResolver) ValidateDiscountCoupon(ctx context.Context, input model.ValidateDiscountCouponInput) (*model.ValidateDiscountCouponResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Llamar al caso de uso
-	validation, err := r.paymentInfraService.ValidateDiscountCoupon(ctx, input.CouponCode, input.RackID, input.TraceID)
+	validation, err := r.paymentInfraService.ValidateDiscountCoupon(ctx, input.CouponCode, input.RackID, traceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate discount coupon: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToValidateCouponResponse(validation), nil
+	return r.mapper.ToValidateCouponResponse(validation, traceID), nil
 }
 
 // GetPurchaseOrderByPo is the resolver for the getPurchaseOrderByPo field.
 func (r *queryResolver) GetPurchaseOrderByPo(ctx context.Context, input model.GetPurchaseOrderByPoInput) (*model.PurchaseOrderResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
 	// Llamar al caso de uso
-	orderData, err := r.paymentInfraService.GetPurchaseOrderByPo(ctx, input.PurchaseOrder, input.TraceID)
+	orderData, err := r.paymentInfraService.GetPurchaseOrderByPo(ctx, input.PurchaseOrder, traceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get purchase order: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToPurchaseOrderDataResponse(orderData), nil
+	return r.mapper.ToPurchaseOrderDataResponse(orderData, traceID), nil
 }
 
 // CheckBookingStatus is the resolver for the checkBookingStatus field.
 func (r *queryResolver) CheckBookingStatus(ctx context.Context, input model.CheckBookingStatusInput) (*model.CheckBookingStatusResponse, error) {
+	// Resolver el traceId de la solicitud
+	ctx, traceID := tracing.Ensure(ctx, input.TraceID)
+
+	// Resolver la zona horaria de presentación
+	location, err := r.mapper.ToLocation(input.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
 	// Llamar al caso de uso
-	bookingStatus, err := r.paymentInfraService.CheckBookingStatus(ctx, input.ServiceName, input.CurrentCode)
+	bookingStatus, err := r.loaders(ctx).BookingStatus.Load(ctx, dataloader.BookingKey{
+		ServiceName: input.ServiceName,
+		CurrentCode: input.CurrentCode,
+		Locale:      i18n.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to check booking status: %w", err)
 	}
 
 	// Mapear a respuesta GraphQL
-	return r.mapper.ToBookingStatusResponse(bookingStatus), nil
+	return r.mapper.ToBookingStatusResponse(bookingStatus, location, traceID), nil
+}
+
+// AvailablePaymentGateways is the resolver for the availablePaymentGateways field.
+func (r *queryResolver) AvailablePaymentGateways(ctx context.Context, rackID int) ([]*model.PaymentGateway, error) {
+	// Llamar al caso de uso
+	gateways, err := r.paymentInfraService.AvailablePaymentGateways(ctx, rackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available payment gateways: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToPaymentGateways(gateways), nil
+}
+
+// LockerOpenAudit is the resolver for the lockerOpenAudit field.
+func (r *queryResolver) LockerOpenAudit(ctx context.Context, serviceName string, from time.Time, to time.Time, outcome *model.LockerOpenOutcome, limit *int, offset *int) (*model.LockerOpenAuditPage, error) {
+	// Llamar al caso de uso
+	page, err := r.lockerOpenAuditService.QueryLockerOpenAudit(ctx, r.mapper.ToLockerOpenAuditFilter(serviceName, from, to, outcome, limit, offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query locker open audit: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToLockerOpenAuditPage(page), nil
+}
+
+// Tenant is the resolver for the tenant field.
+func (r *queryResolver) Tenant(ctx context.Context, serviceName *string) (*model.Tenant, error) {
+	// Llamar al caso de uso
+	tenant, err := r.tenantService.CurrentTenant(ctx, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToTenant(tenant), nil
+}
+
+// FeatureFlags is the resolver for the featureFlags field.
+func (r *queryResolver) FeatureFlags(ctx context.Context, rackID *int, installationID *int, key *string) ([]*model.FeatureFlag, error) {
+	// Llamar al caso de uso
+	flags, err := r.featureFlagService.FeatureFlags(ctx, r.mapper.ToFeatureFlagSubject(rackID, installationID, key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature flags: %w", err)
+	}
+
+	// Mapear a respuesta GraphQL
+	return r.mapper.ToFeatureFlags(flags), nil
+}
+
+// PurchaseOrderStatus is the resolver for the purchaseOrderStatus field.
+func (r *subscriptionResolver) PurchaseOrderStatus(ctx context.Context, purchaseOrder string, traceID *string, locale *string) (<-chan *model.PurchaseOrderData, error) {
+	// Resolver el traceId de la suscripción
+	ctx, resolvedTraceID := tracing.Ensure(ctx, traceID)
+	ctx, _ = i18n.Resolve(ctx, locale)
+	slog.DebugContext(ctx, "🔷 GraphQL Resolver - PurchaseOrderStatus SUBSCRIBE", "purchaseOrder", purchaseOrder)
+
+	// Llamar al caso de uso
+	updates, err := r.purchaseOrderWatchService.WatchPurchaseOrderStatus(ctx, purchaseOrder, resolvedTraceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch purchase order status: %w", err)
+	}
+
+	// Mapear cada actualización a GraphQL; el canal se cierra tras el estado terminal
+	out := make(chan *model.PurchaseOrderData, 1)
+	go func() {
+		defer close(out)
+		for orderData := range updates {
+			select {
+			case out <- r.mapper.ToPurchaseOrderData(orderData):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// AvailablePaymentGroup returns generated.AvailablePaymentGroupResolver implementation.
+func (r *Resolver) AvailablePaymentGroup() generated.AvailablePaymentGroupResolver {
+	return &availablePaymentGroupResolver{r}
+}
+
+// GenerateBookingResponse returns generated.GenerateBookingResponseResolver implementation.
+func (r *Resolver) GenerateBookingResponse() generated.GenerateBookingResponseResolver {
+	return &generateBookingResponseResolver{r}
 }
 
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// PaymentBookingTime returns generated.PaymentBookingTimeResolver implementation.
+func (r *Resolver) PaymentBookingTime() generated.PaymentBookingTimeResolver {
+	return &paymentBookingTimeResolver{r}
+}
+
+// PaymentRack returns generated.PaymentRackResolver implementation.
+func (r *Resolver) PaymentRack() generated.PaymentRackResolver { return &paymentRackResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type availablePaymentGroupResolver struct{ *Resolver }
+type generateBookingResponseResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type paymentBookingTimeResolver struct{ *Resolver }
+type paymentRackResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
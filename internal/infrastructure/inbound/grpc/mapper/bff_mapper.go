@@ -0,0 +1,250 @@
+package mapper
+
+import (
+	bffv1 "bff-graphql-payment/gen/go/proto/bff/v1"
+	"bff-graphql-payment/internal/domain/model"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BffGRPCMapper maneja el mapeo entre modelos de dominio y mensajes del servicio gRPC del BFF
+type BffGRPCMapper struct{}
+
+// NewBffGRPCMapper crea una nueva instancia del mapper
+func NewBffGRPCMapper() *BffGRPCMapper {
+	return &BffGRPCMapper{}
+}
+
+// ToGetPaymentInfraByQrValueResponse mapea la infraestructura de pagos de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToGetPaymentInfraByQrValueResponse(paymentInfra *model.PaymentInfra, traceID string) *bffv1.GetPaymentInfraByQrValueResponse {
+	response := &bffv1.GetPaymentInfraByQrValueResponse{
+		Meta: m.toMeta(paymentInfra.TransactionID, paymentInfra.Message, paymentInfra.Detail, paymentInfra.Status, traceID),
+	}
+
+	if paymentInfra.PaymentRack != nil {
+		response.PaymentRack = &bffv1.PaymentRack{
+			Id:          int32(paymentInfra.PaymentRack.ID),
+			Description: paymentInfra.PaymentRack.Description,
+			Address:     paymentInfra.PaymentRack.Address,
+		}
+	}
+
+	if paymentInfra.Installation != nil {
+		response.Installation = &bffv1.PaymentInstallation{
+			Id:       int32(paymentInfra.Installation.ID),
+			Name:     paymentInfra.Installation.Name,
+			Region:   paymentInfra.Installation.Region,
+			City:     paymentInfra.Installation.City,
+			Address:  paymentInfra.Installation.Address,
+			ImageUrl: paymentInfra.Installation.ImageURL,
+		}
+	}
+
+	for _, bookingTime := range paymentInfra.BookingTimes {
+		response.BookingTimes = append(response.BookingTimes, &bffv1.BookingTime{
+			Id:              int32(bookingTime.ID),
+			Name:            bookingTime.Name,
+			UnitMeasurement: m.mapUnitMeasurement(bookingTime.UnitMeasurement),
+			Amount:          int32(bookingTime.Amount),
+		})
+	}
+
+	return response
+}
+
+// ToGetAvailableLockersResponse mapea los lockers disponibles de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToGetAvailableLockersResponse(lockers *model.AvailableLockers, traceID string) *bffv1.GetAvailableLockersResponse {
+	response := &bffv1.GetAvailableLockersResponse{
+		Meta: m.toMeta(lockers.TransactionID, lockers.Message, lockers.Detail, lockers.Status, traceID),
+	}
+
+	for _, group := range lockers.AvailableGroups {
+		response.AvailableGroups = append(response.AvailableGroups, &bffv1.AvailableGroup{
+			GroupId:     int32(group.GroupID),
+			Name:        group.Name,
+			Price:       group.Price,
+			Description: group.Description,
+			ImageUrl:    group.ImageURL,
+		})
+	}
+
+	return response
+}
+
+// ToValidateDiscountCouponResponse mapea la validación de cupón de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToValidateDiscountCouponResponse(validation *model.DiscountCouponValidation, traceID string) *bffv1.ValidateDiscountCouponResponse {
+	return &bffv1.ValidateDiscountCouponResponse{
+		Meta:               m.toMeta(validation.TransactionID, validation.Message, validation.Detail, validation.Status, traceID),
+		DiscountPercentage: validation.DiscountPercentage,
+	}
+}
+
+// ToGeneratePurchaseOrderResponse mapea la orden de compra de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToGeneratePurchaseOrderResponse(order *model.PurchaseOrder, traceID string) *bffv1.GeneratePurchaseOrderResponse {
+	return &bffv1.GeneratePurchaseOrderResponse{
+		Meta: m.toMeta(order.TransactionID, order.Message, order.Detail, order.Status, traceID),
+		Url:  order.URL,
+	}
+}
+
+// ToGetPurchaseOrderByPoResponse mapea los datos de la orden de compra de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToGetPurchaseOrderByPoResponse(order *model.PurchaseOrderData, traceID string) *bffv1.GetPurchaseOrderByPoResponse {
+	return &bffv1.GetPurchaseOrderByPoResponse{
+		Meta: m.toMeta(order.TransactionID, order.Message, order.Detail, order.Status, traceID),
+		PurchaseOrder: &bffv1.PurchaseOrder{
+			CouponId:           int32(order.CouponID),
+			BookingReference:   int32(order.BookingReference),
+			Oc:                 order.OC,
+			Email:              order.Email,
+			Phone:              order.Phone,
+			Discount:           int32(order.Discount),
+			ProductPrice:       int32(order.ProductPrice),
+			FinalProductPrice:  order.FinalProductPrice,
+			ProductName:        order.ProductName,
+			ProductDescription: order.ProductDescription,
+			LockerPosition:     int32(order.LockerPosition),
+			InstallationName:   order.InstallationName,
+			DeviceSerieNum:     order.DeviceSerieNum,
+			OrderStatus:        m.mapPurchaseOrderStatus(order.OrderStatus),
+		},
+	}
+}
+
+// ToCheckBookingStatusResponse mapea el estado de la reserva de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToCheckBookingStatusResponse(bookingStatus *model.BookingStatusCheck, traceID string) *bffv1.CheckBookingStatusResponse {
+	response := &bffv1.CheckBookingStatusResponse{
+		Meta: m.toMeta(bookingStatus.TransactionID, bookingStatus.Message, bookingStatus.Detail, bookingStatus.Status, traceID),
+	}
+
+	if booking := bookingStatus.Booking; booking != nil {
+		now := time.Now()
+		response.Booking = &bffv1.Booking{
+			Id:                     int32(booking.ID),
+			ConfigurationBookingId: int32(booking.ConfigurationBookingID),
+			InitBooking:            m.toTimestamp(booking.InitBooking),
+			FinishBooking:          m.toTimestamp(booking.FinishBooking),
+			InstallationName:       booking.InstallationName,
+			NumberLocker:           int32(booking.NumberLocker),
+			DeviceId:               booking.DeviceID,
+			CurrentCode:            booking.CurrentCode,
+			Openings:               int32(booking.Openings),
+			ServiceName:            booking.ServiceName,
+			EmailRecipient:         booking.EmailRecipient,
+			CreatedAt:              m.toTimestamp(booking.CreatedAt),
+			UpdatedAt:              m.toTimestamp(booking.UpdatedAt),
+			State:                  m.mapBookingState(booking.State(now)),
+			RemainingSeconds:       int64(booking.RemainingTime(now).Seconds()),
+			CanOpen:                booking.CanOpen(now),
+		}
+	}
+
+	return response
+}
+
+// ToExecuteOpenResponse mapea el resultado de apertura de dominio a respuesta gRPC
+func (m *BffGRPCMapper) ToExecuteOpenResponse(result *model.ExecuteOpenResult, traceID string) *bffv1.ExecuteOpenResponse {
+	return &bffv1.ExecuteOpenResponse{
+		Meta:       m.toMeta(result.TransactionID, result.Message, result.Detail, result.Status, traceID),
+		OpenStatus: m.mapOpenStatus(result.OpenStatus),
+	}
+}
+
+// toMeta construye los datos de la transacción comunes a todas las respuestas
+func (m *BffGRPCMapper) toMeta(transactionID string, message string, detail string, status model.ResponseStatus, traceID string) *bffv1.ResponseMeta {
+	return &bffv1.ResponseMeta{
+		TransactionId: transactionID,
+		Message:       message,
+		Status:        m.mapResponseStatus(status),
+		TraceId:       traceID,
+		Detail:        detail,
+	}
+}
+
+// toTimestamp convierte una fecha de dominio; las fechas no informadas se omiten
+func (m *BffGRPCMapper) toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// mapResponseStatus convierte el estado de respuesta de dominio a gRPC
+func (m *BffGRPCMapper) mapResponseStatus(status model.ResponseStatus) bffv1.ResponseStatus {
+	switch status {
+	case model.ResponseStatusOK:
+		return bffv1.ResponseStatus_RESPONSE_STATUS_OK
+	case model.ResponseStatusError:
+		return bffv1.ResponseStatus_RESPONSE_STATUS_ERROR
+	default:
+		return bffv1.ResponseStatus_RESPONSE_STATUS_UNSPECIFIED
+	}
+}
+
+// mapUnitMeasurement convierte la unidad de medida de dominio a gRPC
+func (m *BffGRPCMapper) mapUnitMeasurement(unit model.UnitMeasurement) bffv1.UnitMeasurement {
+	switch unit {
+	case model.UnitMeasurementHour:
+		return bffv1.UnitMeasurement_UNIT_MEASUREMENT_HOUR
+	case model.UnitMeasurementDay:
+		return bffv1.UnitMeasurement_UNIT_MEASUREMENT_DAY
+	case model.UnitMeasurementWeek:
+		return bffv1.UnitMeasurement_UNIT_MEASUREMENT_WEEK
+	case model.UnitMeasurementMonth:
+		return bffv1.UnitMeasurement_UNIT_MEASUREMENT_MONTH
+	default:
+		return bffv1.UnitMeasurement_UNIT_MEASUREMENT_UNSPECIFIED
+	}
+}
+
+// mapPurchaseOrderStatus convierte el estado de la orden de compra de dominio a gRPC
+func (m *BffGRPCMapper) mapPurchaseOrderStatus(status model.PurchaseOrderStatus) bffv1.PurchaseOrderStatus {
+	switch status {
+	case model.PurchaseOrderStatusPending:
+		return bffv1.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_PENDING
+	case model.PurchaseOrderStatusPaid:
+		return bffv1.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_PAID
+	case model.PurchaseOrderStatusRejected:
+		return bffv1.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_REJECTED
+	case model.PurchaseOrderStatusExpired:
+		return bffv1.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_EXPIRED
+	default:
+		return bffv1.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_UNSPECIFIED
+	}
+}
+
+// mapBookingState convierte el estado de reserva de dominio a gRPC
+func (m *BffGRPCMapper) mapBookingState(state model.BookingState) bffv1.BookingState {
+	switch state {
+	case model.BookingStatePendingPayment:
+		return bffv1.BookingState_BOOKING_STATE_PENDING_PAYMENT
+	case model.BookingStateActive:
+		return bffv1.BookingState_BOOKING_STATE_ACTIVE
+	case model.BookingStateExpired:
+		return bffv1.BookingState_BOOKING_STATE_EXPIRED
+	case model.BookingStateCancelled:
+		return bffv1.BookingState_BOOKING_STATE_CANCELLED
+	case model.BookingStateCompleted:
+		return bffv1.BookingState_BOOKING_STATE_COMPLETED
+	default:
+		return bffv1.BookingState_BOOKING_STATE_UNSPECIFIED
+	}
+}
+
+// mapOpenStatus convierte el estado de apertura de dominio a gRPC
+func (m *BffGRPCMapper) mapOpenStatus(status model.OpenStatus) bffv1.OpenStatus {
+	switch status {
+	case model.OpenStatusReceived:
+		return bffv1.OpenStatus_OPEN_STATUS_RECEIVED
+	case model.OpenStatusRequested:
+		return bffv1.OpenStatus_OPEN_STATUS_REQUESTED
+	case model.OpenStatusExecuted:
+		return bffv1.OpenStatus_OPEN_STATUS_EXECUTED
+	case model.OpenStatusError:
+		return bffv1.OpenStatus_OPEN_STATUS_ERROR
+	case model.OpenStatusSuccess:
+		return bffv1.OpenStatus_OPEN_STATUS_SUCCESS
+	default:
+		return bffv1.OpenStatus_OPEN_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// healthServicePrefix identifica los métodos del servicio de salud, que no exigen credenciales
+// para que los probes del orquestador puedan consultarlo
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+type principalKey struct{}
+
+// AuthUnaryInterceptor autentica al servicio que llama: por el certificado de cliente verificado
+// (mTLS) o por el token de la metadata authorization ("Bearer <token>"). La identidad
+// autenticada es el principal de la llamada; tokens asocia cada servicio con su token.
+func AuthUnaryInterceptor(tokens map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		principal, ok := certificatePrincipal(ctx)
+		if !ok {
+			principal, ok = tokenPrincipal(ctx, tokens)
+		}
+		if !ok {
+			slog.WarnContext(ctx, "🚫 gRPC call rejected: unauthenticated", "method", info.FullMethod, "peer", peerIP(ctx))
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid credentials")
+		}
+
+		return handler(context.WithValue(ctx, principalKey{}, principal), req)
+	}
+}
+
+// authenticatedPrincipal devuelve la identidad que autenticó AuthUnaryInterceptor, o ""
+func authenticatedPrincipal(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
+
+// certificatePrincipal devuelve el Common Name del certificado de cliente, si la conexión lo
+// presentó y quedó verificado contra la CA configurada
+func certificatePrincipal(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	commonName := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	return commonName, commonName != ""
+}
+
+// tokenPrincipal devuelve el servicio dueño del token presentado. Se comparan todos los tokens
+// en tiempo constante para no revelar cuál coincidió.
+func tokenPrincipal(ctx context.Context, tokens map[string]string) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token, ok := strings.CutPrefix(firstMetadataValue(md, "authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+
+	var principal string
+	for service, expected := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+			principal = service
+		}
+	}
+	return principal, principal != ""
+}
+
+// LoadTLSConfig carga el certificado del servidor y, con clientCAFile, exige y verifica el
+// certificado de cliente de cada conexión
+func LoadTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gRPC server certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gRPC client CA: %w", err)
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("failed to parse gRPC client CA: no certificates found")
+		}
+
+		config.ClientCAs = clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	bffv1 "bff-graphql-payment/gen/go/proto/bff/v1"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/ports"
+	"bff-graphql-payment/internal/infrastructure/inbound/grpc/mapper"
+	"context"
+)
+
+// BffGRPCServer implementa bff.v1.BffService delegando en los mismos casos de uso que GraphQL
+type BffGRPCServer struct {
+	bffv1.UnimplementedBffServiceServer
+
+	service ports.PaymentInfraService
+	mapper  *mapper.BffGRPCMapper
+}
+
+// NewBffGRPCServer crea la implementación del servicio gRPC del BFF
+func NewBffGRPCServer(service ports.PaymentInfraService) *BffGRPCServer {
+	return &BffGRPCServer{
+		service: service,
+		mapper:  mapper.NewBffGRPCMapper(),
+	}
+}
+
+// GetPaymentInfraByQrValue obtiene la infraestructura de pagos por valor QR
+func (s *BffGRPCServer) GetPaymentInfraByQrValue(ctx context.Context, req *bffv1.GetPaymentInfraByQrValueRequest) (*bffv1.GetPaymentInfraByQrValueResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	paymentInfra, err := s.service.GetPaymentInfraByQrValue(ctx, req.GetQrValue())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToGetPaymentInfraByQrValueResponse(paymentInfra, traceID), nil
+}
+
+// GetAvailableLockers obtiene los lockers disponibles por rack y tiempo de reserva
+func (s *BffGRPCServer) GetAvailableLockers(ctx context.Context, req *bffv1.GetAvailableLockersRequest) (*bffv1.GetAvailableLockersResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	lockers, err := s.service.GetAvailableLockers(ctx, int(req.GetPaymentRackId()), int(req.GetBookingTimeId()), traceID)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToGetAvailableLockersResponse(lockers, traceID), nil
+}
+
+// ValidateDiscountCoupon valida un cupón de descuento para un rack
+func (s *BffGRPCServer) ValidateDiscountCoupon(ctx context.Context, req *bffv1.ValidateDiscountCouponRequest) (*bffv1.ValidateDiscountCouponResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	validation, err := s.service.ValidateDiscountCoupon(ctx, req.GetCouponCode(), int(req.GetRackId()), traceID)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToValidateDiscountCouponResponse(validation, traceID), nil
+}
+
+// GeneratePurchaseOrder genera una orden de compra
+func (s *BffGRPCServer) GeneratePurchaseOrder(ctx context.Context, req *bffv1.GeneratePurchaseOrderRequest) (*bffv1.GeneratePurchaseOrderResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	order, err := s.service.GeneratePurchaseOrder(ctx, int(req.GetRackIdReference()), int(req.GetGroupId()), req.CouponCode,
+		req.GetUserEmail(), req.GetUserPhone(), traceID, req.GetGatewayName())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToGeneratePurchaseOrderResponse(order, traceID), nil
+}
+
+// GetPurchaseOrderByPo obtiene una orden de compra por su número
+func (s *BffGRPCServer) GetPurchaseOrderByPo(ctx context.Context, req *bffv1.GetPurchaseOrderByPoRequest) (*bffv1.GetPurchaseOrderByPoResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	order, err := s.service.GetPurchaseOrderByPo(ctx, req.GetPurchaseOrder(), traceID)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToGetPurchaseOrderByPoResponse(order, traceID), nil
+}
+
+// CheckBookingStatus verifica el estado de una reserva
+func (s *BffGRPCServer) CheckBookingStatus(ctx context.Context, req *bffv1.CheckBookingStatusRequest) (*bffv1.CheckBookingStatusResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	bookingStatus, err := s.service.CheckBookingStatus(ctx, req.GetServiceName(), req.GetCurrentCode())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToCheckBookingStatusResponse(bookingStatus, traceID), nil
+}
+
+// ExecuteOpen ejecuta la apertura del locker de una reserva
+func (s *BffGRPCServer) ExecuteOpen(ctx context.Context, req *bffv1.ExecuteOpenRequest) (*bffv1.ExecuteOpenResponse, error) {
+	ctx, traceID := tracing.Ensure(ctx, nil)
+
+	// Llamar al caso de uso
+	result, err := s.service.ExecuteOpen(ctx, req.GetServiceName(), req.GetCurrentCode())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// Mapear a respuesta gRPC
+	return s.mapper.ToExecuteOpenResponse(result, traceID), nil
+}
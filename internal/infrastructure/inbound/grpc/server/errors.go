@@ -0,0 +1,102 @@
+package server
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/domain/exception"
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifica al BFF en el google.rpc.ErrorInfo de los errores de dominio
+const errorDomain = "bff-graphql-payment"
+
+// invalidArgumentErrors son los errores de validación de entrada del caso de uso
+var invalidArgumentErrors = []error{
+	exception.ErrInvalidPaymentRackID,
+	exception.ErrInvalidBookingTimeID,
+	exception.ErrInvalidCouponCode,
+	exception.ErrInvalidCoupon,
+	exception.ErrInvalidGroupID,
+	exception.ErrInvalidEmail,
+	exception.ErrInvalidPhone,
+	exception.ErrInvalidTraceID,
+	exception.ErrInvalidGatewayName,
+	exception.ErrInvalidPurchaseOrder,
+	exception.ErrInvalidBookingReference,
+	exception.ErrInvalidServiceName,
+	exception.ErrInvalidCurrentCode,
+	exception.ErrUnknownTenant,
+	exception.ErrQRMalformed,
+	exception.ErrQRSignatureInvalid,
+	exception.ErrQRKeyUnknown,
+	exception.ErrQRExpired,
+	exception.ErrQRUnsigned,
+}
+
+// notFoundErrors son los errores que indican que el recurso solicitado no existe
+var notFoundErrors = []error{
+	exception.ErrPaymentRackNotFound,
+	exception.ErrNoLockersAvailable,
+	exception.ErrCouponNotFound,
+	exception.ErrPurchaseOrderNotFound,
+	exception.ErrBookingNotFound,
+}
+
+// upstreamErrors son los errores que indican que el backend no completó la operación
+var upstreamErrors = []error{
+	exception.ErrPurchaseOrderFailed,
+	exception.ErrBookingGenerationFailed,
+	exception.ErrExecuteOpenFailed,
+	exception.ErrRefundFailed,
+}
+
+// toStatusError convierte un error del caso de uso en un status gRPC con el texto en el idioma
+// de la llamada. Los errores de dominio llevan su código en un google.rpc.ErrorInfo; los que no
+// son de validación, no encontrado ni del backend responden FAILED_PRECONDITION.
+func toStatusError(ctx context.Context, err error) error {
+	code := codes.Internal
+	switch {
+	case isAny(err, invalidArgumentErrors):
+		code = codes.InvalidArgument
+	case isAny(err, notFoundErrors):
+		code = codes.NotFound
+	case errors.Is(err, exception.ErrPaymentInfraServiceUnavailable):
+		code = codes.Unavailable
+	case isAny(err, upstreamErrors):
+		code = codes.Internal
+	case errors.Is(err, exception.ErrTenantMismatch):
+		code = codes.PermissionDenied
+	case errors.Is(err, exception.ErrAdminRequired):
+		code = codes.Unauthenticated
+	case errors.As(err, new(*exception.DomainError)):
+		code = codes.FailedPrecondition
+	}
+
+	reason, message, ok := i18n.Error(i18n.FromContext(ctx), err)
+	if !ok {
+		return status.Error(code, err.Error())
+	}
+
+	st, detailErr := status.New(code, message).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+	if detailErr != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// isAny indica si err corresponde a alguno de los errores indicados
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
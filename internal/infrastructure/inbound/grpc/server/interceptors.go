@@ -0,0 +1,156 @@
+package server
+
+import (
+	"bff-graphql-payment/internal/application/caller"
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/infrastructure/inbound/ratelimit"
+	"context"
+	"log/slog"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// traceIDMetadataKey es la clave de metadata con la que se recibe y se devuelve el traceId,
+// la misma con la que el BFF lo propaga a sus backends
+const traceIDMetadataKey = "x-trace-id"
+
+// traceIDCarrier lo implementan los mensajes de solicitud con campo trace_id
+type traceIDCarrier interface {
+	GetTraceId() string
+}
+
+// TraceUnaryInterceptor asigna el traceId de la llamada con las mismas reglas que el servidor HTTP:
+// el campo trace_id de la solicitud, la metadata x-trace-id, un traceparent (W3C) o uno generado.
+// Lo guarda en el contexto y lo devuelve en la metadata de respuesta.
+func TraceUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	explicit := firstMetadataValue(md, traceIDMetadataKey)
+	if carrier, ok := req.(traceIDCarrier); ok && strings.TrimSpace(carrier.GetTraceId()) != "" {
+		explicit = carrier.GetTraceId()
+	}
+
+	traceID := tracing.Resolve(explicit, firstMetadataValue(md, "traceparent"))
+	grpc.SetHeader(ctx, metadata.Pairs(traceIDMetadataKey, traceID))
+
+	return handler(tracing.WithTraceID(ctx, traceID), req)
+}
+
+// CallerUnaryInterceptor guarda en el contexto la identidad del servicio autenticado por
+// AuthUnaryInterceptor, la IP del peer y su user agent, igual que el middleware HTTP Caller.
+// La metadata x-principal se ignora: la identidad solo la prueban las credenciales.
+func CallerUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return handler(caller.WithInfo(ctx, caller.Info{
+		Principal:  authenticatedPrincipal(ctx),
+		ClientIP:   peerIP(ctx),
+		UserAgent:  firstMetadataValue(md, "user-agent"),
+		AdminToken: firstMetadataValue(md, strings.ToLower(caller.AdminTokenKey)),
+	}), req)
+}
+
+// LocaleUnaryInterceptor guarda en el contexto el idioma de los mensajes elegido de la metadata
+// accept-language, igual que el middleware HTTP Locale
+func LocaleUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if locale, ok := i18n.ParseAcceptLanguage(firstMetadataValue(md, "accept-language")); ok {
+		ctx = i18n.WithLocale(ctx, locale)
+	}
+	return handler(ctx, req)
+}
+
+// TenantUnaryInterceptor guarda en el contexto el tenant indicado por la metadata x-tenant-id o,
+// sin ella, por el hostname (:authority), igual que el middleware HTTP Tenant
+func TenantUnaryInterceptor(tenants tenancy.RequestResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		tenant, err := tenants.ResolveRequest(firstMetadataValue(md, strings.ToLower(tenancy.Header)), firstMetadataValue(md, ":authority"))
+		if err != nil {
+			return nil, toStatusError(ctx, err)
+		}
+
+		if tenant != nil {
+			ctx = tenancy.WithTenant(ctx, tenant)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// LoggingUnaryInterceptor registra cada llamada con su resultado y duración
+func LoggingUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	if st := status.Convert(err); st.Code() == codes.OK {
+		slog.InfoContext(ctx, "📡 gRPC call completed", "method", info.FullMethod, "duration", time.Since(start))
+	} else {
+		slog.ErrorContext(ctx, "❌ gRPC call failed", "method", info.FullMethod, "duration", time.Since(start), "code", st.Code().String(), "error", st.Message())
+	}
+
+	return resp, err
+}
+
+// MetricsUnaryInterceptor registra llamadas, errores y duración en el mismo recorder que el resto del BFF
+func MetricsUnaryInterceptor(recorder ports.MetricsRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		method := path.Base(info.FullMethod)
+		recorder.IncCounter("grpc_server_requests_total")
+		recorder.IncCounter("grpc_server_requests_" + method)
+		if err != nil {
+			recorder.IncCounter("grpc_server_errors_total")
+			recorder.IncCounter("grpc_server_errors_" + strings.ToLower(status.Code(err).String()))
+		}
+		recorder.ObserveDuration("grpc_server_request_duration_"+method, time.Since(start))
+
+		return resp, err
+	}
+}
+
+// RateLimitUnaryInterceptor aplica el limitador por tenant y cliente del servidor HTTP usando la IP del peer
+func RateLimitUnaryInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !limiter.Allow(tenancy.ID(ctx), peerIP(ctx)) {
+			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// firstMetadataValue devuelve el primer valor de una clave de metadata, o "" si no está
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// peerIP obtiene la IP del cliente de la llamada
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
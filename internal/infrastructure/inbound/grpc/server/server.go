@@ -0,0 +1,59 @@
+package server
+
+import (
+	bffv1 "bff-graphql-payment/gen/go/proto/bff/v1"
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/infrastructure/inbound/ratelimit"
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Options contiene la seguridad del servidor gRPC
+type Options struct {
+	// Tokens asocia cada servicio con el token que presenta en la metadata authorization
+	Tokens map[string]string
+	// TLS habilita TLS (y mTLS si exige certificados de cliente); nil acepta texto plano
+	TLS *tls.Config
+	// Reflection expone la reflexión del servidor; solo para desarrollo
+	Reflection bool
+}
+
+// NewServer crea el servidor gRPC del BFF con bff.v1.BffService y el servicio de salud
+// (grpc.health.v1). Los interceptores se ejecutan en orden: traceId, idioma, logging,
+// métricas, autenticación, cliente, tenant y límite de solicitudes.
+func NewServer(bffServer *BffGRPCServer, recorder ports.MetricsRecorder, tenants tenancy.RequestResolver, limiter *ratelimit.Limiter, options Options) *grpc.Server {
+	serverOptions := []grpc.ServerOption{grpc.ChainUnaryInterceptor(
+		TraceUnaryInterceptor,
+		LocaleUnaryInterceptor,
+		LoggingUnaryInterceptor,
+		MetricsUnaryInterceptor(recorder),
+		AuthUnaryInterceptor(options.Tokens),
+		CallerUnaryInterceptor,
+		TenantUnaryInterceptor(tenants),
+		RateLimitUnaryInterceptor(limiter),
+	)}
+	if options.TLS != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(options.TLS)))
+	}
+
+	server := grpc.NewServer(serverOptions...)
+
+	bffv1.RegisterBffServiceServer(server, bffServer)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(bffv1.BffService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	if options.Reflection {
+		reflection.Register(server)
+	}
+
+	return server
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"bff-graphql-payment/internal/application/caller"
+	"net/http"
+	"strings"
+)
+
+// maxPrincipalLength limita el largo de la identidad recibida
+const maxPrincipalLength = 256
+
+// Caller guarda en el contexto la identidad, IP y user agent del cliente de cada solicitud. La IP
+// se obtiene de X-Forwarded-For solo cuando la conexión viene de uno de los proxies de confianza.
+func Caller(proxies TrustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			info := caller.Info{
+				Principal:  truncate(strings.TrimSpace(r.Header.Get(caller.PrincipalKey)), maxPrincipalLength),
+				ClientIP:   proxies.ClientIP(r),
+				UserAgent:  truncate(r.UserAgent(), maxPrincipalLength),
+				AdminToken: r.Header.Get(caller.AdminTokenKey),
+			}
+			next.ServeHTTP(w, r.WithContext(caller.WithInfo(r.Context(), info)))
+		})
+	}
+}
+
+// truncate acota el largo de un valor recibido del cliente
+func truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// TrustedProxies son las redes de los proxies cuyo X-Forwarded-For se considera. Sin proxies
+// de confianza la IP del cliente es siempre la de la conexión.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies interpreta una lista de redes CIDR o direcciones IP individuales
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+			}
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		addr = addr.Unmap()
+		proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return proxies, nil
+}
+
+// trusts indica si la dirección pertenece a un proxy de confianza
+func (p TrustedProxies) trusts(value string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP obtiene la IP del cliente. X-Forwarded-For solo se considera si la conexión viene de
+// un proxy de confianza, y se recorre de derecha a izquierda hasta la primera dirección que no
+// es de un proxy de confianza: las anteriores las pudo escribir el propio cliente.
+func (p TrustedProxies) ClientIP(r *http.Request) string {
+	remote := remoteIP(r)
+	if len(p) == 0 || !p.trusts(remote) {
+		return remote
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if _, err := netip.ParseAddr(hop); err != nil {
+			// Un valor inválido corta la cadena; se usa la última dirección confiable conocida
+			return remote
+		}
+		if !p.trusts(hop) {
+			return hop
+		}
+		remote = hop
+	}
+	return remote
+}
+
+// remoteIP obtiene la IP de la conexión
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
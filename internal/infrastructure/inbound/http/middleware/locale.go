@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"net/http"
+)
+
+// Locale guarda en el contexto el idioma de los mensajes elegido del header Accept-Language.
+// Sin un idioma soportado se usa el idioma por defecto.
+func Locale(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if locale, ok := i18n.ParseAcceptLanguage(r.Header.Get("Accept-Language")); ok {
+			r = r.WithContext(i18n.WithLocale(r.Context(), locale))
+		}
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r)
+	})
+}
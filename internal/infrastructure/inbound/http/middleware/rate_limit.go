@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"bff-graphql-payment/internal/application/caller"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/infrastructure/inbound/ratelimit"
+	"net/http"
+)
+
+// RateLimit aplica el límite de solicitudes por tenant y cliente; la IP del cliente es la que
+// guardó el middleware Caller
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client := caller.FromContext(r.Context()).ClientIP
+			if client == "" {
+				client = remoteIP(r)
+			}
+
+			if !limiter.Allow(tenancy.ID(r.Context()), client) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"message":"too many requests"}`))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/application/tenancy"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// Tenant guarda en el contexto el tenant indicado por el header X-Tenant-Id o, sin header, por el
+// hostname. Un tenant desconocido responde 400; sin tenant la solicitud continúa y cada caso de
+// uso lo obtiene del serviceName o usa el tenant por defecto.
+func Tenant(tenants tenancy.RequestResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, err := tenants.ResolveRequest(r.Header.Get(tenancy.Header), r.Host)
+			if err != nil {
+				slog.WarnContext(r.Context(), "🚫 Request rejected", "tenant", r.Header.Get(tenancy.Header), "host", r.Host, "error", err)
+
+				code, message, _ := i18n.Error(i18n.FromContext(r.Context()), err)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"code": code, "message": message})
+				return
+			}
+
+			if tenant != nil {
+				r = r.WithContext(tenancy.WithTenant(r.Context(), tenant))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"bff-graphql-payment/internal/application/tracing"
+	"net/http"
+	"sync"
+)
+
+// TraceIDHeader es el header con el que se recibe y se devuelve el traceId de la solicitud
+const TraceIDHeader = "X-Trace-Id"
+
+// Trace asigna un traceId a cada solicitud: el de X-Trace-Id, el trace-id de un header
+// traceparent (W3C) o uno generado. Lo guarda en el contexto y lo devuelve en X-Trace-Id.
+// Si la operación trae su propio traceId (por ejemplo en el input GraphQL), la respuesta
+// devuelve ese; con varios, el primero.
+func Trace(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID := tracing.Resolve(r.Header.Get(TraceIDHeader), r.Header.Get("traceparent"))
+		w.Header().Set(TraceIDHeader, traceID)
+
+		// Los resolvers corren en paralelo; solo el primer traceId explícito reemplaza el header
+		var once sync.Once
+		ctx := tracing.WithReporter(tracing.WithTraceID(r.Context(), traceID), func(explicit string) {
+			if explicit == traceID || !tracing.Valid(explicit) {
+				return
+			}
+			once.Do(func() { w.Header().Set(TraceIDHeader, explicit) })
+		})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
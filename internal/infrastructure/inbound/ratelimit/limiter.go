@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Settings contiene los límites vigentes del limitador
+type Settings struct {
+	// RequestsPerSecond en 0 desactiva el limitador
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// Limiter limita las solicitudes por tenant e IP de cliente. Los límites de cada tenant se
+// leen en cada solicitud para que un cambio de configuración se aplique sin reiniciar. Lo usan
+// tanto el servidor HTTP como el gRPC, cada uno con su propia instancia.
+type Limiter struct {
+	settings func(tenantID string) Settings
+	onReject func()
+
+	mu       sync.Mutex
+	limiters map[string]*clientLimiter
+	lastGC   time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	settings Settings
+	lastSeen time.Time
+}
+
+const (
+	// clientIdleTTL es el tiempo tras el cual se descarta el limitador de un cliente inactivo
+	clientIdleTTL = 10 * time.Minute
+	// maxClientLimiters acota la memoria del limitador; al llenarse se descartan primero los
+	// clientes inactivos y luego el que lleva más tiempo sin solicitudes
+	maxClientLimiters = 10000
+)
+
+// NewLimiter crea un limitador que obtiene los límites de cada tenant desde settings; las
+// solicitudes sin tenant usan el tenant "". onReject (opcional) se invoca cada vez que se
+// rechaza una solicitud.
+func NewLimiter(settings func(tenantID string) Settings, onReject func()) *Limiter {
+	return &Limiter{
+		settings: settings,
+		onReject: onReject,
+		limiters: make(map[string]*clientLimiter),
+		lastGC:   time.Now(),
+	}
+}
+
+// Allow indica si la solicitud del cliente del tenant puede procesarse. Si no, invoca onReject.
+func (rl *Limiter) Allow(tenantID string, client string) bool {
+	if rl.allow(tenantID, client) {
+		return true
+	}
+
+	if rl.onReject != nil {
+		rl.onReject()
+	}
+	return false
+}
+
+// allow aplica el limitador del cliente en el tenant
+func (rl *Limiter) allow(tenantID string, client string) bool {
+	settings := rl.settings(tenantID)
+	if settings.RequestsPerSecond <= 0 {
+		return true
+	}
+
+	now := time.Now()
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.lastGC) > clientIdleTTL {
+		rl.removeIdle(now)
+	}
+
+	key := tenantID + "|" + client
+	cl, ok := rl.limiters[key]
+	if !ok {
+		if len(rl.limiters) >= maxClientLimiters {
+			rl.evict(now)
+		}
+		cl = &clientLimiter{
+			limiter:  rate.NewLimiter(rate.Limit(settings.RequestsPerSecond), settings.Burst),
+			settings: settings,
+		}
+		rl.limiters[key] = cl
+	}
+
+	// Si cambiaron los límites del tenant, actualizar el limitador del cliente
+	if cl.settings != settings {
+		cl.limiter.SetLimitAt(now, rate.Limit(settings.RequestsPerSecond))
+		cl.limiter.SetBurstAt(now, settings.Burst)
+		cl.settings = settings
+	}
+	cl.lastSeen = now
+
+	return cl.limiter.AllowN(now, 1)
+}
+
+// removeIdle descarta los limitadores de los clientes inactivos
+func (rl *Limiter) removeIdle(now time.Time) {
+	for key, cl := range rl.limiters {
+		if now.Sub(cl.lastSeen) > clientIdleTTL {
+			delete(rl.limiters, key)
+		}
+	}
+	rl.lastGC = now
+}
+
+// evict libera espacio para un cliente nuevo cuando el limitador está lleno
+func (rl *Limiter) evict(now time.Time) {
+	rl.removeIdle(now)
+	if len(rl.limiters) < maxClientLimiters {
+		return
+	}
+
+	var oldestKey string
+	var oldest time.Time
+	for key, cl := range rl.limiters {
+		if oldestKey == "" || cl.lastSeen.Before(oldest) {
+			oldestKey, oldest = key, cl.lastSeen
+		}
+	}
+	delete(rl.limiters, oldestKey)
+}
@@ -0,0 +1,141 @@
+package rest
+
+import "time"
+
+// ErrorResponse es el cuerpo de toda respuesta de error
+type ErrorResponse struct {
+	Error   ErrorBody `json:"error"`
+	TraceID string    `json:"traceId"`
+}
+
+// ErrorBody describe un error con un código estable
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// RackResponse es la respuesta de GET /v1/racks/by-qr/{qr}
+type RackResponse struct {
+	TransactionID string               `json:"transactionId"`
+	Message       string               `json:"message"`
+	Detail        string               `json:"detail,omitempty"`
+	Status        string               `json:"status"`
+	TraceID       string               `json:"traceId"`
+	PaymentRack   PaymentRack          `json:"paymentRack"`
+	Installation  *PaymentInstallation `json:"installation,omitempty"`
+	Branding      *TenantBranding      `json:"branding,omitempty"`
+	BookingTimes  []BookingTime        `json:"bookingTimes"`
+}
+
+// PaymentRack es un rack de pago
+type PaymentRack struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+	Address     string `json:"address"`
+}
+
+// PaymentInstallation es la instalación donde se ubica el rack
+type PaymentInstallation struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Region   string `json:"region"`
+	City     string `json:"city"`
+	Address  string `json:"address"`
+	ImageURL string `json:"imageUrl"`
+}
+
+// TenantBranding es la marca del tenant de la instalación
+type TenantBranding struct {
+	LogoURL        string `json:"logoUrl"`
+	PrimaryColor   string `json:"primaryColor"`
+	SecondaryColor string `json:"secondaryColor"`
+}
+
+// BookingTime es un tiempo de reserva ofrecido por el rack
+type BookingTime struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	UnitMeasurement string `json:"unitMeasurement"`
+	Amount          int    `json:"amount"`
+}
+
+// AvailabilityResponse es la respuesta de GET /v1/racks/{id}/availability
+type AvailabilityResponse struct {
+	TransactionID   string           `json:"transactionId"`
+	Message         string           `json:"message"`
+	Detail          string           `json:"detail,omitempty"`
+	Status          string           `json:"status"`
+	TraceID         string           `json:"traceId"`
+	PaymentRackID   int              `json:"paymentRackId"`
+	BookingTimeID   int              `json:"bookingTimeId"`
+	AvailableGroups []AvailableGroup `json:"availableGroups"`
+}
+
+// AvailableGroup es un grupo de lockers disponible
+type AvailableGroup struct {
+	GroupID     int     `json:"groupId"`
+	Name        string  `json:"name"`
+	Price       float64 `json:"price"`
+	Description string  `json:"description"`
+	ImageURL    string  `json:"imageUrl"`
+}
+
+// PurchaseOrderRequest es el cuerpo de POST /v1/purchase-orders
+type PurchaseOrderRequest struct {
+	RackIDReference int     `json:"rackIdReference"`
+	GroupID         int     `json:"groupId"`
+	CouponCode      *string `json:"couponCode"`
+	UserEmail       string  `json:"userEmail"`
+	UserPhone       string  `json:"userPhone"`
+	GatewayName     string  `json:"gatewayName"`
+}
+
+// PurchaseOrderResponse es la respuesta de POST /v1/purchase-orders
+type PurchaseOrderResponse struct {
+	TransactionID string `json:"transactionId"`
+	Message       string `json:"message"`
+	Detail        string `json:"detail,omitempty"`
+	Status        string `json:"status"`
+	TraceID       string `json:"traceId"`
+	URL           string `json:"url"`
+}
+
+// BookingResponse es la respuesta de GET /v1/bookings/{code}
+type BookingResponse struct {
+	TransactionID string  `json:"transactionId"`
+	Message       string  `json:"message"`
+	Detail        string  `json:"detail,omitempty"`
+	Status        string  `json:"status"`
+	TraceID       string  `json:"traceId"`
+	Booking       Booking `json:"booking"`
+}
+
+// Booking es el estado de una reserva
+type Booking struct {
+	ID               int       `json:"id"`
+	ServiceName      string    `json:"serviceName"`
+	CurrentCode      string    `json:"currentCode"`
+	InstallationName string    `json:"installationName"`
+	NumberLocker     int       `json:"numberLocker"`
+	InitBooking      time.Time `json:"initBooking"`
+	FinishBooking    time.Time `json:"finishBooking"`
+	Openings         int       `json:"openings"`
+	State            string    `json:"state"`
+	RemainingTime    int       `json:"remainingTime"`
+	CanOpen          bool      `json:"canOpen"`
+}
+
+// OpenRequest es el cuerpo de POST /v1/bookings/{code}/open
+type OpenRequest struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// OpenResponse es la respuesta de POST /v1/bookings/{code}/open
+type OpenResponse struct {
+	TransactionID string `json:"transactionId"`
+	Message       string `json:"message"`
+	Detail        string `json:"detail,omitempty"`
+	Status        string `json:"status"`
+	TraceID       string `json:"traceId"`
+	OpenStatus    string `json:"openStatus"`
+}
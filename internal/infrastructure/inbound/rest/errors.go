@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"bff-graphql-payment/internal/domain/exception"
+	"errors"
+	"net/http"
+)
+
+// Códigos de error estables devueltos en el cuerpo de las respuestas de error
+const (
+	CodeInvalidArgument    = "INVALID_ARGUMENT"
+	CodeNotFound           = "NOT_FOUND"
+	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	CodeUpstreamError      = "UPSTREAM_ERROR"
+)
+
+var (
+	// errInvalidBody se devuelve cuando el cuerpo JSON de la solicitud no se puede interpretar
+	errInvalidBody = errors.New("invalid request body")
+
+	// errInvalidQuery se devuelve cuando un parámetro de la query no tiene el formato esperado
+	errInvalidQuery = errors.New("invalid query parameter")
+
+	// errRouteNotFound se devuelve cuando la ruta no corresponde a ningún recurso
+	errRouteNotFound = errors.New("route not found")
+)
+
+// invalidArgumentErrors son los errores de validación de entrada del caso de uso
+var invalidArgumentErrors = []error{
+	errInvalidBody,
+	errInvalidQuery,
+	exception.ErrInvalidPaymentRackID,
+	exception.ErrInvalidBookingTimeID,
+	exception.ErrInvalidCouponCode,
+	exception.ErrInvalidCoupon,
+	exception.ErrInvalidGroupID,
+	exception.ErrInvalidEmail,
+	exception.ErrInvalidPhone,
+	exception.ErrInvalidTraceID,
+	exception.ErrInvalidGatewayName,
+	exception.ErrInvalidPurchaseOrder,
+	exception.ErrInvalidBookingReference,
+	exception.ErrInvalidServiceName,
+	exception.ErrInvalidCurrentCode,
+	exception.ErrInvalidTimezone,
+	exception.ErrInvalidQRImageFormat,
+	exception.ErrInvalidQRImageSize,
+	exception.ErrUnknownTenant,
+}
+
+// qrRejectedErrors son los rechazos de un QR adulterado o no aceptado; conservan su código para
+// que el kiosco distinga la causa
+var qrRejectedErrors = []error{
+	exception.ErrQRMalformed,
+	exception.ErrQRSignatureInvalid,
+	exception.ErrQRKeyUnknown,
+	exception.ErrQRUnsigned,
+}
+
+// notFoundErrors son los errores que indican que el recurso solicitado no existe
+var notFoundErrors = []error{
+	errRouteNotFound,
+	exception.ErrPaymentRackNotFound,
+	exception.ErrNoLockersAvailable,
+	exception.ErrCouponNotFound,
+	exception.ErrPurchaseOrderNotFound,
+	exception.ErrBookingNotFound,
+}
+
+// upstreamErrors son los errores que indican que el backend no completó la operación
+var upstreamErrors = []error{
+	exception.ErrPurchaseOrderFailed,
+	exception.ErrBookingGenerationFailed,
+	exception.ErrExecuteOpenFailed,
+	exception.ErrRefundFailed,
+}
+
+// statusForError elige el código HTTP y el código de error para un error del caso de uso.
+// Los demás errores de dominio conservan su código y responden 409.
+func statusForError(err error) (int, string) {
+	var domainErr *exception.DomainError
+
+	switch {
+	case isAny(err, invalidArgumentErrors):
+		return http.StatusBadRequest, CodeInvalidArgument
+	case isAny(err, qrRejectedErrors) && errors.As(err, &domainErr):
+		return http.StatusBadRequest, domainErr.Code
+	case errors.Is(err, exception.ErrQRExpired):
+		return http.StatusGone, exception.ErrQRExpired.Code
+	case errors.Is(err, exception.ErrAdminRequired):
+		return http.StatusUnauthorized, exception.ErrAdminRequired.Code
+	case isAny(err, notFoundErrors):
+		return http.StatusNotFound, CodeNotFound
+	case errors.Is(err, exception.ErrPaymentInfraServiceUnavailable):
+		return http.StatusServiceUnavailable, CodeServiceUnavailable
+	case isAny(err, upstreamErrors):
+		return http.StatusBadGateway, CodeUpstreamError
+	case errors.Is(err, exception.ErrTenantMismatch):
+		return http.StatusForbidden, exception.ErrTenantMismatch.Code
+	case errors.As(err, &domainErr):
+		return http.StatusConflict, domainErr.Code
+	default:
+		return http.StatusBadGateway, CodeUpstreamError
+	}
+}
+
+// isAny indica si err corresponde a alguno de los errores indicados
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package rest
+
+import (
+	"bff-graphql-payment/internal/application/i18n"
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/model"
+	"bff-graphql-payment/internal/domain/ports"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBodyBytes limita el tamaño de los cuerpos JSON aceptados
+const maxBodyBytes = 64 << 10
+
+// Handler expone los casos de uso de pago como API REST/JSON para el firmware de los kioscos.
+// Usa el mismo servicio que los resolvers GraphQL, por lo que las validaciones y reglas son idénticas.
+type Handler struct {
+	service   ports.PaymentInfraService
+	qrService ports.BookingCodeQRService
+	mux       *http.ServeMux
+}
+
+// NewHandler crea el handler REST y registra sus rutas
+func NewHandler(service ports.PaymentInfraService, qrService ports.BookingCodeQRService) *Handler {
+	h := &Handler{
+		service:   service,
+		qrService: qrService,
+		mux:       http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET /v1/racks/{rack}/{resource}", h.routeRack)
+	h.mux.HandleFunc("POST /v1/purchase-orders", h.createPurchaseOrder)
+	h.mux.HandleFunc("GET /v1/bookings/{code}", h.getBooking)
+	h.mux.HandleFunc("POST /v1/bookings/{code}/open", h.openBooking)
+	h.mux.HandleFunc("GET /bookings/{code}/qr.png", h.getBookingCodeQR)
+	h.mux.HandleFunc("GET /openapi.json", serveOpenAPI)
+
+	return h
+}
+
+// ServeHTTP implementa http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// routeRack distingue /v1/racks/by-qr/{qr} de /v1/racks/{id}/availability. ServeMux no admite
+// ambos patrones a la vez porque se solapan en /v1/racks/by-qr/availability.
+func (h *Handler) routeRack(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.PathValue("rack") == "by-qr":
+		h.getRackByQR(w, r, r.PathValue("resource"))
+	case r.PathValue("resource") == "availability":
+		h.getAvailability(w, r, r.PathValue("rack"))
+	default:
+		_, traceID := tracing.Ensure(r.Context(), nil)
+		writeError(w, r, traceID, errRouteNotFound)
+	}
+}
+
+// getRackByQR atiende GET /v1/racks/by-qr/{qr}
+func (h *Handler) getRackByQR(w http.ResponseWriter, r *http.Request, qrValue string) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+
+	// Llamar al caso de uso
+	paymentInfra, err := h.service.GetPaymentInfraByQrValue(ctx, qrValue)
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	if paymentInfra.Status == model.ResponseStatusError || paymentInfra.PaymentRack == nil {
+		writeError(w, r, traceID, exception.ErrPaymentRackNotFound)
+		return
+	}
+
+	// Mapear a respuesta REST
+	writeJSON(w, http.StatusOK, toRackResponse(paymentInfra, traceID))
+}
+
+// getAvailability atiende GET /v1/racks/{id}/availability?bookingTimeId=
+func (h *Handler) getAvailability(w http.ResponseWriter, r *http.Request, rack string) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+
+	rackID, err := strconv.Atoi(rack)
+	if err != nil {
+		writeError(w, r, traceID, exception.ErrInvalidPaymentRackID)
+		return
+	}
+
+	bookingTimeID, err := strconv.Atoi(r.URL.Query().Get("bookingTimeId"))
+	if err != nil {
+		writeError(w, r, traceID, exception.ErrInvalidBookingTimeID)
+		return
+	}
+
+	// Llamar al caso de uso
+	lockers, err := h.service.GetAvailableLockers(ctx, rackID, bookingTimeID, traceID)
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Mapear a respuesta REST
+	writeJSON(w, http.StatusOK, toAvailabilityResponse(lockers, rackID, bookingTimeID, traceID))
+}
+
+// createPurchaseOrder atiende POST /v1/purchase-orders
+func (h *Handler) createPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+
+	var request PurchaseOrderRequest
+	if err := decodeJSON(w, r, &request); err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Llamar al caso de uso
+	order, err := h.service.GeneratePurchaseOrder(ctx, request.RackIDReference, request.GroupID, request.CouponCode,
+		request.UserEmail, request.UserPhone, traceID, request.GatewayName)
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Mapear a respuesta REST
+	writeJSON(w, http.StatusCreated, toPurchaseOrderResponse(order, traceID))
+}
+
+// getBooking atiende GET /v1/bookings/{code}?serviceName=&timezone=
+func (h *Handler) getBooking(w http.ResponseWriter, r *http.Request) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+	query := r.URL.Query()
+
+	location, err := toLocation(query.Get("timezone"))
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Llamar al caso de uso
+	bookingStatus, err := h.service.CheckBookingStatus(ctx, query.Get("serviceName"), r.PathValue("code"))
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	if bookingStatus.Status == model.ResponseStatusError || bookingStatus.Booking == nil {
+		writeError(w, r, traceID, exception.ErrBookingNotFound)
+		return
+	}
+
+	// Mapear a respuesta REST
+	writeJSON(w, http.StatusOK, toBookingResponse(bookingStatus, location, traceID))
+}
+
+// openBooking atiende POST /v1/bookings/{code}/open
+func (h *Handler) openBooking(w http.ResponseWriter, r *http.Request) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+
+	var request OpenRequest
+	if err := decodeJSON(w, r, &request); err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Llamar al caso de uso
+	result, err := h.service.ExecuteOpen(ctx, request.ServiceName, r.PathValue("code"))
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// Mapear a respuesta REST
+	writeJSON(w, http.StatusOK, toOpenResponse(result, traceID))
+}
+
+// getBookingCodeQR atiende GET /bookings/{code}/qr.png?size=&serviceName=&expiresAt=
+// y devuelve el código de apertura dibujado como PNG para mostrarlo en la pantalla del kiosco.
+func (h *Handler) getBookingCodeQR(w http.ResponseWriter, r *http.Request) {
+	ctx, traceID := tracing.Ensure(r.Context(), nil)
+	query := r.URL.Query()
+
+	request := model.BookingCodeQRRequest{
+		Code:        r.PathValue("code"),
+		Format:      model.QRImageFormatPNG,
+		ServiceName: query.Get("serviceName"),
+	}
+
+	if value := query.Get("size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil {
+			writeError(w, r, traceID, fmt.Errorf("%w: %s", exception.ErrInvalidQRImageSize, value))
+			return
+		}
+		request.Size = size
+	}
+
+	if value := query.Get("expiresAt"); value != "" {
+		expiresAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, r, traceID, fmt.Errorf("%w: expiresAt must be RFC 3339", errInvalidQuery))
+			return
+		}
+		request.ExpiresAt = &expiresAt
+	}
+
+	// Llamar al caso de uso
+	image, err := h.qrService.RenderBookingCode(ctx, request)
+	if err != nil {
+		writeError(w, r, traceID, err)
+		return
+	}
+
+	// El código de apertura es sensible: no se guarda en cachés intermedias
+	w.Header().Set("Content-Type", image.Format.MimeType())
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(image.Content)
+}
+
+// toLocation resuelve la zona horaria solicitada; sin valor se usa la del backend
+func toLocation(timezone string) (*time.Location, error) {
+	name := model.DefaultTimezone
+	if strings.TrimSpace(timezone) != "" {
+		name = strings.TrimSpace(timezone)
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", exception.ErrInvalidTimezone, name)
+	}
+	return location, nil
+}
+
+// decodeJSON interpreta el cuerpo JSON de la solicitud rechazando campos desconocidos
+func decodeJSON(w http.ResponseWriter, r *http.Request, target any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidBody, err)
+	}
+	return nil
+}
+
+// writeError escribe el cuerpo de error común con el código derivado del error del caso de uso
+func writeError(w http.ResponseWriter, r *http.Request, traceID string, err error) {
+	status, code := statusForError(err)
+	level := slog.LevelWarn
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	slog.Log(r.Context(), level, "❌ REST request failed", "method", r.Method, "path", r.URL.Path, "status", status, "code", code, "error", err)
+
+	writeJSON(w, status, ErrorResponse{
+		Error:   ErrorBody{Code: code, Message: i18n.ErrorMessage(i18n.FromContext(r.Context()), err)},
+		TraceID: traceID,
+	})
+}
+
+// writeJSON escribe una respuesta JSON
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
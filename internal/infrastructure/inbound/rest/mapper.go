@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"time"
+)
+
+// toRackResponse mapea la infraestructura de pagos de dominio a la respuesta REST
+func toRackResponse(paymentInfra *model.PaymentInfra, traceID string) RackResponse {
+	response := RackResponse{
+		TransactionID: paymentInfra.TransactionID,
+		Message:       paymentInfra.Message,
+		Detail:        paymentInfra.Detail,
+		Status:        string(paymentInfra.Status),
+		TraceID:       traceID,
+		BookingTimes:  make([]BookingTime, 0, len(paymentInfra.BookingTimes)),
+	}
+
+	if paymentInfra.PaymentRack != nil {
+		response.PaymentRack = PaymentRack{
+			ID:          paymentInfra.PaymentRack.ID,
+			Description: paymentInfra.PaymentRack.Description,
+			Address:     paymentInfra.PaymentRack.Address,
+		}
+	}
+
+	if paymentInfra.Installation != nil {
+		response.Installation = &PaymentInstallation{
+			ID:       paymentInfra.Installation.ID,
+			Name:     paymentInfra.Installation.Name,
+			Region:   paymentInfra.Installation.Region,
+			City:     paymentInfra.Installation.City,
+			Address:  paymentInfra.Installation.Address,
+			ImageURL: paymentInfra.Installation.ImageURL,
+		}
+	}
+
+	if paymentInfra.Branding != nil {
+		response.Branding = &TenantBranding{
+			LogoURL:        paymentInfra.Branding.LogoURL,
+			PrimaryColor:   paymentInfra.Branding.PrimaryColor,
+			SecondaryColor: paymentInfra.Branding.SecondaryColor,
+		}
+	}
+
+	for _, bookingTime := range paymentInfra.BookingTimes {
+		response.BookingTimes = append(response.BookingTimes, BookingTime{
+			ID:              bookingTime.ID,
+			Name:            bookingTime.Name,
+			UnitMeasurement: string(bookingTime.UnitMeasurement),
+			Amount:          bookingTime.Amount,
+		})
+	}
+
+	return response
+}
+
+// toAvailabilityResponse mapea los lockers disponibles de dominio a la respuesta REST
+func toAvailabilityResponse(lockers *model.AvailableLockers, rackID int, bookingTimeID int, traceID string) AvailabilityResponse {
+	response := AvailabilityResponse{
+		TransactionID:   lockers.TransactionID,
+		Message:         lockers.Message,
+		Detail:          lockers.Detail,
+		Status:          string(lockers.Status),
+		TraceID:         traceID,
+		PaymentRackID:   rackID,
+		BookingTimeID:   bookingTimeID,
+		AvailableGroups: make([]AvailableGroup, 0, len(lockers.AvailableGroups)),
+	}
+
+	for _, group := range lockers.AvailableGroups {
+		response.AvailableGroups = append(response.AvailableGroups, AvailableGroup{
+			GroupID:     group.GroupID,
+			Name:        group.Name,
+			Price:       group.Price,
+			Description: group.Description,
+			ImageURL:    group.ImageURL,
+		})
+	}
+
+	return response
+}
+
+// toPurchaseOrderResponse mapea la orden de compra de dominio a la respuesta REST
+func toPurchaseOrderResponse(order *model.PurchaseOrder, traceID string) PurchaseOrderResponse {
+	return PurchaseOrderResponse{
+		TransactionID: order.TransactionID,
+		Message:       order.Message,
+		Detail:        order.Detail,
+		Status:        string(order.Status),
+		TraceID:       traceID,
+		URL:           order.URL,
+	}
+}
+
+// toBookingResponse mapea el estado de la reserva de dominio a la respuesta REST.
+// Las fechas se expresan en la zona horaria solicitada.
+func toBookingResponse(bookingStatus *model.BookingStatusCheck, location *time.Location, traceID string) BookingResponse {
+	booking := bookingStatus.Booking
+	now := time.Now()
+
+	return BookingResponse{
+		TransactionID: bookingStatus.TransactionID,
+		Message:       bookingStatus.Message,
+		Detail:        bookingStatus.Detail,
+		Status:        string(bookingStatus.Status),
+		TraceID:       traceID,
+		Booking: Booking{
+			ID:               booking.ID,
+			ServiceName:      booking.ServiceName,
+			CurrentCode:      booking.CurrentCode,
+			InstallationName: booking.InstallationName,
+			NumberLocker:     booking.NumberLocker,
+			InitBooking:      booking.InitBooking.In(location),
+			FinishBooking:    booking.FinishBooking.In(location),
+			Openings:         booking.Openings,
+			State:            string(booking.State(now)),
+			RemainingTime:    int(booking.RemainingTime(now).Seconds()),
+			CanOpen:          booking.CanOpen(now),
+		},
+	}
+}
+
+// toOpenResponse mapea el resultado de apertura de dominio a la respuesta REST
+func toOpenResponse(result *model.ExecuteOpenResult, traceID string) OpenResponse {
+	return OpenResponse{
+		TransactionID: result.TransactionID,
+		Message:       result.Message,
+		Detail:        result.Detail,
+		Status:        string(result.Status),
+		TraceID:       traceID,
+		OpenStatus:    string(result.OpenStatus),
+	}
+}
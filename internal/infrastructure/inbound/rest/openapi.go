@@ -0,0 +1,18 @@
+package rest
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPIDocument es la especificación OpenAPI 3 de la API REST
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// serveOpenAPI atiende GET /openapi.json
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(openAPIDocument)
+}
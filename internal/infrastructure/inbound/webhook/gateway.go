@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxClockSkew limita la antigüedad de la firma de una notificación para evitar reenvíos
+const maxClockSkew = 5 * time.Minute
+
+var (
+	// ErrGatewayNotConfigured se devuelve cuando la pasarela no tiene parser o secreto configurado
+	ErrGatewayNotConfigured = errors.New("payment gateway webhook not configured")
+
+	// ErrInvalidSignature se devuelve cuando la firma de la notificación no es válida
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// ErrInvalidNotification se devuelve cuando el cuerpo de la notificación no se puede interpretar
+	ErrInvalidNotification = errors.New("invalid webhook notification")
+
+	// ErrGatewayUnavailable se devuelve cuando no se puede consultar el pago a la pasarela
+	ErrGatewayUnavailable = errors.New("payment gateway unavailable")
+)
+
+// NotificationGateway interpreta las notificaciones de una pasarela de pago concreta
+type NotificationGateway interface {
+	// Name devuelve el gatewayName con el que se registra la pasarela
+	Name() string
+	// Verify valida la firma de la notificación con el secreto configurado
+	Verify(r *http.Request, body []byte, secret string) error
+	// Parse convierte la notificación verificada en un evento de resultado de pago; solo puede
+	// confiar en los campos cubiertos por la firma y debe consultar a la pasarela el resto
+	Parse(ctx context.Context, body []byte) (*model.PaymentResultEvent, error)
+}
+
+// checkTimestamp verifica que la marca de tiempo firmada (segundos Unix) esté dentro de maxClockSkew
+func checkTimestamp(now time.Time, timestamp string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > maxClockSkew || skew < -maxClockSkew {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// signHMACSHA256 calcula la firma HMAC-SHA256 en hexadecimal
+func signHMACSHA256(message []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// equalSignatures compara firmas hexadecimales en tiempo constante
+func equalSignatures(expected string, received string) bool {
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(strings.TrimSpace(received))))
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"bff-graphql-payment/internal/application/tracing"
+	"bff-graphql-payment/internal/domain/exception"
+	"bff-graphql-payment/internal/domain/ports"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// maxBodyBytes limita el tamaño de las notificaciones aceptadas
+const maxBodyBytes = 1 << 20
+
+// PaymentWebhookHandler recibe las notificaciones de pago en POST /webhooks/payments/{gatewayName}
+type PaymentWebhookHandler struct {
+	service  ports.PaymentWebhookService
+	gateways map[string]NotificationGateway
+	secrets  map[string]string
+}
+
+// NewPaymentWebhookHandler crea el handler de webhooks de pago.
+// secrets asocia cada gatewayName con el secreto usado para verificar sus firmas.
+func NewPaymentWebhookHandler(service ports.PaymentWebhookService, secrets map[string]string, gateways ...NotificationGateway) *PaymentWebhookHandler {
+	h := &PaymentWebhookHandler{
+		service:  service,
+		gateways: make(map[string]NotificationGateway, len(gateways)),
+		secrets:  make(map[string]string, len(secrets)),
+	}
+
+	for _, gateway := range gateways {
+		h.gateways[strings.ToLower(gateway.Name())] = gateway
+	}
+
+	for name, secret := range secrets {
+		h.secrets[strings.ToLower(name)] = secret
+	}
+
+	return h
+}
+
+// ServeHTTP implementa http.Handler
+func (h *PaymentWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	gatewayName := strings.ToLower(r.PathValue("gatewayName"))
+
+	gateway, ok := h.gateways[gatewayName]
+	secret := h.secrets[gatewayName]
+	if !ok || secret == "" {
+		slog.WarnContext(ctx, "⚠️ Webhook rejected: gateway not configured", "gateway", gatewayName)
+		writeJSON(w, http.StatusNotFound, ErrGatewayNotConfigured.Error())
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, "unable to read body")
+		return
+	}
+
+	if err := gateway.Verify(r, body, secret); err != nil {
+		slog.WarnContext(ctx, "⚠️ Webhook rejected", "gateway", gatewayName, "error", err)
+		writeJSON(w, http.StatusUnauthorized, ErrInvalidSignature.Error())
+		return
+	}
+
+	event, err := gateway.Parse(ctx, body)
+	if errors.Is(err, ErrGatewayUnavailable) {
+		slog.ErrorContext(ctx, "❌ Webhook payment lookup failed", "gateway", gatewayName, "error", err)
+		writeJSON(w, http.StatusServiceUnavailable, ErrGatewayUnavailable.Error())
+		return
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "⚠️ Webhook rejected", "gateway", gatewayName, "error", err)
+		writeJSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	event.TraceID = tracing.TraceID(ctx)
+
+	slog.InfoContext(ctx, "📨 Webhook received", "gateway", gatewayName, "purchaseOrder", event.PurchaseOrder, "result", event.Result)
+
+	if _, err := h.service.HandlePaymentResult(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "❌ Webhook processing failed", "gateway", gatewayName, "purchaseOrder", event.PurchaseOrder, "error", err)
+		writeJSON(w, statusForError(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, "received")
+}
+
+// statusForError elige el código HTTP para un error del caso de uso. Los errores
+// transitorios devuelven 5xx para que la pasarela reintente la notificación.
+func statusForError(err error) int {
+	switch {
+	case errors.Is(err, exception.ErrInvalidPaymentResult),
+		errors.Is(err, exception.ErrInvalidPurchaseOrder),
+		errors.Is(err, exception.ErrInvalidGatewayName):
+		return http.StatusBadRequest
+	case errors.Is(err, exception.ErrPurchaseOrderNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, exception.ErrInvalidPaymentTransition):
+		return http.StatusConflict
+	case errors.Is(err, exception.ErrPaymentResultMismatch):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusServiceUnavailable
+	}
+}
+
+// writeJSON escribe una respuesta JSON con un mensaje
+func writeJSON(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
@@ -0,0 +1,189 @@
+package webhook
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// mercadoPagoSignatureHeader tiene el formato "ts=<unix>,v1=<hmac hex>"
+	mercadoPagoSignatureHeader = "X-Signature"
+	mercadoPagoRequestIDHeader = "X-Request-Id"
+	// mercadoPagoFetchTimeout limita la consulta del pago a la API de MercadoPago
+	mercadoPagoFetchTimeout = 10 * time.Second
+)
+
+// MercadoPagoGateway interpreta notificaciones al estilo MercadoPago. La firma solo cubre
+// data.id, así que el resultado del pago se consulta a la API con ese id en lugar de leerse
+// del cuerpo.
+type MercadoPagoGateway struct {
+	now         func() time.Time
+	apiURL      string
+	accessToken string
+	client      *http.Client
+}
+
+// NewMercadoPagoGateway crea el parser de notificaciones MercadoPago que consulta los pagos
+// en apiURL con el access token de la cuenta
+func NewMercadoPagoGateway(apiURL string, accessToken string) *MercadoPagoGateway {
+	return &MercadoPagoGateway{
+		now:         time.Now,
+		apiURL:      strings.TrimRight(apiURL, "/"),
+		accessToken: accessToken,
+		client:      &http.Client{Timeout: mercadoPagoFetchTimeout},
+	}
+}
+
+// mercadoPagoNotification representa el cuerpo de la notificación MercadoPago. Solo data.id
+// está firmado; el resto del cuerpo no se usa para decidir el resultado.
+type mercadoPagoNotification struct {
+	Type string `json:"type"`
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// mercadoPagoPayment representa el pago devuelto por GET /v1/payments/{id}
+type mercadoPagoPayment struct {
+	ExternalReference string  `json:"external_reference"`
+	Status            string  `json:"status"`
+	TransactionAmount float64 `json:"transaction_amount"`
+	DateApproved      string  `json:"date_approved"`
+	DateCreated       string  `json:"date_created"`
+}
+
+// Name implementa NotificationGateway.Name
+func (g *MercadoPagoGateway) Name() string {
+	return "mercadopago"
+}
+
+// Verify implementa NotificationGateway.Verify. La firma cubre el manifiesto
+// "id:<data.id>;request-id:<x-request-id>;ts:<ts>;".
+func (g *MercadoPagoGateway) Verify(r *http.Request, body []byte, secret string) error {
+	var ts, v1 string
+	for _, part := range strings.Split(r.Header.Get(mercadoPagoSignatureHeader), ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "ts":
+			ts = value
+		case "v1":
+			v1 = value
+		}
+	}
+	if ts == "" || v1 == "" {
+		return ErrInvalidSignature
+	}
+
+	if err := checkTimestamp(g.now(), ts); err != nil {
+		return err
+	}
+
+	var notification mercadoPagoNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNotification, err)
+	}
+
+	manifest := fmt.Sprintf("id:%s;request-id:%s;ts:%s;", notification.Data.ID, r.Header.Get(mercadoPagoRequestIDHeader), ts)
+	if !equalSignatures(signHMACSHA256([]byte(manifest), secret), v1) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Parse implementa NotificationGateway.Parse. La orden, el estado y el monto salen del pago
+// consultado con el data.id firmado, no del cuerpo de la notificación.
+func (g *MercadoPagoGateway) Parse(ctx context.Context, body []byte) (*model.PaymentResultEvent, error) {
+	var notification mercadoPagoNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNotification, err)
+	}
+
+	if notification.Type != "" && notification.Type != "payment" {
+		return nil, fmt.Errorf("%w: unsupported notification type %q", ErrInvalidNotification, notification.Type)
+	}
+
+	paymentID := strings.TrimSpace(notification.Data.ID)
+	if paymentID == "" {
+		return nil, fmt.Errorf("%w: missing data.id", ErrInvalidNotification)
+	}
+
+	payment, err := g.fetchPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(payment.ExternalReference) == "" {
+		return nil, fmt.Errorf("%w: payment %s has no external_reference", ErrInvalidNotification, paymentID)
+	}
+
+	event := &model.PaymentResultEvent{
+		GatewayName:          g.Name(),
+		PurchaseOrder:        payment.ExternalReference,
+		Result:               g.mapStatus(payment.Status),
+		Amount:               int64(payment.TransactionAmount),
+		GatewayTransactionID: paymentID,
+	}
+
+	occurredAt := payment.DateApproved
+	if occurredAt == "" {
+		occurredAt = payment.DateCreated
+	}
+	if parsed, err := time.Parse(time.RFC3339, occurredAt); err == nil {
+		event.OccurredAt = parsed
+	}
+
+	return event, nil
+}
+
+// fetchPayment consulta el pago en la API de MercadoPago. Un pago inexistente invalida la
+// notificación; cualquier otro fallo es transitorio para que la pasarela reintente.
+func (g *MercadoPagoGateway) fetchPayment(ctx context.Context, paymentID string) (*mercadoPagoPayment, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, g.apiURL+"/v1/payments/"+url.PathEscape(paymentID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create payment request: %v", ErrGatewayUnavailable, err)
+	}
+	request.Header.Set("Authorization", "Bearer "+g.accessToken)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := g.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to fetch payment %s: %v", ErrGatewayUnavailable, paymentID, err)
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: payment %s not found", ErrInvalidNotification, paymentID)
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		return nil, fmt.Errorf("%w: payment %s lookup returned status %d", ErrGatewayUnavailable, paymentID, response.StatusCode)
+	}
+
+	var payment mercadoPagoPayment
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxBodyBytes)).Decode(&payment); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payment %s: %v", ErrGatewayUnavailable, paymentID, err)
+	}
+	return &payment, nil
+}
+
+// mapStatus convierte el estado MercadoPago al resultado de pago de dominio
+func (g *MercadoPagoGateway) mapStatus(status string) model.PaymentResult {
+	switch strings.ToLower(status) {
+	case "approved":
+		return model.PaymentResultApproved
+	case "rejected", "cancelled", "refunded", "charged_back":
+		return model.PaymentResultRejected
+	case "pending", "in_process", "authorized":
+		return model.PaymentResultPending
+	case "expired":
+		return model.PaymentResultExpired
+	default:
+		return model.PaymentResult(strings.ToUpper(status))
+	}
+}
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// webpaySignatureHeader contiene el HMAC-SHA256 (hex) de "<timestamp>.<cuerpo>"
+	webpaySignatureHeader = "X-Webpay-Signature"
+	// webpayTimestampHeader contiene el momento de envío en segundos Unix
+	webpayTimestampHeader = "X-Webpay-Timestamp"
+)
+
+// WebpayGateway interpreta notificaciones al estilo Webpay (Transbank)
+type WebpayGateway struct {
+	now func() time.Time
+}
+
+// NewWebpayGateway crea el parser de notificaciones Webpay
+func NewWebpayGateway() *WebpayGateway {
+	return &WebpayGateway{now: time.Now}
+}
+
+// webpayNotification representa el cuerpo de la notificación Webpay
+type webpayNotification struct {
+	BuyOrder          string `json:"buy_order"`
+	Status            string `json:"status"`
+	Amount            int64  `json:"amount"`
+	AuthorizationCode string `json:"authorization_code"`
+	TransactionDate   string `json:"transaction_date"`
+}
+
+// Name implementa NotificationGateway.Name
+func (g *WebpayGateway) Name() string {
+	return "webpay"
+}
+
+// Verify implementa NotificationGateway.Verify. La firma cubre la marca de tiempo para que una
+// notificación capturada no se pueda reenviar fuera de maxClockSkew.
+func (g *WebpayGateway) Verify(r *http.Request, body []byte, secret string) error {
+	signature := r.Header.Get(webpaySignatureHeader)
+	timestamp := strings.TrimSpace(r.Header.Get(webpayTimestampHeader))
+	if signature == "" || timestamp == "" {
+		return ErrInvalidSignature
+	}
+
+	if err := checkTimestamp(g.now(), timestamp); err != nil {
+		return err
+	}
+
+	message := append([]byte(timestamp+"."), body...)
+	if !equalSignatures(signHMACSHA256(message, secret), signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Parse implementa NotificationGateway.Parse. La firma cubre el cuerpo completo.
+func (g *WebpayGateway) Parse(_ context.Context, body []byte) (*model.PaymentResultEvent, error) {
+	var notification webpayNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNotification, err)
+	}
+
+	if strings.TrimSpace(notification.BuyOrder) == "" {
+		return nil, fmt.Errorf("%w: missing buy_order", ErrInvalidNotification)
+	}
+
+	event := &model.PaymentResultEvent{
+		GatewayName:          g.Name(),
+		PurchaseOrder:        notification.BuyOrder,
+		Result:               g.mapStatus(notification.Status),
+		Amount:               notification.Amount,
+		GatewayTransactionID: notification.AuthorizationCode,
+	}
+
+	if occurredAt, err := time.Parse(time.RFC3339, notification.TransactionDate); err == nil {
+		event.OccurredAt = occurredAt
+	}
+
+	return event, nil
+}
+
+// mapStatus convierte el estado Webpay al resultado de pago de dominio
+func (g *WebpayGateway) mapStatus(status string) model.PaymentResult {
+	switch strings.ToUpper(status) {
+	case "AUTHORIZED":
+		return model.PaymentResultApproved
+	case "FAILED", "REVERSED", "NULLIFIED":
+		return model.PaymentResultRejected
+	case "INITIALIZED":
+		return model.PaymentResultPending
+	case "EXPIRED":
+		return model.PaymentResultExpired
+	default:
+		return model.PaymentResult(strings.ToUpper(status))
+	}
+}
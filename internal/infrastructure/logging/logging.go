@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"bff-graphql-payment/internal/application/tracing"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// level es el nivel de log compartido por todo el proceso; puede cambiarse en caliente
+var level = new(slog.LevelVar)
+
+// Setup configura slog como logger por defecto con el nivel indicado.
+// Los llamados existentes a log.Printf pasan por este handler con nivel INFO.
+// Los registros hechos con un contexto que lleva traceId lo incluyen como atributo.
+func Setup(levelName string) error {
+	if err := SetLevel(levelName); err != nil {
+		return err
+	}
+
+	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(traceHandler{handler}))
+
+	return nil
+}
+
+// traceHandler agrega el traceId del contexto a cada registro
+type traceHandler struct {
+	slog.Handler
+}
+
+// Handle implementa slog.Handler
+func (h traceHandler) Handle(ctx context.Context, record slog.Record) error {
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		record.AddAttrs(slog.String("traceId", traceID))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs implementa slog.Handler
+func (h traceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return traceHandler{h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implementa slog.Handler
+func (h traceHandler) WithGroup(name string) slog.Handler {
+	return traceHandler{h.Handler.WithGroup(name)}
+}
+
+// SetLevel cambia el nivel de log en tiempo de ejecución
+func SetLevel(levelName string) error {
+	parsed, err := ParseLevel(levelName)
+	if err != nil {
+		return err
+	}
+
+	level.Set(parsed)
+	return nil
+}
+
+// Level devuelve el nivel de log actual
+func Level() slog.Level {
+	return level.Level()
+}
+
+// ParseLevel convierte un nombre de nivel (debug, info, warn, error) a slog.Level
+func ParseLevel(levelName string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(levelName)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q", levelName)
+	}
+}
@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// auditRecord es una línea del archivo de auditoría
+type auditRecord struct {
+	ID                    string    `json:"id"`
+	Timestamp             time.Time `json:"timestamp"`
+	Principal             string    `json:"principal"`
+	ClientIP              string    `json:"clientIp,omitempty"`
+	UserAgent             string    `json:"userAgent,omitempty"`
+	ServiceName           string    `json:"serviceName"`
+	MaskedCode            string    `json:"maskedCode"`
+	OpenStatuses          []string  `json:"openStatuses"`
+	Outcome               string    `json:"outcome"`
+	Reason                string    `json:"reason,omitempty"`
+	UpstreamTransactionID string    `json:"upstreamTransactionId,omitempty"`
+	TraceID               string    `json:"traceId,omitempty"`
+}
+
+// FileLog guarda la auditoría de aperturas como un archivo JSONL de solo agregado. Cada registro
+// se sincroniza a disco antes de confirmarse y nunca se modifica ni se elimina.
+type FileLog struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// OpenFileLog abre (o crea) el archivo de auditoría
+func OpenFileLog(path string) (*FileLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+
+	slog.Info("🧾 Locker open audit log opened", "file", path)
+	return &FileLog{path: path, file: file}, nil
+}
+
+// Append implementa ports.LockerOpenAuditLog
+func (l *FileLog) Append(ctx context.Context, record model.LockerOpenAuditRecord) error {
+	line, err := json.Marshal(toAuditRecord(record))
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return errors.New("audit file is closed")
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit file: %w", err)
+	}
+	return nil
+}
+
+// Query implementa ports.LockerOpenAuditLog recorriendo el archivo completo. Una línea inválida
+// (por ejemplo, una escritura interrumpida) se omite.
+func (l *FileLog) Query(ctx context.Context, filter model.LockerOpenAuditFilter) (*model.LockerOpenAuditPage, error) {
+	file, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+
+	var matches []model.LockerOpenAuditRecord
+	line := 0
+	for scanner.Scan() {
+		line++
+		var stored auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &stored); err != nil {
+			slog.WarnContext(ctx, "⚠️ Skipping invalid audit line", "line", line, "error", err)
+			continue
+		}
+
+		record := toDomainRecord(stored)
+		if filter.Matches(record) {
+			matches = append(matches, record)
+		}
+
+		if line%1000 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit file: %w", err)
+	}
+
+	return paginate(matches, filter), nil
+}
+
+// Close cierra el archivo de auditoría
+func (l *FileLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// paginate ordena los registros del más reciente al más antiguo y devuelve la página solicitada
+func paginate(records []model.LockerOpenAuditRecord, filter model.LockerOpenAuditFilter) *model.LockerOpenAuditPage {
+	slices.SortStableFunc(records, func(a, b model.LockerOpenAuditRecord) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	page := &model.LockerOpenAuditPage{
+		TotalCount: len(records),
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+		Records:    []model.LockerOpenAuditRecord{},
+	}
+
+	if filter.Offset >= len(records) {
+		return page
+	}
+
+	end := min(filter.Offset+filter.Limit, len(records))
+	page.Records = records[filter.Offset:end]
+	page.HasNextPage = end < len(records)
+	return page
+}
+
+// toAuditRecord mapea el registro de dominio a su representación JSON
+func toAuditRecord(record model.LockerOpenAuditRecord) auditRecord {
+	statuses := make([]string, 0, len(record.OpenStatuses))
+	for _, status := range record.OpenStatuses {
+		statuses = append(statuses, string(status))
+	}
+
+	return auditRecord{
+		ID:                    record.ID,
+		Timestamp:             record.Timestamp,
+		Principal:             record.Principal,
+		ClientIP:              record.ClientIP,
+		UserAgent:             record.UserAgent,
+		ServiceName:           record.ServiceName,
+		MaskedCode:            record.MaskedCode,
+		OpenStatuses:          statuses,
+		Outcome:               string(record.Outcome),
+		Reason:                record.Reason,
+		UpstreamTransactionID: record.UpstreamTransactionID,
+		TraceID:               record.TraceID,
+	}
+}
+
+// toDomainRecord mapea la representación JSON al registro de dominio
+func toDomainRecord(stored auditRecord) model.LockerOpenAuditRecord {
+	statuses := make([]model.OpenStatus, 0, len(stored.OpenStatuses))
+	for _, status := range stored.OpenStatuses {
+		statuses = append(statuses, model.OpenStatus(status))
+	}
+
+	return model.LockerOpenAuditRecord{
+		ID:                    stored.ID,
+		Timestamp:             stored.Timestamp,
+		Principal:             stored.Principal,
+		ClientIP:              stored.ClientIP,
+		UserAgent:             stored.UserAgent,
+		ServiceName:           stored.ServiceName,
+		MaskedCode:            stored.MaskedCode,
+		OpenStatuses:          statuses,
+		Outcome:               model.LockerOpenOutcome(stored.Outcome),
+		Reason:                stored.Reason,
+		UpstreamTransactionID: stored.UpstreamTransactionID,
+		TraceID:               stored.TraceID,
+	}
+}
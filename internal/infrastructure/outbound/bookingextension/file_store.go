@@ -0,0 +1,187 @@
+package bookingextension
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// extensionRecord es una extensión pendiente en el archivo
+type extensionRecord struct {
+	PurchaseOrder   string    `json:"purchaseOrder"`
+	ServiceName     string    `json:"serviceName"`
+	CurrentCode     string    `json:"currentCode"`
+	BookingTimeID   int       `json:"bookingTimeId"`
+	BookingTimeName string    `json:"bookingTimeName"`
+	UnitMeasurement string    `json:"unitMeasurement"`
+	Amount          int       `json:"amount"`
+	TraceID         string    `json:"traceId,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// FileStore guarda las extensiones pendientes en un archivo JSON. Cada cambio reescribe el
+// archivo completo en uno temporal que luego lo reemplaza, de modo que una escritura
+// interrumpida nunca deja el archivo a medias. Las extensiones pendientes son pocas y de corta
+// vida, por lo que reescribirlas completas es barato.
+type FileStore struct {
+	mu         sync.Mutex
+	path       string
+	extensions map[string]model.PendingBookingExtension
+}
+
+// OpenFileStore abre (o crea) el archivo de extensiones pendientes y carga su contenido
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{
+		path:       path,
+		extensions: make(map[string]model.PendingBookingExtension),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read booking extension file: %w", err)
+	}
+
+	if len(data) > 0 {
+		var records []extensionRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to decode booking extension file: %w", err)
+		}
+		for _, record := range records {
+			store.extensions[record.PurchaseOrder] = record.toModel()
+		}
+	}
+
+	slog.Info("🧾 Pending booking extensions loaded", "file", path, "pending", len(store.extensions))
+	return store, nil
+}
+
+// Save implementa ports.PendingBookingExtensionStore
+func (s *FileStore) Save(ctx context.Context, extension model.PendingBookingExtension) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, existed := s.extensions[extension.PurchaseOrder]
+	s.extensions[extension.PurchaseOrder] = extension
+	if err := s.persist(); err != nil {
+		if existed {
+			s.extensions[extension.PurchaseOrder] = previous
+		} else {
+			delete(s.extensions, extension.PurchaseOrder)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete implementa ports.PendingBookingExtensionStore
+func (s *FileStore) Delete(ctx context.Context, purchaseOrder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.extensions[purchaseOrder]
+	if !ok {
+		return nil
+	}
+
+	delete(s.extensions, purchaseOrder)
+	if err := s.persist(); err != nil {
+		s.extensions[purchaseOrder] = previous
+		return err
+	}
+	return nil
+}
+
+// List implementa ports.PendingBookingExtensionStore
+func (s *FileStore) List(ctx context.Context) ([]model.PendingBookingExtension, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	extensions := make([]model.PendingBookingExtension, 0, len(s.extensions))
+	for _, extension := range s.extensions {
+		extensions = append(extensions, extension)
+	}
+	return extensions, nil
+}
+
+// persist escribe las extensiones en un archivo temporal, lo sincroniza a disco y reemplaza el archivo
+func (s *FileStore) persist() error {
+	records := make([]extensionRecord, 0, len(s.extensions))
+	for _, extension := range s.extensions {
+		records = append(records, toExtensionRecord(extension))
+	}
+	slices.SortFunc(records, func(a, b extensionRecord) int {
+		return strings.Compare(a.PurchaseOrder, b.PurchaseOrder)
+	})
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode booking extensions: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create booking extension file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write booking extension file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync booking extension file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close booking extension file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace booking extension file: %w", err)
+	}
+	return nil
+}
+
+// toExtensionRecord convierte la extensión del modelo en su registro del archivo
+func toExtensionRecord(extension model.PendingBookingExtension) extensionRecord {
+	return extensionRecord{
+		PurchaseOrder:   extension.PurchaseOrder,
+		ServiceName:     extension.ServiceName,
+		CurrentCode:     extension.CurrentCode,
+		BookingTimeID:   extension.BookingTime.ID,
+		BookingTimeName: extension.BookingTime.Name,
+		UnitMeasurement: string(extension.BookingTime.UnitMeasurement),
+		Amount:          extension.BookingTime.Amount,
+		TraceID:         extension.TraceID,
+		CreatedAt:       extension.CreatedAt,
+	}
+}
+
+// toModel convierte el registro del archivo en la extensión del modelo
+func (r extensionRecord) toModel() model.PendingBookingExtension {
+	return model.PendingBookingExtension{
+		PurchaseOrder: r.PurchaseOrder,
+		ServiceName:   r.ServiceName,
+		CurrentCode:   r.CurrentCode,
+		BookingTime: model.PaymentBookingTime{
+			ID:              r.BookingTimeID,
+			Name:            r.BookingTimeName,
+			UnitMeasurement: model.UnitMeasurement(r.UnitMeasurement),
+			Amount:          r.Amount,
+		},
+		TraceID:   r.TraceID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// Asegurar que FileStore implementa PendingBookingExtensionStore
+var _ ports.PendingBookingExtensionStore = (*FileStore)(nil)
@@ -0,0 +1,51 @@
+package bookingextension
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"sync"
+)
+
+// MemoryStore guarda las extensiones pendientes en memoria; se pierden al reiniciar.
+// Pensado para pruebas y desarrollo local.
+type MemoryStore struct {
+	mu         sync.Mutex
+	extensions map[string]model.PendingBookingExtension
+}
+
+// NewMemoryStore crea un almacenamiento de extensiones pendientes en memoria
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{extensions: make(map[string]model.PendingBookingExtension)}
+}
+
+// Save implementa ports.PendingBookingExtensionStore
+func (s *MemoryStore) Save(ctx context.Context, extension model.PendingBookingExtension) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.extensions[extension.PurchaseOrder] = extension
+	return nil
+}
+
+// Delete implementa ports.PendingBookingExtensionStore
+func (s *MemoryStore) Delete(ctx context.Context, purchaseOrder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.extensions, purchaseOrder)
+	return nil
+}
+
+// List implementa ports.PendingBookingExtensionStore
+func (s *MemoryStore) List(ctx context.Context) ([]model.PendingBookingExtension, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	extensions := make([]model.PendingBookingExtension, 0, len(s.extensions))
+	for _, extension := range s.extensions {
+		extensions = append(extensions, extension)
+	}
+	return extensions, nil
+}
+
+// Asegurar que MemoryStore implementa PendingBookingExtensionStore
+var _ ports.PendingBookingExtensionStore = (*MemoryStore)(nil)
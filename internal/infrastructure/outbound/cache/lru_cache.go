@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/model"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// entry es un valor en caché junto a su instante de almacenamiento
+type entry[V any] struct {
+	value    V
+	storedAt time.Time
+}
+
+// LRUCache es una caché en memoria con expulsión LRU, TTL y ventana de stale-while-revalidate
+type LRUCache[V any] struct {
+	entries  *lru.Cache[string, entry[V]]
+	ttl      time.Duration
+	staleTTL time.Duration
+	now      func() time.Time
+}
+
+// NewLRUCache crea una caché de hasta maxEntries entradas. Una entrada es vigente durante ttl
+// y puede servirse como obsoleta durante staleTTL adicional mientras se revalida.
+func NewLRUCache[V any](maxEntries int, ttl time.Duration, staleTTL time.Duration) (*LRUCache[V], error) {
+	entries, err := lru.New[string, entry[V]](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LRUCache[V]{
+		entries:  entries,
+		ttl:      ttl,
+		staleTTL: staleTTL,
+		now:      time.Now,
+	}, nil
+}
+
+// Get devuelve el valor y si está vigente, obsoleto o ausente
+func (c *LRUCache[V]) Get(key string) (V, ports.CacheLookup) {
+	var zero V
+
+	cached, ok := c.entries.Get(key)
+	if !ok {
+		return zero, ports.CacheMiss
+	}
+
+	age := c.now().Sub(cached.storedAt)
+	switch {
+	case age < c.ttl:
+		return cached.value, ports.CacheFresh
+	case age < c.ttl+c.staleTTL:
+		return cached.value, ports.CacheStale
+	default:
+		c.entries.Remove(key)
+		return zero, ports.CacheMiss
+	}
+}
+
+// Set guarda el valor como vigente
+func (c *LRUCache[V]) Set(key string, value V) {
+	c.entries.Add(key, entry[V]{value: value, storedAt: c.now()})
+}
+
+// Invalidate elimina la entrada e indica si existía
+func (c *LRUCache[V]) Invalidate(key string) bool {
+	return c.entries.Remove(key)
+}
+
+// Purge elimina todas las entradas y devuelve cuántas había
+func (c *LRUCache[V]) Purge() int {
+	count := c.entries.Len()
+	c.entries.Purge()
+	return count
+}
+
+// Asegurar que LRUCache implementa PaymentInfraCache
+var _ ports.PaymentInfraCache = (*LRUCache[*model.PaymentInfra])(nil)
@@ -0,0 +1,124 @@
+package coalesce
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// PaymentInfraRepository deduplica las lecturas concurrentes idénticas al backend.
+// Mientras una lectura está en curso, las llamadas con la misma operación y argumentos
+// normalizados esperan y comparten su resultado en lugar de repetir la llamada gRPC.
+// Las escrituras se delegan sin cambios.
+//
+// El resultado compartido es el mismo puntero para todos los llamadores, por lo que
+// no debe modificarse. El traceID no forma parte de la clave: los seguidores reciben
+// la respuesta de la llamada líder. El tenant sí, porque cada tenant puede tener su propio backend.
+type PaymentInfraRepository struct {
+	ports.PaymentInfraRepository
+
+	group   singleflight.Group
+	metrics ports.MetricsRecorder
+}
+
+// NewPaymentInfraRepository envuelve el repositorio con deduplicación de lecturas
+func NewPaymentInfraRepository(repo ports.PaymentInfraRepository, metrics ports.MetricsRecorder) *PaymentInfraRepository {
+	return &PaymentInfraRepository{
+		PaymentInfraRepository: repo,
+		metrics:                metrics,
+	}
+}
+
+// GetPaymentInfraByQrValue implementa PaymentInfraRepository.GetPaymentInfraByQrValue con deduplicación
+func (r *PaymentInfraRepository) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	key := normalize(qrValue)
+	return coalesce(r, ctx, "get_payment_infra_by_qr_value", key, func(ctx context.Context) (*model.PaymentInfra, error) {
+		return r.PaymentInfraRepository.GetPaymentInfraByQrValue(ctx, qrValue)
+	})
+}
+
+// GetAvailableLockers implementa PaymentInfraRepository.GetAvailableLockers con deduplicación
+func (r *PaymentInfraRepository) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
+	key := fmt.Sprintf("%d|%d", paymentRackID, bookingTimeID)
+	return coalesce(r, ctx, "get_available_lockers", key, func(ctx context.Context) (*model.AvailableLockers, error) {
+		return r.PaymentInfraRepository.GetAvailableLockers(ctx, paymentRackID, bookingTimeID, traceID)
+	})
+}
+
+// ValidateDiscountCoupon implementa PaymentInfraRepository.ValidateDiscountCoupon con deduplicación
+func (r *PaymentInfraRepository) ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error) {
+	key := fmt.Sprintf("%s|%d", normalize(couponCode), rackID)
+	return coalesce(r, ctx, "validate_discount_coupon", key, func(ctx context.Context) (*model.DiscountCouponValidation, error) {
+		return r.PaymentInfraRepository.ValidateDiscountCoupon(ctx, couponCode, rackID, traceID)
+	})
+}
+
+// GetPurchaseOrderByPo implementa PaymentInfraRepository.GetPurchaseOrderByPo con deduplicación
+func (r *PaymentInfraRepository) GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error) {
+	key := normalize(purchaseOrder)
+	return coalesce(r, ctx, "get_purchase_order_by_po", key, func(ctx context.Context) (*model.PurchaseOrderData, error) {
+		return r.PaymentInfraRepository.GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+	})
+}
+
+// CheckBookingStatus implementa PaymentInfraRepository.CheckBookingStatus con deduplicación
+func (r *PaymentInfraRepository) CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error) {
+	key := normalize(serviceName) + "|" + normalize(currentCode)
+	return coalesce(r, ctx, "check_booking_status", key, func(ctx context.Context) (*model.BookingStatusCheck, error) {
+		return r.PaymentInfraRepository.CheckBookingStatus(ctx, serviceName, currentCode)
+	})
+}
+
+// GetBookingExtensionOptions implementa PaymentInfraRepository.GetBookingExtensionOptions con deduplicación
+func (r *PaymentInfraRepository) GetBookingExtensionOptions(ctx context.Context, serviceName string, currentCode string, traceID string) (*model.BookingExtensionOptions, error) {
+	key := normalize(serviceName) + "|" + normalize(currentCode)
+	return coalesce(r, ctx, "get_booking_extension_options", key, func(ctx context.Context) (*model.BookingExtensionOptions, error) {
+		return r.PaymentInfraRepository.GetBookingExtensionOptions(ctx, serviceName, currentCode, traceID)
+	})
+}
+
+// coalesce ejecuta fn una sola vez por clave entre las llamadas concurrentes. La llamada
+// líder no se cancela si su llamador abandona; cada llamador deja de esperar con su propio ctx.
+func coalesce[T any](r *PaymentInfraRepository, ctx context.Context, operation string, key string, fn func(ctx context.Context) (T, error)) (T, error) {
+	r.record("repository_coalesce_calls_total", operation)
+
+	leader := false
+	results := r.group.DoChan(operation+"|"+tenancy.Key(ctx, key), func() (any, error) {
+		leader = true
+		return fn(context.WithoutCancel(ctx))
+	})
+
+	select {
+	case result := <-results:
+		if !leader {
+			r.record("repository_coalesce_shared_total", operation)
+		}
+		value, _ := result.Val.(T)
+		return value, result.Err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// record incrementa el contador total y el de la operación
+func (r *PaymentInfraRepository) record(name string, operation string) {
+	if r.metrics == nil {
+		return
+	}
+	r.metrics.IncCounter(name)
+	r.metrics.IncCounter(name + "_" + operation)
+}
+
+// normalize normaliza un argumento de texto para la clave de deduplicación
+func normalize(value string) string {
+	return strings.TrimSpace(value)
+}
+
+// Asegurar que PaymentInfraRepository implementa ports.PaymentInfraRepository
+var _ ports.PaymentInfraRepository = (*PaymentInfraRepository)(nil)
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// subscriberBuffer es la capacidad del canal de cada suscriptor
+const subscriberBuffer = 8
+
+// PaymentEventBroker distribuye en memoria los eventos de resultado de pago
+type PaymentEventBroker struct {
+	mu          sync.RWMutex
+	nextID      uint64
+	subscribers map[uint64]*subscription
+}
+
+type subscription struct {
+	purchaseOrder string
+	// blocking hace que Publish espere a que haya espacio en el canal en vez de descartar el evento
+	blocking bool
+	ch       chan model.PaymentResultEvent
+	// done se cierra al cancelar la suscripción para liberar a un Publish en espera
+	done chan struct{}
+}
+
+// NewPaymentEventBroker crea un nuevo broker de eventos de pago
+func NewPaymentEventBroker() *PaymentEventBroker {
+	return &PaymentEventBroker{
+		subscribers: make(map[uint64]*subscription),
+	}
+}
+
+// Publish implementa PaymentEventPublisher.Publish. Si el canal de un suscriptor está lleno,
+// el evento se descarta para ese suscriptor, salvo en las suscripciones bloqueantes: para esas
+// se espera hasta que lo reciba, cancele la suscripción o se cancele ctx.
+func (b *PaymentEventBroker) Publish(ctx context.Context, event model.PaymentResultEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscribers {
+		if sub.purchaseOrder != "" && sub.purchaseOrder != event.PurchaseOrder {
+			continue
+		}
+
+		if sub.blocking {
+			select {
+			case sub.ch <- event:
+			case <-sub.done:
+			case <-ctx.Done():
+				slog.WarnContext(ctx, "⚠️ Payment event not delivered before cancellation", "purchaseOrder", event.PurchaseOrder, "error", ctx.Err())
+			}
+			continue
+		}
+
+		select {
+		case sub.ch <- event:
+		default:
+			slog.WarnContext(ctx, "⚠️ Payment event dropped for slow subscriber", "purchaseOrder", event.PurchaseOrder)
+		}
+	}
+}
+
+// Subscribe registra un suscriptor para los eventos de una orden de compra.
+// Con purchaseOrder vacío se reciben los eventos de todas las órdenes.
+// La función devuelta cancela la suscripción y cierra el canal.
+func (b *PaymentEventBroker) Subscribe(purchaseOrder string) (<-chan model.PaymentResultEvent, func()) {
+	return b.subscribe(purchaseOrder, false)
+}
+
+// SubscribeBlocking implementa PaymentEventSubscriber.SubscribeBlocking. El suscriptor debe
+// consumir el canal hasta cancelar la suscripción; mientras tanto, Publish lo espera.
+func (b *PaymentEventBroker) SubscribeBlocking(purchaseOrder string) (<-chan model.PaymentResultEvent, func()) {
+	return b.subscribe(purchaseOrder, true)
+}
+
+// subscribe registra la suscripción y devuelve su canal y la función que la cancela
+func (b *PaymentEventBroker) subscribe(purchaseOrder string, blocking bool) (<-chan model.PaymentResultEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	sub := &subscription{
+		purchaseOrder: purchaseOrder,
+		blocking:      blocking,
+		ch:            make(chan model.PaymentResultEvent, subscriberBuffer),
+		done:          make(chan struct{}),
+	}
+	b.subscribers[id] = sub
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			// Liberar primero a un Publish en espera, que mantiene el lock de lectura
+			close(sub.done)
+			b.mu.Lock()
+			delete(b.subscribers, id)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, unsubscribe
+}
+
+// Asegurar que PaymentEventBroker implementa PaymentEventPublisher
+var _ ports.PaymentEventPublisher = (*PaymentEventBroker)(nil)
+
+// Asegurar que PaymentEventBroker implementa PaymentEventSubscriber
+var _ ports.PaymentEventSubscriber = (*PaymentEventBroker)(nil)
@@ -0,0 +1,201 @@
+package featureflag
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/domain/model"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flagFile representa el archivo JSON de feature flags
+type flagFile struct {
+	Flags []flagRecord `json:"flags"`
+}
+
+type flagRecord struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	// Percentage ausente equivale a 100
+	Percentage      *int     `json:"percentage"`
+	Tenants         []string `json:"tenants"`
+	InstallationIDs []int    `json:"installationIds"`
+	RackIDs         []int    `json:"rackIds"`
+}
+
+// FileProvider lee los feature flags de un archivo JSON y lo vuelve a cargar cuando cambia. Si
+// el archivo nuevo es inválido se conservan los flags anteriores.
+type FileProvider struct {
+	path     string
+	interval time.Duration
+	metrics  ports.MetricsRecorder
+
+	mu          sync.RWMutex
+	flags       []model.FeatureFlag
+	lastModTime time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// OpenFileProvider carga el archivo de feature flags; falla si el archivo no es válido
+func OpenFileProvider(path string, interval time.Duration, metrics ports.MetricsRecorder) (*FileProvider, error) {
+	provider := &FileProvider{
+		path:     path,
+		interval: interval,
+		metrics:  metrics,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	if err := provider.Reload(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// FeatureFlags implementa ports.FeatureFlagProvider
+func (p *FileProvider) FeatureFlags() []model.FeatureFlag {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.flags
+}
+
+// Start comienza a observar el archivo de feature flags
+func (p *FileProvider) Start() {
+	go func() {
+		defer close(p.done)
+
+		if p.interval <= 0 {
+			<-p.stop
+			return
+		}
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if p.fileChanged() {
+					p.Reload()
+				}
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop deja de observar el archivo; requiere que Start se haya llamado
+func (p *FileProvider) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+	<-p.done
+}
+
+// Reload lee el archivo y reemplaza los flags; si es inválido se conservan los anteriores
+func (p *FileProvider) Reload() error {
+	info, err := os.Stat(p.path)
+	if err == nil {
+		p.mu.Lock()
+		p.lastModTime = info.ModTime()
+		p.mu.Unlock()
+	}
+
+	flags, err := LoadFile(p.path)
+	if err != nil {
+		slog.Error("❌ Feature flags reload rejected, keeping previous flags", "error", err)
+		p.metrics.IncCounter("feature_flags_reload_failures_total")
+		return err
+	}
+
+	p.mu.Lock()
+	p.flags = flags
+	p.mu.Unlock()
+
+	slog.Info("🚩 Feature flags loaded", "file", p.path, "flags", len(flags))
+	p.metrics.IncCounter("feature_flags_reload_success_total")
+	return nil
+}
+
+// fileChanged indica si el archivo se modificó desde la última carga
+func (p *FileProvider) fileChanged() bool {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return false
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return !info.ModTime().Equal(p.lastModTime)
+}
+
+// LoadFile lee y valida un archivo de feature flags
+func LoadFile(path string) ([]model.FeatureFlag, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feature flags file: %w", err)
+	}
+
+	var file flagFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse feature flags file %s: %w", path, err)
+	}
+
+	var errs []error
+	names := make(map[string]bool, len(file.Flags))
+	flags := make([]model.FeatureFlag, 0, len(file.Flags))
+
+	for _, record := range file.Flags {
+		name := strings.TrimSpace(record.Name)
+		switch {
+		case name == "":
+			errs = append(errs, errors.New("feature flag name must not be empty"))
+			continue
+		case names[name]:
+			errs = append(errs, fmt.Errorf("duplicated feature flag %q", name))
+			continue
+		}
+		names[name] = true
+
+		percentage := 100
+		if record.Percentage != nil {
+			percentage = *record.Percentage
+		}
+		if percentage < 0 || percentage > 100 {
+			errs = append(errs, fmt.Errorf("percentage of feature flag %q must be between 0 and 100, got %d", name, percentage))
+		}
+
+		for _, id := range slices.Concat(record.InstallationIDs, record.RackIDs) {
+			if id <= 0 {
+				errs = append(errs, fmt.Errorf("installation and rack ids of feature flag %q must be positive, got %d", name, id))
+				break
+			}
+		}
+
+		flags = append(flags, model.FeatureFlag{
+			Name:            name,
+			Description:     record.Description,
+			Enabled:         record.Enabled,
+			Percentage:      percentage,
+			Tenants:         record.Tenants,
+			InstallationIDs: record.InstallationIDs,
+			RackIDs:         record.RackIDs,
+		})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid feature flags file %s: %w", path, err)
+	}
+	return flags, nil
+}
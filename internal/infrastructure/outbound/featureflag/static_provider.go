@@ -0,0 +1,34 @@
+package featureflag
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"sync"
+)
+
+// StaticProvider entrega feature flags definidos en memoria, como los flags booleanos de
+// CONFIG_FILE; se reemplazan en cada recarga de la configuración
+type StaticProvider struct {
+	mu    sync.RWMutex
+	flags []model.FeatureFlag
+}
+
+// NewStaticProvider crea un proveedor con los flags indicados
+func NewStaticProvider(flags ...model.FeatureFlag) *StaticProvider {
+	provider := &StaticProvider{}
+	provider.Replace(flags...)
+	return provider
+}
+
+// Replace reemplaza atómicamente todos los flags
+func (p *StaticProvider) Replace(flags ...model.FeatureFlag) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.flags = flags
+}
+
+// FeatureFlags implementa ports.FeatureFlagProvider
+func (p *StaticProvider) FeatureFlags() []model.FeatureFlag {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.flags
+}
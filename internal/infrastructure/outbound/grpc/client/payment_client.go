@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -27,12 +29,19 @@ type PaymentServiceGRPCClient struct {
 	grpcClient    paymentpb.PaymentServiceClient
 	bookingClient bookingpb.BookingServiceClient
 	mapper        *mapper.PaymentInfraGRPCMapper
-	timeout       time.Duration
-	useMock       bool // Flag para determinar si usar mocks o cliente real
+	// Timeouts por llamada en nanosegundos; atómicos para permitir recarga en caliente
+	paymentTimeout atomic.Int64
+	bookingTimeout atomic.Int64
+	useMock        bool // Flag para determinar si usar mocks o cliente real
+	// flags decide por operación si responder con mocks cuando useMock está desactivado; puede ser nil
+	flags ports.FeatureFlags
+	// mockFlags permite los flags mock.*; se desactiva en producción
+	mockFlags  bool
+	mockOrders *mockOrderStore
 }
 
 // NewPaymentServiceGRPCClient crea un nuevo cliente gRPC para el servicio de pagos
-func NewPaymentServiceGRPCClient(paymentAddress string, bookingAddress string, timeout time.Duration, useMock bool) (*PaymentServiceGRPCClient, error) {
+func NewPaymentServiceGRPCClient(paymentAddress string, bookingAddress string, timeout time.Duration, useMock bool, mockFlags bool, flags ports.FeatureFlags) (*PaymentServiceGRPCClient, error) {
 	var conn *grpc.ClientConn
 	var bookingConn *grpc.ClientConn
 	var grpcClient paymentpb.PaymentServiceClient
@@ -45,6 +54,8 @@ func NewPaymentServiceGRPCClient(paymentAddress string, bookingAddress string, t
 		conn, err = grpc.Dial(
 			paymentAddress,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithUnaryInterceptor(traceUnaryInterceptor),
+			grpc.WithStreamInterceptor(traceStreamInterceptor),
 			grpc.WithBlock(),
 			grpc.WithTimeout(timeout),
 		)
@@ -58,6 +69,8 @@ func NewPaymentServiceGRPCClient(paymentAddress string, bookingAddress string, t
 		bookingConn, err = grpc.Dial(
 			bookingAddress,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithUnaryInterceptor(traceUnaryInterceptor),
+			grpc.WithStreamInterceptor(traceStreamInterceptor),
 			grpc.WithBlock(),
 			grpc.WithTimeout(timeout),
 		)
@@ -71,21 +84,64 @@ func NewPaymentServiceGRPCClient(paymentAddress string, bookingAddress string, t
 		log.Printf("🧪 Using MOCK mode for Payment and Booking Services (no real connection)")
 	}
 
-	return &PaymentServiceGRPCClient{
+	client := &PaymentServiceGRPCClient{
 		conn:          conn,
 		bookingConn:   bookingConn,
 		grpcClient:    grpcClient,
 		bookingClient: bookingClient,
 		mapper:        mapper.NewPaymentInfraGRPCMapper(),
-		timeout:       timeout,
 		useMock:       useMock,
-	}, nil
+		flags:         flags,
+		mockFlags:     mockFlags,
+		mockOrders:    newMockOrderStore(),
+	}
+	client.SetTimeouts(timeout, timeout)
+
+	return client, nil
+}
+
+// SetTimeouts actualiza los timeouts por llamada de los servicios de pagos y reservas
+func (c *PaymentServiceGRPCClient) SetTimeouts(paymentTimeout time.Duration, bookingTimeout time.Duration) {
+	c.paymentTimeout.Store(int64(paymentTimeout))
+	c.bookingTimeout.Store(int64(bookingTimeout))
+}
+
+// paymentCallTimeout devuelve el timeout vigente para llamadas al servicio de pagos
+func (c *PaymentServiceGRPCClient) paymentCallTimeout() time.Duration {
+	return time.Duration(c.paymentTimeout.Load())
+}
+
+// bookingCallTimeout devuelve el timeout vigente para llamadas al servicio de reservas
+func (c *PaymentServiceGRPCClient) bookingCallTimeout() time.Duration {
+	return time.Duration(c.bookingTimeout.Load())
+}
+
+// mocked indica si la operación responde con mocks: siempre con USE_MOCK y, si no, cuando está
+// activo su feature flag mock.<operación> fuera de producción
+func (c *PaymentServiceGRPCClient) mocked(ctx context.Context, operation string, rackID int) bool {
+	if c.useMock {
+		return true
+	}
+
+	flag := model.MockFeatureFlag(operation)
+	if c.flags == nil || !c.flags.Enabled(ctx, flag, model.FeatureFlagSubject{RackID: rackID}, false) {
+		return false
+	}
+
+	// El archivo de flags se recarga en caliente, así que la validación de la configuración no basta
+	if !c.mockFlags {
+		slog.WarnContext(ctx, "🚫 Mock feature flag ignored in production", "operation", operation, "flag", flag)
+		return false
+	}
+
+	slog.InfoContext(ctx, "🧪 Using MOCK by feature flag", "operation", operation, "flag", flag)
+	return true
 }
 
 // GetPaymentInfraByQrValue implementa PaymentInfraRepository.GetPaymentInfraByQrValue
 func (c *PaymentServiceGRPCClient) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
 	// Crear contexto con timeout
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	// Crear request
@@ -94,7 +150,7 @@ func (c *PaymentServiceGRPCClient) GetPaymentInfraByQrValue(ctx context.Context,
 	var response *dto.GetPaymentInfraByQrValueResponse
 
 	// Usar mock o llamada real según configuración
-	if c.useMock {
+	if c.mocked(ctx, "getPaymentInfraByQrValue", 0) {
 		response = c.mockGetPaymentInfraByQrValue(request)
 	} else {
 		// Llamada real al servicio gRPC
@@ -104,7 +160,7 @@ func (c *PaymentServiceGRPCClient) GetPaymentInfraByQrValue(ctx context.Context,
 
 		grpcResponse, err := c.grpcClient.GetPaymentInfraByQrValue(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ gRPC call failed: %v", err)
+			slog.ErrorContext(ctx, "❌ gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -127,7 +183,7 @@ func (c *PaymentServiceGRPCClient) GetPaymentInfraByQrValue(ctx context.Context,
 
 // GetAvailableLockers implementa PaymentInfraRepository.GetAvailableLockers
 func (c *PaymentServiceGRPCClient) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToGetAvailableLockersRequest(paymentRackID, bookingTimeID, traceID)
@@ -135,7 +191,7 @@ func (c *PaymentServiceGRPCClient) GetAvailableLockers(ctx context.Context, paym
 	var response *dto.GetAvailableLockersResponse
 
 	// Usar mock o llamada real según configuración
-	if c.useMock {
+	if c.mocked(ctx, "getAvailableLockers", paymentRackID) {
 		response = c.mockGetAvailableLockers(request)
 	} else {
 		// Llamada real al servicio gRPC con el método correcto del proto
@@ -147,7 +203,7 @@ func (c *PaymentServiceGRPCClient) GetAvailableLockers(ctx context.Context, paym
 
 		grpcResponse, err := c.grpcClient.GetAvailableLockersByRackIDAndBookingTime(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ gRPC call failed: %v", err)
+			slog.ErrorContext(ctx, "❌ gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -168,7 +224,7 @@ func (c *PaymentServiceGRPCClient) GetAvailableLockers(ctx context.Context, paym
 
 // ValidateDiscountCoupon implementa PaymentInfraRepository.ValidateDiscountCoupon
 func (c *PaymentServiceGRPCClient) ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToValidateCouponRequest(couponCode, rackID, traceID)
@@ -176,7 +232,7 @@ func (c *PaymentServiceGRPCClient) ValidateDiscountCoupon(ctx context.Context, c
 	var response *dto.ValidateDiscountCouponResponse
 
 	// Usar mock o llamada real según configuración
-	if c.useMock {
+	if c.mocked(ctx, "validateDiscountCoupon", rackID) {
 		response = c.mockValidateCoupon(request)
 	} else {
 		// Llamada real al servicio gRPC
@@ -188,7 +244,7 @@ func (c *PaymentServiceGRPCClient) ValidateDiscountCoupon(ctx context.Context, c
 
 		grpcResponse, err := c.grpcClient.ValidateDiscountCoupon(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ ValidateDiscountCoupon gRPC call failed: %v", err)
+			slog.ErrorContext(ctx, "❌ ValidateDiscountCoupon gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -209,7 +265,7 @@ func (c *PaymentServiceGRPCClient) ValidateDiscountCoupon(ctx context.Context, c
 
 // GeneratePurchaseOrder implementa PaymentInfraRepository.GeneratePurchaseOrder
 func (c *PaymentServiceGRPCClient) GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToGeneratePurchaseOrderRequest(rackIdReference, groupID, couponCode, userEmail, userPhone, traceID, gatewayName)
@@ -219,14 +275,13 @@ func (c *PaymentServiceGRPCClient) GeneratePurchaseOrder(ctx context.Context, ra
 	if request.CouponCode != nil {
 		couponCodeValue = fmt.Sprintf("\"%s\"", *request.CouponCode)
 	}
-	log.Printf("🔵 GeneratePurchaseOrder - Request: rackId=%d, groupId=%d, couponCode=%s, email=%s, phone=%s, traceId=%s, gateway=%s",
-		request.RackIdReference, request.GroupId, couponCodeValue, request.UserEmail, request.UserPhone, request.TraceId, request.GatewayName)
+	slog.DebugContext(ctx, "🔵 GeneratePurchaseOrder - Request", "rackId", request.RackIdReference, "groupId", request.GroupId, "couponCode", couponCodeValue, "email", model.MaskEmail(request.UserEmail), "phone", model.MaskCode(request.UserPhone), "traceId", request.TraceId, "gateway", request.GatewayName)
 
 	var response *dto.GeneratePurchaseOrderResponse
 
 	// Usar mock o llamada real según configuración
-	if c.useMock {
-		log.Printf("🟡 Using MOCK mode for GeneratePurchaseOrder")
+	if c.mocked(ctx, "generatePurchaseOrder", rackIdReference) {
+		slog.DebugContext(ctx, "🟡 Using MOCK mode for GeneratePurchaseOrder")
 		response = c.mockGeneratePurchaseOrder(request)
 	} else {
 		// Llamada real al servicio gRPC
@@ -240,37 +295,36 @@ func (c *PaymentServiceGRPCClient) GeneratePurchaseOrder(ctx context.Context, ra
 			GatewayName:     request.GatewayName,
 		}
 
-		log.Printf("🟢 Calling real gRPC service for GeneratePurchaseOrder")
+		slog.DebugContext(ctx, "🟢 Calling real gRPC service for GeneratePurchaseOrder")
 		grpcResponse, err := c.grpcClient.GeneratePurchaseOrder(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ GeneratePurchaseOrder gRPC call failed: %v", err)
-			log.Printf("❌ Error details - Type: %T, Message: %s", err, err.Error())
+			slog.ErrorContext(ctx, "❌ GeneratePurchaseOrder gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
-		log.Printf("✅ GeneratePurchaseOrder gRPC call succeeded")
+		slog.DebugContext(ctx, "✅ GeneratePurchaseOrder gRPC call succeeded")
 		// Mapear respuesta de gRPC a DTO
 		response = c.mapper.FromGRPCGeneratePurchaseOrderResponse(grpcResponse)
 	}
 
 	if response == nil {
-		log.Printf("❌ GeneratePurchaseOrder - Response is nil")
+		slog.InfoContext(ctx, "❌ GeneratePurchaseOrder - Response is nil")
 		return nil, exception.ErrPaymentInfraServiceUnavailable
 	}
 
 	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
-		log.Printf("❌ GeneratePurchaseOrder - Response status is ERROR: %s", response.Response.Message)
+		slog.ErrorContext(ctx, "❌ GeneratePurchaseOrder - Response status is ERROR", "error", response.Response.Message)
 		return nil, exception.ErrPurchaseOrderFailed
 	}
 
-	log.Printf("✅ GeneratePurchaseOrder - Success: transactionId=%s, url=%s", response.Response.TransactionId, response.Url)
+	slog.InfoContext(ctx, "✅ GeneratePurchaseOrder - Success", "transactionId", response.Response.TransactionId, "url", response.Url)
 
 	return c.mapper.ToPurchaseOrderDomain(response), nil
 }
 
 // GenerateBooking implementa PaymentInfraRepository.GenerateBooking
 func (c *PaymentServiceGRPCClient) GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToGenerateBookingRequest(rackIdReference, groupID, couponCode, userEmail, userPhone, traceID)
@@ -278,7 +332,7 @@ func (c *PaymentServiceGRPCClient) GenerateBooking(ctx context.Context, rackIdRe
 	var response *dto.GenerateBookingResponse
 
 	// Usar mock o llamada real según configuración
-	if c.useMock {
+	if c.mocked(ctx, "generateBooking", rackIdReference) {
 		response = c.mockGenerateBooking(request)
 	} else {
 		// Llamada real al servicio gRPC
@@ -293,7 +347,7 @@ func (c *PaymentServiceGRPCClient) GenerateBooking(ctx context.Context, rackIdRe
 
 		grpcResponse, err := c.grpcClient.GenerateBooking(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ GenerateBooking gRPC call failed: %v", err)
+			slog.ErrorContext(ctx, "❌ GenerateBooking gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -314,7 +368,7 @@ func (c *PaymentServiceGRPCClient) GenerateBooking(ctx context.Context, rackIdRe
 
 // GetPurchaseOrderByPo implementa PaymentInfraRepository.GetPurchaseOrderByPo
 func (c *PaymentServiceGRPCClient) GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToGetPurchaseOrderByPoRequest(purchaseOrder, traceID)
@@ -333,16 +387,190 @@ func (c *PaymentServiceGRPCClient) GetPurchaseOrderByPo(ctx context.Context, pur
 	return c.mapper.ToPurchaseOrderDataDomain(response), nil
 }
 
+// UpdatePurchaseOrderStatus implementa PaymentInfraRepository.UpdatePurchaseOrderStatus
+func (c *PaymentServiceGRPCClient) UpdatePurchaseOrderStatus(ctx context.Context, purchaseOrder string, status model.PurchaseOrderStatus, traceID string) (*model.PurchaseOrderData, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
+	defer cancel()
+
+	request := c.mapper.ToUpdatePurchaseOrderStatusRequest(purchaseOrder, status, traceID)
+
+	slog.DebugContext(ctx, "UpdatePurchaseOrderStatus - Request", "purchaseOrder", purchaseOrder, "status", status, "traceId", traceID)
+
+	// El payment-manager aún no expone la actualización de estado por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "updatePurchaseOrderStatus", 0) {
+		slog.ErrorContext(ctx, "❌ UpdatePurchaseOrderStatus - Not exposed by payment service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockUpdatePurchaseOrderStatus(request)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ UpdatePurchaseOrderStatus - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrPurchaseOrderNotFound
+	}
+
+	return c.mapper.ToPurchaseOrderDataDomain(response), nil
+}
+
+// CancelBooking implementa PaymentInfraRepository.CancelBooking
+func (c *PaymentServiceGRPCClient) CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
+	defer cancel()
+
+	grpcRequest := c.mapper.ToCancelBookingRequest(request)
+
+	slog.DebugContext(ctx, "CancelBooking - Request", "serviceName", request.ServiceName, "currentCode", request.CurrentCode, "purchaseOrder", request.PurchaseOrder, "reason", request.Reason, "traceId", request.TraceID)
+
+	// El booking-manager aún no expone la cancelación por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "cancelBooking", 0) {
+		slog.ErrorContext(ctx, "❌ CancelBooking - Not exposed by booking service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockCancelBooking(grpcRequest)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ CancelBooking - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrBookingNotFound
+	}
+
+	slog.InfoContext(ctx, "✅ CancelBooking - Success", "bookingId", response.BookingId, "purchaseOrder", response.PurchaseOrder)
+
+	return c.mapper.ToBookingCancellationDomain(response), nil
+}
+
+// RequestRefund implementa PaymentInfraRepository.RequestRefund
+func (c *PaymentServiceGRPCClient) RequestRefund(ctx context.Context, request model.RefundRequest) (*model.Refund, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
+	defer cancel()
+
+	grpcRequest := c.mapper.ToRefundRequest(request)
+
+	slog.DebugContext(ctx, "RequestRefund - Request", "purchaseOrder", request.PurchaseOrder, "amount", request.Amount, "traceId", request.TraceID)
+
+	// El payment-manager aún no expone los reembolsos por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "requestRefund", 0) {
+		slog.ErrorContext(ctx, "❌ RequestRefund - Not exposed by payment service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockRequestRefund(grpcRequest)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ RequestRefund - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrRefundFailed
+	}
+
+	slog.InfoContext(ctx, "✅ RequestRefund - Success", "refundId", response.RefundId, "status", response.Status)
+
+	return c.mapper.ToRefundDomain(response), nil
+}
+
+// GetBookingExtensionOptions implementa PaymentInfraRepository.GetBookingExtensionOptions
+func (c *PaymentServiceGRPCClient) GetBookingExtensionOptions(ctx context.Context, serviceName string, currentCode string, traceID string) (*model.BookingExtensionOptions, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
+	defer cancel()
+
+	request := c.mapper.ToGetBookingExtensionOptionsRequest(serviceName, currentCode, traceID)
+
+	slog.DebugContext(ctx, "GetBookingExtensionOptions - Request", "serviceName", serviceName, "currentCode", currentCode, "traceId", traceID)
+
+	// El booking-manager aún no expone la cotización de extensiones por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "getBookingExtensionOptions", 0) {
+		slog.ErrorContext(ctx, "❌ GetBookingExtensionOptions - Not exposed by booking service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockGetBookingExtensionOptions(request)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ GetBookingExtensionOptions - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrBookingNotFound
+	}
+
+	return c.mapper.ToBookingExtensionOptionsDomain(response), nil
+}
+
+// GenerateBookingExtensionOrder implementa PaymentInfraRepository.GenerateBookingExtensionOrder
+func (c *PaymentServiceGRPCClient) GenerateBookingExtensionOrder(ctx context.Context, request model.BookingExtensionOrderRequest) (*model.BookingExtensionOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.paymentCallTimeout())
+	defer cancel()
+
+	grpcRequest := c.mapper.ToGenerateBookingExtensionOrderRequest(request)
+
+	slog.DebugContext(ctx, "GenerateBookingExtensionOrder - Request", "bookingId", request.BookingID, "bookingTimeId", request.BookingTimeID, "amount", request.Amount, "gatewayName", request.GatewayName, "traceId", request.TraceID)
+
+	// El payment-manager aún no expone órdenes ligadas a reservas existentes por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "generateBookingExtensionOrder", 0) {
+		slog.ErrorContext(ctx, "❌ GenerateBookingExtensionOrder - Not exposed by payment service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockGenerateBookingExtensionOrder(grpcRequest)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ GenerateBookingExtensionOrder - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrPurchaseOrderFailed
+	}
+
+	slog.InfoContext(ctx, "✅ GenerateBookingExtensionOrder - Success", "purchaseOrder", response.PurchaseOrder, "url", response.Url)
+
+	return c.mapper.ToBookingExtensionOrderDomain(response), nil
+}
+
+// UpdateBookingFinish implementa PaymentInfraRepository.UpdateBookingFinish
+func (c *PaymentServiceGRPCClient) UpdateBookingFinish(ctx context.Context, serviceName string, currentCode string, finishBooking time.Time, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
+	defer cancel()
+
+	request := c.mapper.ToUpdateBookingFinishRequest(serviceName, currentCode, finishBooking, purchaseOrder, traceID)
+
+	slog.DebugContext(ctx, "UpdateBookingFinish - Request", "serviceName", serviceName, "finishBooking", request.FinishBooking, "purchaseOrder", purchaseOrder, "traceId", traceID)
+
+	// El booking-manager aún no expone la actualización del término por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "updateBookingFinish", 0) {
+		slog.ErrorContext(ctx, "❌ UpdateBookingFinish - Not exposed by booking service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockUpdateBookingFinish(request)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		slog.ErrorContext(ctx, "❌ UpdateBookingFinish - Response status is ERROR", "error", response.Response.Message)
+		return nil, exception.ErrBookingNotFound
+	}
+
+	return c.mapper.ToBookingStatusDomain(response), nil
+}
+
 // CheckBookingStatus implementa PaymentInfraRepository.CheckBookingStatus
 func (c *PaymentServiceGRPCClient) CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToCheckBookingStatusRequest(serviceName, currentCode)
 
 	var response *dto.CheckBookingStatusResponse
 
-	if c.useMock {
+	if c.mocked(ctx, "checkBookingStatus", 0) {
 		response = c.mockCheckBookingStatus(request)
 	} else {
 		// Llamada real al servicio gRPC de Booking
@@ -353,7 +581,7 @@ func (c *PaymentServiceGRPCClient) CheckBookingStatus(ctx context.Context, servi
 
 		grpcResponse, err := c.bookingClient.CheckBookingStatus(ctx, grpcRequest)
 		if err != nil {
-			log.Printf("❌ Booking gRPC call failed: %v", err)
+			slog.ErrorContext(ctx, "❌ Booking gRPC call failed", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -372,28 +600,55 @@ func (c *PaymentServiceGRPCClient) CheckBookingStatus(ctx context.Context, servi
 	return c.mapper.ToBookingStatusDomain(response), nil
 }
 
+// GetBooking implementa PaymentInfraRepository.GetBooking
+func (c *PaymentServiceGRPCClient) GetBooking(ctx context.Context, currentCode string, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
+	defer cancel()
+
+	request := c.mapper.ToGetBookingRequest(currentCode, purchaseOrder, traceID)
+
+	slog.DebugContext(ctx, "GetBooking - Request", "currentCode", model.MaskCode(currentCode), "purchaseOrder", purchaseOrder, "traceId", traceID)
+
+	// El booking-manager aún no expone la consulta por código u orden de compra por gRPC: solo hay mock y, sin él, se falla cerrado
+	if !c.mocked(ctx, "getBooking", 0) {
+		slog.ErrorContext(ctx, "❌ GetBooking - Not exposed by booking service")
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+	response := c.mockGetBooking(request)
+
+	if response == nil {
+		return nil, exception.ErrPaymentInfraServiceUnavailable
+	}
+
+	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
+		return nil, exception.ErrBookingNotFound
+	}
+
+	return c.mapper.ToBookingStatusDomain(response), nil
+}
+
 // ExecuteOpen implementa PaymentInfraRepository.ExecuteOpen
 func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.bookingCallTimeout())
 	defer cancel()
 
 	request := c.mapper.ToExecuteOpenRequest(serviceName, currentCode)
 
-	log.Printf("ExecuteOpen - Request: serviceName=%s, currentCode=%s", serviceName, currentCode)
+	slog.DebugContext(ctx, "ExecuteOpen - Request", "serviceName", serviceName, "currentCode", currentCode)
 
 	var response *dto.ExecuteOpenResponse
 
-	if c.useMock {
-		log.Printf("Using MOCK mode for ExecuteOpen")
+	if c.mocked(ctx, "executeOpen", 0) {
+		slog.DebugContext(ctx, "Using MOCK mode for ExecuteOpen")
 		response = c.mockExecuteOpen(request)
 	} else {
 		// ExecuteOpen es un stream bidireccional en el proto del servicio de booking
 		// Implementamos versión simplificada: enviar un mensaje y recibir respuestas hasta completar
-		log.Printf("📞 Calling gRPC streaming service for ExecuteOpen")
+		slog.DebugContext(ctx, "📞 Calling gRPC streaming service for ExecuteOpen")
 
 		stream, err := c.bookingClient.ExecuteOpen(ctx)
 		if err != nil {
-			log.Printf("❌ ExecuteOpen failed to create stream: %v", err)
+			slog.ErrorContext(ctx, "❌ ExecuteOpen failed to create stream", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
@@ -404,19 +659,20 @@ func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName
 		}
 
 		if err := stream.Send(grpcRequest); err != nil {
-			log.Printf("❌ ExecuteOpen failed to send request: %v", err)
+			slog.ErrorContext(ctx, "❌ ExecuteOpen failed to send request", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
 		// Cerrar el envío para indicar que no enviaremos más
 		if err := stream.CloseSend(); err != nil {
-			log.Printf("❌ ExecuteOpen failed to close send: %v", err)
+			slog.ErrorContext(ctx, "❌ ExecuteOpen failed to close send", "error", err)
 			return nil, c.mapGRPCError(err)
 		}
 
 		// Recibir la(s) respuesta(s) del stream
 		// Para simplificar en GraphQL, tomamos la última respuesta recibida
 		var lastResponse *bookingpb.ExecuteOpenResponse
+		var receivedStatuses []dto.OpenStatus
 		for {
 			resp, err := stream.Recv()
 			if err != nil {
@@ -426,15 +682,16 @@ func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName
 				}
 				// Si ya recibimos al menos una respuesta, preferimos usarla
 				if lastResponse != nil {
-					log.Printf("⚠️ ExecuteOpen stream recv error after responses: %v", err)
+					slog.WarnContext(ctx, "⚠️ ExecuteOpen stream recv error after responses", "error", err)
 					break
 				}
-				log.Printf("❌ ExecuteOpen failed to receive: %v", err)
+				slog.ErrorContext(ctx, "❌ ExecuteOpen failed to receive", "error", err)
 				return nil, c.mapGRPCError(err)
 			}
 
 			lastResponse = resp
-			log.Printf("📥 ExecuteOpen received status: %v", resp.Status)
+			receivedStatuses = append(receivedStatuses, dto.OpenStatus(resp.Status))
+			slog.DebugContext(ctx, "📥 ExecuteOpen received status", "status", resp.Status)
 
 			// Si recibimos un estado terminal, salimos inmediatamente para devolver resultado rápido.
 			switch resp.Status {
@@ -442,7 +699,7 @@ func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName
 				bookingpb.OpenStatus_OPEN_STATUS_EXECUTED,
 				bookingpb.OpenStatus_OPEN_STATUS_ERROR,
 				bookingpb.OpenStatus_OPEN_STATUS_SUCCESS:
-				log.Printf("🤖 ExecuteOpen received terminal status: %v", resp.Status)
+				slog.DebugContext(ctx, "🤖 ExecuteOpen received terminal status", "status", resp.Status)
 				// usamos lastResponse y dejamos el loop
 				goto STREAM_DONE
 			}
@@ -450,7 +707,7 @@ func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName
 	STREAM_DONE:
 
 		if lastResponse == nil {
-			log.Printf("❌ ExecuteOpen - No response received from stream")
+			slog.InfoContext(ctx, "❌ ExecuteOpen - No response received from stream")
 			return nil, exception.ErrPaymentInfraServiceUnavailable
 		}
 
@@ -463,32 +720,32 @@ func (c *PaymentServiceGRPCClient) ExecuteOpen(ctx context.Context, serviceName
 		}
 
 		response = &dto.ExecuteOpenResponse{
-			Status:   dto.OpenStatus(lastResponse.Status),
-			Response: genericResp,
+			Status:           dto.OpenStatus(lastResponse.Status),
+			Response:         genericResp,
+			ReceivedStatuses: receivedStatuses,
 		}
 
-		log.Printf("📡 ExecuteOpen - Stream handling completed (lastStatus=%v)", lastResponse.Status)
-		log.Printf("📡 gRPC RESPONSE DETAILS: Status=%v, TransactionId=%s, Message=%s, ResponseStatus=%v",
-			response.Status, response.Response.TransactionId, response.Response.Message, response.Response.Status)
+		slog.DebugContext(ctx, "📡 ExecuteOpen - Stream handling completed", "lastStatus", lastResponse.Status)
+		slog.DebugContext(ctx, "📡 ExecuteOpen response details", "status", response.Status, "transactionId", response.Response.TransactionId, "message", response.Response.Message, "responseStatus", response.Response.Status)
 	}
 
 	if response == nil {
-		log.Printf("❌ ExecuteOpen - Response is nil")
+		slog.InfoContext(ctx, "❌ ExecuteOpen - Response is nil")
 		return nil, exception.ErrPaymentInfraServiceUnavailable
 	}
 
 	if response.Response != nil && response.Response.Status == dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR {
-		log.Printf("⚠️ ExecuteOpen - Response status is ERROR: %s", response.Response.Message)
+		slog.WarnContext(ctx, "⚠️ ExecuteOpen - Response status is ERROR", "error", response.Response.Message)
 		// Devolvemos el resultado tal cual para que el caller (GraphQL) pueda mostrar el estado/reportado por booking
 		domainResult := c.mapper.ToExecuteOpenDomain(response)
-		log.Printf("🔄 gRPC -> DOMAIN MAPPING: Mapped to domain with OpenStatus=%v, Status=%v", domainResult.OpenStatus, domainResult.Status)
+		slog.DebugContext(ctx, "🔄 ExecuteOpen mapped to domain", "openStatus", domainResult.OpenStatus, "status", domainResult.Status)
 		return domainResult, nil
 	}
 
-	log.Printf("✅ ExecuteOpen - Success: status=%v, message=%s", response.Status, response.Response.Message)
+	slog.InfoContext(ctx, "✅ ExecuteOpen - Success", "status", response.Status, "message", response.Response.Message)
 	domainResult := c.mapper.ToExecuteOpenDomain(response)
-	log.Printf("🔄 gRPC -> DOMAIN MAPPING: Mapped to domain with OpenStatus=%v, Status=%v, TransactionId=%s",
-		domainResult.OpenStatus, domainResult.Status, domainResult.TransactionID)
+	slog.DebugContext(ctx, "🔄 ExecuteOpen mapped to domain",
+		"openStatus", domainResult.OpenStatus, "status", domainResult.Status, "transactionId", domainResult.TransactionID)
 	return domainResult, nil
 }
 
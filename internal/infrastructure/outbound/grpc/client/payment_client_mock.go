@@ -1,20 +1,117 @@
 package client
 
 import (
+	"bff-graphql-payment/internal/domain/model"
 	"bff-graphql-payment/internal/infrastructure/outbound/grpc/dto"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// mockBackendTimeLayout es el formato sin offset con que el backend informa las fechas de reserva
+const mockBackendTimeLayout = "2006-01-02 15:04:05"
+
 // Mock responses for development/testing purposes
 // These methods simulate gRPC responses without actual service calls
 
+// mockOrderStore guarda en memoria el estado de las órdenes de compra y de las reservas simuladas
+type mockOrderStore struct {
+	mu        sync.RWMutex
+	statuses  map[string]string
+	gateways  map[string]mockOrderPayment
+	cancelled map[string]bool
+	finishes  map[string]string
+}
+
+// mockOrderPayment es la pasarela y el monto con que se generó una orden simulada
+type mockOrderPayment struct {
+	gatewayName string
+	amount      int64
+}
+
+// newMockOrderStore crea un nuevo almacén de órdenes simuladas
+func newMockOrderStore() *mockOrderStore {
+	return &mockOrderStore{
+		statuses:  make(map[string]string),
+		gateways:  make(map[string]mockOrderPayment),
+		cancelled: make(map[string]bool),
+		finishes:  make(map[string]string),
+	}
+}
+
+// status devuelve el estado registrado de una orden, si existe
+func (s *mockOrderStore) status(purchaseOrder string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status, ok := s.statuses[purchaseOrder]
+	return status, ok
+}
+
+// setStatus registra el estado de una orden
+func (s *mockOrderStore) setStatus(purchaseOrder string, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[purchaseOrder] = status
+}
+
+// setPayment registra la pasarela y el monto de una orden
+func (s *mockOrderStore) setPayment(purchaseOrder string, gatewayName string, amount int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gateways[purchaseOrder] = mockOrderPayment{gatewayName: gatewayName, amount: amount}
+}
+
+// payment devuelve la pasarela y el monto registrados de una orden, si existen
+func (s *mockOrderStore) payment(purchaseOrder string) (mockOrderPayment, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	payment, ok := s.gateways[purchaseOrder]
+	return payment, ok
+}
+
+// cancel registra una reserva (serviceName/currentCode) u orden de compra como cancelada
+func (s *mockOrderStore) cancel(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancelled[key] = true
+}
+
+// isCancelled indica si la reserva u orden de compra fue cancelada
+func (s *mockOrderStore) isCancelled(key string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cancelled[key]
+}
+
+// setFinish registra el término extendido de una reserva
+func (s *mockOrderStore) setFinish(key string, finishBooking string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finishes[key] = finishBooking
+}
+
+// finish devuelve el término extendido de una reserva, si existe
+func (s *mockOrderStore) finish(key string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	finishBooking, ok := s.finishes[key]
+	return finishBooking, ok
+}
+
+// mockBookingKey identifica una reserva simulada por su código
+func mockBookingKey(serviceName string, currentCode string) string {
+	return strings.ToLower(serviceName) + "/" + currentCode
+}
+
 // mockGRPCCall simula una llamada gRPC para GetPaymentInfraByQrValue
 func (c *PaymentServiceGRPCClient) mockGetPaymentInfraByQrValue(request *dto.GetPaymentInfraByQrValueRequest) *dto.GetPaymentInfraByQrValueResponse {
 	// Simular diferentes respuestas basadas en el valor QR para testing
 	if request.QrValue == "" {
 		return &dto.GetPaymentInfraByQrValueResponse{
 			Response: &dto.PaymentManagerGenericResponse{
-				TransactionId: time.Now().Format("20060102150405"),
+				TransactionId: uuid.NewString(),
 				Message:       "Valor QR inválido",
 				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
 				TraceId:       "trace-" + time.Now().Format("20060102150405"),
@@ -25,7 +122,7 @@ func (c *PaymentServiceGRPCClient) mockGetPaymentInfraByQrValue(request *dto.Get
 	// Respuesta mock exitosa
 	return &dto.GetPaymentInfraByQrValueResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Success",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       "trace-" + time.Now().Format("20060102150405"),
@@ -64,7 +161,7 @@ func (c *PaymentServiceGRPCClient) mockGetPaymentInfraByQrValue(request *dto.Get
 func (c *PaymentServiceGRPCClient) mockGetAvailableLockers(request *dto.GetAvailableLockersRequest) *dto.GetAvailableLockersResponse {
 	return &dto.GetAvailableLockersResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Success",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       request.TraceId,
@@ -109,7 +206,7 @@ func (c *PaymentServiceGRPCClient) mockValidateCoupon(request *dto.ValidateDisco
 
 	return &dto.ValidateDiscountCouponResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Coupon validation completed",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       request.TraceId,
@@ -120,12 +217,15 @@ func (c *PaymentServiceGRPCClient) mockValidateCoupon(request *dto.ValidateDisco
 
 // mockGeneratePurchaseOrder simula la generación de una orden de compra
 func (c *PaymentServiceGRPCClient) mockGeneratePurchaseOrder(request *dto.GeneratePurchaseOrderRequest) *dto.GeneratePurchaseOrderResponse {
-	// Generar URL de pago simulada
-	paymentUrl := "https://payment.odihnx.com/pay/" + time.Now().Format("20060102150405")
+	// Generar URL de pago simulada; la orden queda pendiente hasta que la pasarela confirme
+	purchaseOrder := time.Now().Format("20060102150405")
+	paymentUrl := "https://payment.odihnx.com/pay/" + purchaseOrder
+	c.mockOrders.setStatus(purchaseOrder, "PENDING")
+	c.mockOrders.setPayment(purchaseOrder, request.GatewayName, 5000)
 
 	return &dto.GeneratePurchaseOrderResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Purchase order generated successfully",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       request.TraceId,
@@ -138,7 +238,7 @@ func (c *PaymentServiceGRPCClient) mockGeneratePurchaseOrder(request *dto.Genera
 func (c *PaymentServiceGRPCClient) mockGenerateBooking(request *dto.GenerateBookingRequest) *dto.GenerateBookingResponse {
 	return &dto.GenerateBookingResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Reserva generada exitosamente",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       request.TraceId,
@@ -149,10 +249,11 @@ func (c *PaymentServiceGRPCClient) mockGenerateBooking(request *dto.GenerateBook
 
 // mockGetPurchaseOrderByPo simula la obtención de una orden de compra por PO
 func (c *PaymentServiceGRPCClient) mockGetPurchaseOrderByPo(request *dto.GetPurchaseOrderByPoRequest) *dto.GetPurchaseOrderByPoResponse {
-	if request.PurchaseOrder == "" {
+	// Las órdenes terminadas en "NOTFOUND" simulan una orden inexistente
+	if request.PurchaseOrder == "" || strings.HasSuffix(strings.ToUpper(request.PurchaseOrder), "NOTFOUND") {
 		return &dto.GetPurchaseOrderByPoResponse{
 			Response: &dto.PaymentManagerGenericResponse{
-				TransactionId: time.Now().Format("20060102150405"),
+				TransactionId: uuid.NewString(),
 				Message:       "Orden de compra inválida",
 				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
 				TraceId:       request.TraceId,
@@ -160,9 +261,21 @@ func (c *PaymentServiceGRPCClient) mockGetPurchaseOrderByPo(request *dto.GetPurc
 		}
 	}
 
+	// Las órdenes generadas por el mock reflejan su estado actual; el resto se asume pagada
+	status, ok := c.mockOrders.status(request.PurchaseOrder)
+	if !ok {
+		status = "PAID"
+	}
+
+	// Las órdenes sin pasarela registrada no la informan, como las órdenes antiguas del backend
+	payment, ok := c.mockOrders.payment(request.PurchaseOrder)
+	if !ok {
+		payment.amount = 5000
+	}
+
 	return &dto.GetPurchaseOrderByPoResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Orden de compra encontrada",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       request.TraceId,
@@ -174,23 +287,79 @@ func (c *PaymentServiceGRPCClient) mockGetPurchaseOrderByPo(request *dto.GetPurc
 			Email:              "user@odihnx.com",
 			Phone:              "+56912345678",
 			Discount:           0,
-			ProductPrice:       5000,
-			FinalProductPrice:  5000,
+			ProductPrice:       int32(payment.amount),
+			FinalProductPrice:  payment.amount,
 			ProductName:        "Locker 1 día",
 			ProductDescription: "Arriendo de locker por 1 día",
 			LockerPosition:     15,
 			InstallationName:   "DEV PAGO",
 			DeviceSerieNum:     "DEV-001",
-			Status:             "PAID",
+			Status:             status,
+			GatewayName:        payment.gatewayName,
 		},
 	}
 }
 
+// mockUpdatePurchaseOrderStatus simula la actualización del estado de una orden de compra
+func (c *PaymentServiceGRPCClient) mockUpdatePurchaseOrderStatus(request *dto.UpdatePurchaseOrderStatusRequest) *dto.GetPurchaseOrderByPoResponse {
+	// Las órdenes terminadas en "NOTFOUND" simulan una orden inexistente
+	if strings.HasSuffix(strings.ToUpper(request.PurchaseOrder), "NOTFOUND") {
+		return &dto.GetPurchaseOrderByPoResponse{
+			Response: &dto.PaymentManagerGenericResponse{
+				TransactionId: uuid.NewString(),
+				Message:       "Orden de compra no encontrada",
+				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
+				TraceId:       request.TraceId,
+			},
+		}
+	}
+
+	c.mockOrders.setStatus(request.PurchaseOrder, request.Status)
+
+	return c.mockGetPurchaseOrderByPo(&dto.GetPurchaseOrderByPoRequest{
+		PurchaseOrder: request.PurchaseOrder,
+		TraceId:       request.TraceId,
+	})
+}
+
 // mockCheckBookingStatus simula la verificación de estado de reserva
 func (c *PaymentServiceGRPCClient) mockCheckBookingStatus(request *dto.CheckBookingStatusRequest) *dto.CheckBookingStatusResponse {
+	backendLocation, _ := time.LoadLocation(model.DefaultTimezone)
+	now := time.Now().In(backendLocation)
+
+	// Las reservas canceladas dejan de existir para el backend
+	if c.mockOrders.isCancelled(mockBookingKey(request.ServiceName, request.CurrentCode)) {
+		return &dto.CheckBookingStatusResponse{
+			Response: &dto.PaymentManagerGenericResponse{
+				TransactionId: uuid.NewString(),
+				Message:       "Reserva cancelada",
+				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
+				TraceId:       "trace-" + time.Now().Format("20060102150405"),
+			},
+		}
+	}
+
+	// Los códigos terminados en "EXPIRED", "NOTSTARTED" o "EXHAUSTED" simulan reservas no abribles
+	initBooking, finishBooking := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	openings := int32(2)
+	code := strings.ToUpper(request.CurrentCode)
+	switch {
+	case strings.HasSuffix(code, "EXPIRED"):
+		initBooking, finishBooking = now.Add(-48*time.Hour), now.Add(-time.Hour)
+	case strings.HasSuffix(code, "NOTSTARTED"):
+		initBooking, finishBooking = now.Add(time.Hour), now.Add(48*time.Hour)
+	case strings.HasSuffix(code, "EXHAUSTED"):
+		openings = 0
+	}
+
+	finish := finishBooking.Format(mockBackendTimeLayout)
+	if extended, ok := c.mockOrders.finish(mockBookingKey(request.ServiceName, request.CurrentCode)); ok {
+		finish = extended
+	}
+
 	return &dto.CheckBookingStatusResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Success",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       "trace-" + time.Now().Format("20060102150405"),
@@ -198,30 +367,216 @@ func (c *PaymentServiceGRPCClient) mockCheckBookingStatus(request *dto.CheckBook
 		Booking: &dto.BookingStatusRecord{
 			Id:                     123,
 			ConfigurationBookingId: 456,
-			InitBooking:            time.Now().Add(-24 * time.Hour).Format(time.RFC3339),
-			FinishBooking:          time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+			InitBooking:            initBooking.Format(mockBackendTimeLayout),
+			FinishBooking:          finish,
 			InstallationName:       "installation-name",
 			NumberLocker:           15,
 			DeviceId:               "device-id",
 			CurrentCode:            request.CurrentCode,
-			Openings:               2,
+			Openings:               openings,
 			ServiceName:            request.ServiceName,
 			EmailRecipient:         "usuario@odihnx.com",
-			CreatedAt:              time.Now().Add(-48 * time.Hour).Format(time.RFC3339),
-			UpdatedAt:              time.Now().Format(time.RFC3339),
+			CreatedAt:              now.Add(-48 * time.Hour).Format(mockBackendTimeLayout),
+			UpdatedAt:              now.Format(mockBackendTimeLayout),
+		},
+	}
+}
+
+// mockGetBooking simula la consulta de una reserva por su código o por su orden de compra
+func (c *PaymentServiceGRPCClient) mockGetBooking(request *dto.GetBookingRequest) *dto.CheckBookingStatusResponse {
+	// Sin código, la reserva de la orden es la que genera el mock de GenerateBooking; sin orden,
+	// se simula una orden pagada asociada al código
+	code, purchaseOrder := request.CurrentCode, request.PurchaseOrder
+	if code == "" {
+		code = "ABC123DEF"
+	}
+	if purchaseOrder == "" {
+		purchaseOrder = "OC-" + code
+	}
+
+	if strings.HasSuffix(strings.ToUpper(request.CurrentCode+request.PurchaseOrder), "NOTFOUND") {
+		return &dto.CheckBookingStatusResponse{
+			Response: &dto.PaymentManagerGenericResponse{
+				TransactionId: uuid.NewString(),
+				Message:       "Reserva no encontrada",
+				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
+				TraceId:       request.TraceId,
+			},
+		}
+	}
+
+	response := c.mockCheckBookingStatus(&dto.CheckBookingStatusRequest{
+		ServiceName: "svc1",
+		CurrentCode: code,
+	})
+	if response.Booking != nil {
+		response.Booking.PurchaseOrder = purchaseOrder
+	}
+	return response
+}
+
+// mockCancelBooking simula la cancelación de una reserva
+func (c *PaymentServiceGRPCClient) mockCancelBooking(request *dto.CancelBookingRequest) *dto.CancelBookingResponse {
+	// Los códigos u órdenes terminados en "NOTFOUND" simulan una reserva inexistente
+	reference := request.PurchaseOrder
+	if request.CurrentCode != "" {
+		reference = request.CurrentCode
+	}
+	if strings.HasSuffix(strings.ToUpper(reference), "NOTFOUND") || c.mockOrders.isCancelled(request.PurchaseOrder) {
+		return &dto.CancelBookingResponse{
+			Response: &dto.PaymentManagerGenericResponse{
+				TransactionId: uuid.NewString(),
+				Message:       "Reserva no encontrada",
+				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
+				TraceId:       request.TraceId,
+			},
+		}
+	}
+
+	// Una reserva identificada por código se asocia a una orden de compra derivada del código
+	purchaseOrder := request.PurchaseOrder
+	if purchaseOrder == "" {
+		purchaseOrder = "OC-" + request.CurrentCode
+	}
+
+	if request.CurrentCode != "" {
+		c.mockOrders.cancel(mockBookingKey(request.ServiceName, request.CurrentCode))
+	}
+	c.mockOrders.cancel(purchaseOrder)
+
+	// Una orden aún pendiente de pago queda anulada
+	if status, ok := c.mockOrders.status(purchaseOrder); ok && status == "PENDING" {
+		c.mockOrders.setStatus(purchaseOrder, "EXPIRED")
+	}
+
+	return &dto.CancelBookingResponse{
+		Response: &dto.PaymentManagerGenericResponse{
+			TransactionId: uuid.NewString(),
+			Message:       "Reserva cancelada",
+			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
+			TraceId:       request.TraceId,
 		},
+		BookingId:     123,
+		PurchaseOrder: purchaseOrder,
+		Status:        "CANCELLED",
 	}
 }
 
+// mockRequestRefund simula la solicitud de reembolso de una orden de compra.
+// Las órdenes terminadas en "REFUNDREJECTED", "REFUNDPENDING" o "REFUNDERROR" simulan
+// un reembolso rechazado, en proceso o un error del backend; el resto se aprueba.
+func (c *PaymentServiceGRPCClient) mockRequestRefund(request *dto.RefundRequest) *dto.RefundResponse {
+	purchaseOrder := strings.ToUpper(request.PurchaseOrder)
+
+	if strings.HasSuffix(purchaseOrder, "REFUNDERROR") {
+		return &dto.RefundResponse{
+			Response: &dto.PaymentManagerGenericResponse{
+				TransactionId: uuid.NewString(),
+				Message:       "Pasarela no disponible para reembolsos",
+				Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_ERROR,
+				TraceId:       request.TraceId,
+			},
+		}
+	}
+
+	status, message := "APPROVED", "Reembolso aprobado"
+	switch {
+	case strings.HasSuffix(purchaseOrder, "REFUNDREJECTED"):
+		status, message = "REJECTED", "Reembolso rechazado por la pasarela"
+	case strings.HasSuffix(purchaseOrder, "REFUNDPENDING"):
+		status, message = "PENDING", "Reembolso en proceso"
+	default:
+		c.mockOrders.setStatus(request.PurchaseOrder, "REFUNDED")
+	}
+
+	return &dto.RefundResponse{
+		Response: &dto.PaymentManagerGenericResponse{
+			TransactionId: uuid.NewString(),
+			Message:       message,
+			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
+			TraceId:       request.TraceId,
+		},
+		RefundId: "RF-" + uuid.NewString(),
+		Status:   status,
+		Amount:   request.Amount,
+	}
+}
+
+// mockGetBookingExtensionOptions simula la cotización de extensiones con los tiempos de reserva del rack
+func (c *PaymentServiceGRPCClient) mockGetBookingExtensionOptions(request *dto.GetBookingExtensionOptionsRequest) *dto.GetBookingExtensionOptionsResponse {
+	return &dto.GetBookingExtensionOptionsResponse{
+		Response: &dto.PaymentManagerGenericResponse{
+			TransactionId: uuid.NewString(),
+			Message:       "Success",
+			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
+			TraceId:       request.TraceId,
+		},
+		BookingId: 123,
+		RackId:    1,
+		Options: []*dto.BookingExtensionOptionRecord{
+			{
+				BookingTime: &dto.BookingTimeRecord{
+					Id:              1,
+					Name:            "Express (1 día)",
+					UnitMeasurement: dto.UnitMeasurement_DAY,
+					Amount:          1,
+				},
+				Price: 2000,
+			},
+			{
+				BookingTime: &dto.BookingTimeRecord{
+					Id:              2,
+					Name:            "Normal (3 días)",
+					UnitMeasurement: dto.UnitMeasurement_DAY,
+					Amount:          3,
+				},
+				Price: 5000,
+			},
+		},
+	}
+}
+
+// mockGenerateBookingExtensionOrder simula la orden de compra de una extensión; queda pendiente hasta que la pasarela confirme
+func (c *PaymentServiceGRPCClient) mockGenerateBookingExtensionOrder(request *dto.GenerateBookingExtensionOrderRequest) *dto.GenerateBookingExtensionOrderResponse {
+	purchaseOrder := "EXT" + time.Now().Format("20060102150405")
+	c.mockOrders.setStatus(purchaseOrder, "PENDING")
+	c.mockOrders.setPayment(purchaseOrder, request.GatewayName, request.Amount)
+
+	return &dto.GenerateBookingExtensionOrderResponse{
+		Response: &dto.PaymentManagerGenericResponse{
+			TransactionId: uuid.NewString(),
+			Message:       "Booking extension order generated successfully",
+			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
+			TraceId:       request.TraceId,
+		},
+		PurchaseOrder: purchaseOrder,
+		Url:           "https://payment.odihnx.com/pay/" + purchaseOrder,
+	}
+}
+
+// mockUpdateBookingFinish simula la actualización del término de una reserva
+func (c *PaymentServiceGRPCClient) mockUpdateBookingFinish(request *dto.UpdateBookingFinishRequest) *dto.CheckBookingStatusResponse {
+	c.mockOrders.setFinish(mockBookingKey(request.ServiceName, request.CurrentCode), request.FinishBooking)
+
+	return c.mockCheckBookingStatus(&dto.CheckBookingStatusRequest{
+		ServiceName: request.ServiceName,
+		CurrentCode: request.CurrentCode,
+	})
+}
+
 // mockExecuteOpen simula la apertura de locker
 func (c *PaymentServiceGRPCClient) mockExecuteOpen(request *dto.ExecuteOpenRequest) *dto.ExecuteOpenResponse {
 	return &dto.ExecuteOpenResponse{
 		Response: &dto.PaymentManagerGenericResponse{
-			TransactionId: time.Now().Format("20060102150405"),
+			TransactionId: uuid.NewString(),
 			Message:       "Locker abierto exitosamente",
 			Status:        dto.PaymentManagerResponseStatus_RESPONSE_STATUS_OK,
 			TraceId:       "trace-" + time.Now().Format("20060102150405"),
 		},
 		Status: dto.OpenStatus_OPEN_STATUS_SUCCESS,
+		ReceivedStatuses: []dto.OpenStatus{
+			dto.OpenStatus_OPEN_STATUS_RECEIVED,
+			dto.OpenStatus_OPEN_STATUS_SUCCESS,
+		},
 	}
 }
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"bff-graphql-payment/internal/application/tracing"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// traceIDMetadataKey es la clave de metadata gRPC con la que se propaga el traceId a los backends
+const traceIDMetadataKey = "x-trace-id"
+
+// withTraceMetadata agrega el traceId del contexto a la metadata saliente
+func withTraceMetadata(ctx context.Context) context.Context {
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		return metadata.AppendToOutgoingContext(ctx, traceIDMetadataKey, traceID)
+	}
+	return ctx
+}
+
+// traceUnaryInterceptor propaga el traceId en las llamadas unarias
+func traceUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withTraceMetadata(ctx), method, req, reply, cc, opts...)
+}
+
+// traceStreamInterceptor propaga el traceId en las llamadas de streaming
+func traceStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withTraceMetadata(ctx), desc, cc, method, opts...)
+}
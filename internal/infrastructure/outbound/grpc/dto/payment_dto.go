@@ -164,6 +164,14 @@ type PurchaseOrderRecord struct {
 	InstallationName   string `json:"installation_name"`
 	DeviceSerieNum     string `json:"device_serie_num"`
 	Status             string `json:"status"`
+	GatewayName        string `json:"gateway_name"`
+}
+
+// UpdatePurchaseOrderStatusRequest represents the request for updating a purchase order status
+type UpdatePurchaseOrderStatusRequest struct {
+	PurchaseOrder string `json:"purchase_order"`
+	Status        string `json:"status"`
+	TraceId       string `json:"trace_id"`
 }
 
 // CheckBookingStatusRequest represents the request for checking booking status
@@ -193,6 +201,7 @@ type BookingStatusRecord struct {
 	EmailRecipient         string `json:"email_recipient"`
 	CreatedAt              string `json:"created_at"`
 	UpdatedAt              string `json:"updated_at"`
+	PurchaseOrder          string `json:"purchase_order"`
 }
 
 // ExecuteOpenRequest represents the request for executing locker opening
@@ -205,6 +214,8 @@ type ExecuteOpenRequest struct {
 type ExecuteOpenResponse struct {
 	Status   OpenStatus                     `json:"status"`
 	Response *PaymentManagerGenericResponse `json:"response"`
+	// ReceivedStatuses are all statuses received from the stream, in order
+	ReceivedStatuses []OpenStatus `json:"received_statuses,omitempty"`
 }
 
 // OpenStatus enum
@@ -218,3 +229,91 @@ const (
 	OpenStatus_OPEN_STATUS_ERROR       OpenStatus = 4
 	OpenStatus_OPEN_STATUS_SUCCESS     OpenStatus = 5
 )
+
+// CancelBookingRequest represents the request for cancelling a booking
+type CancelBookingRequest struct {
+	ServiceName   string `json:"service_name"`
+	CurrentCode   string `json:"current_code"`
+	PurchaseOrder string `json:"purchase_order"`
+	Reason        string `json:"reason"`
+	TraceId       string `json:"trace_id"`
+}
+
+// CancelBookingResponse represents the response for cancelling a booking
+type CancelBookingResponse struct {
+	Response      *PaymentManagerGenericResponse `json:"response"`
+	BookingId     int32                          `json:"booking_id"`
+	PurchaseOrder string                         `json:"purchase_order"`
+	Status        string                         `json:"status"`
+}
+
+// RefundRequest represents the request for refunding a purchase order
+type RefundRequest struct {
+	PurchaseOrder string `json:"purchase_order"`
+	Amount        int64  `json:"amount"`
+	Reason        string `json:"reason"`
+	TraceId       string `json:"trace_id"`
+}
+
+// RefundResponse represents the response for refunding a purchase order
+type RefundResponse struct {
+	Response *PaymentManagerGenericResponse `json:"response"`
+	RefundId string                         `json:"refund_id"`
+	Status   string                         `json:"status"`
+	Amount   int64                          `json:"amount"`
+}
+
+// GetBookingExtensionOptionsRequest represents the request for getting the extension options of a booking
+type GetBookingExtensionOptionsRequest struct {
+	ServiceName string `json:"service_name"`
+	CurrentCode string `json:"current_code"`
+	TraceId     string `json:"trace_id"`
+}
+
+// GetBookingExtensionOptionsResponse represents the response with the extension options of a booking
+type GetBookingExtensionOptionsResponse struct {
+	Response  *PaymentManagerGenericResponse  `json:"response"`
+	BookingId int32                           `json:"booking_id"`
+	RackId    int32                           `json:"rack_id"`
+	Options   []*BookingExtensionOptionRecord `json:"options"`
+}
+
+// BookingExtensionOptionRecord represents a priced booking time for extending a booking
+type BookingExtensionOptionRecord struct {
+	BookingTime *BookingTimeRecord `json:"booking_time"`
+	Price       int64              `json:"price"`
+}
+
+// GenerateBookingExtensionOrderRequest represents the request for generating a purchase order tied to a booking
+type GenerateBookingExtensionOrderRequest struct {
+	BookingId     int32  `json:"booking_id"`
+	ServiceName   string `json:"service_name"`
+	CurrentCode   string `json:"current_code"`
+	BookingTimeId int32  `json:"booking_time_id"`
+	Amount        int64  `json:"amount"`
+	GatewayName   string `json:"gateway_name"`
+	TraceId       string `json:"trace_id"`
+}
+
+// GenerateBookingExtensionOrderResponse represents the response for generating a booking extension order
+type GenerateBookingExtensionOrderResponse struct {
+	Response      *PaymentManagerGenericResponse `json:"response"`
+	PurchaseOrder string                         `json:"purchase_order"`
+	Url           string                         `json:"url"`
+}
+
+// GetBookingRequest represents the request for getting a booking by its code or purchase order
+type GetBookingRequest struct {
+	CurrentCode   string `json:"current_code"`
+	PurchaseOrder string `json:"purchase_order"`
+	TraceId       string `json:"trace_id"`
+}
+
+// UpdateBookingFinishRequest represents the request for updating the finish date of a booking
+type UpdateBookingFinishRequest struct {
+	ServiceName   string `json:"service_name"`
+	CurrentCode   string `json:"current_code"`
+	FinishBooking string `json:"finish_booking"`
+	PurchaseOrder string `json:"purchase_order"`
+	TraceId       string `json:"trace_id"`
+}
@@ -5,6 +5,7 @@ import (
 	paymentpb "bff-graphql-payment/gen/go/proto/payment/v1"
 	"bff-graphql-payment/internal/domain/model"
 	"bff-graphql-payment/internal/infrastructure/outbound/grpc/dto"
+	"time"
 )
 
 // PaymentInfraGRPCMapper maneja el mapeo entre modelos de dominio y DTOs de gRPC
@@ -273,12 +274,176 @@ func (m *PaymentInfraGRPCMapper) ToPurchaseOrderDataDomain(response *dto.GetPurc
 		orderData.LockerPosition = int(response.PurchaseOrder.LockerPosition)
 		orderData.InstallationName = response.PurchaseOrder.InstallationName
 		orderData.DeviceSerieNum = response.PurchaseOrder.DeviceSerieNum
-		orderData.OrderStatus = response.PurchaseOrder.Status
+		orderData.OrderStatus = model.PurchaseOrderStatus(response.PurchaseOrder.Status)
+		orderData.GatewayName = response.PurchaseOrder.GatewayName
 	}
 
 	return orderData
 }
 
+// ToUpdatePurchaseOrderStatusRequest mapea a solicitud gRPC para actualizar el estado de una orden de compra
+func (m *PaymentInfraGRPCMapper) ToUpdatePurchaseOrderStatusRequest(purchaseOrder string, status model.PurchaseOrderStatus, traceID string) *dto.UpdatePurchaseOrderStatusRequest {
+	return &dto.UpdatePurchaseOrderStatusRequest{
+		PurchaseOrder: purchaseOrder,
+		Status:        string(status),
+		TraceId:       traceID,
+	}
+}
+
+// ToCancelBookingRequest mapea a solicitud gRPC para cancelar una reserva
+func (m *PaymentInfraGRPCMapper) ToCancelBookingRequest(request model.BookingCancellationRequest) *dto.CancelBookingRequest {
+	return &dto.CancelBookingRequest{
+		ServiceName:   request.ServiceName,
+		CurrentCode:   request.CurrentCode,
+		PurchaseOrder: request.PurchaseOrder,
+		Reason:        request.Reason,
+		TraceId:       request.TraceID,
+	}
+}
+
+// ToBookingCancellationDomain mapea la respuesta gRPC al modelo de dominio de cancelación
+func (m *PaymentInfraGRPCMapper) ToBookingCancellationDomain(response *dto.CancelBookingResponse) *model.BookingCancellation {
+	if response == nil {
+		return nil
+	}
+
+	cancellation := &model.BookingCancellation{
+		BookingID:          int(response.BookingId),
+		PurchaseOrder:      response.PurchaseOrder,
+		CancellationStatus: model.CancellationStatus(response.Status),
+	}
+
+	if response.Response != nil {
+		cancellation.TransactionID = response.Response.TransactionId
+		cancellation.Message = response.Response.Message
+		cancellation.Status = m.mapResponseStatus(response.Response.Status)
+	}
+
+	return cancellation
+}
+
+// ToRefundRequest mapea a solicitud gRPC para reembolsar una orden de compra
+func (m *PaymentInfraGRPCMapper) ToRefundRequest(request model.RefundRequest) *dto.RefundRequest {
+	return &dto.RefundRequest{
+		PurchaseOrder: request.PurchaseOrder,
+		Amount:        request.Amount,
+		Reason:        request.Reason,
+		TraceId:       request.TraceID,
+	}
+}
+
+// ToRefundDomain mapea la respuesta gRPC al modelo de dominio de reembolso
+func (m *PaymentInfraGRPCMapper) ToRefundDomain(response *dto.RefundResponse) *model.Refund {
+	if response == nil {
+		return nil
+	}
+
+	refund := &model.Refund{
+		RefundID: response.RefundId,
+		Status:   model.RefundStatus(response.Status),
+		Amount:   response.Amount,
+	}
+
+	if response.Response != nil {
+		refund.Message = response.Response.Message
+	}
+
+	return refund
+}
+
+// ToGetBookingExtensionOptionsRequest mapea a solicitud gRPC para obtener las opciones de extensión de una reserva
+func (m *PaymentInfraGRPCMapper) ToGetBookingExtensionOptionsRequest(serviceName string, currentCode string, traceID string) *dto.GetBookingExtensionOptionsRequest {
+	return &dto.GetBookingExtensionOptionsRequest{
+		ServiceName: serviceName,
+		CurrentCode: currentCode,
+		TraceId:     traceID,
+	}
+}
+
+// ToBookingExtensionOptionsDomain mapea la respuesta gRPC al modelo de dominio de opciones de extensión
+func (m *PaymentInfraGRPCMapper) ToBookingExtensionOptionsDomain(response *dto.GetBookingExtensionOptionsResponse) *model.BookingExtensionOptions {
+	if response == nil {
+		return nil
+	}
+
+	options := &model.BookingExtensionOptions{
+		BookingID: int(response.BookingId),
+		RackID:    int(response.RackId),
+		Options:   make([]model.BookingExtensionOption, 0, len(response.Options)),
+	}
+
+	for _, option := range response.Options {
+		if option == nil || option.BookingTime == nil {
+			continue
+		}
+		options.Options = append(options.Options, model.BookingExtensionOption{
+			BookingTime: model.PaymentBookingTime{
+				ID:              int(option.BookingTime.Id),
+				Name:            option.BookingTime.Name,
+				UnitMeasurement: m.mapUnitMeasurement(option.BookingTime.UnitMeasurement),
+				Amount:          int(option.BookingTime.Amount),
+			},
+			Price: option.Price,
+		})
+	}
+
+	return options
+}
+
+// ToGenerateBookingExtensionOrderRequest mapea a solicitud gRPC para generar la orden de compra de una extensión
+func (m *PaymentInfraGRPCMapper) ToGenerateBookingExtensionOrderRequest(request model.BookingExtensionOrderRequest) *dto.GenerateBookingExtensionOrderRequest {
+	return &dto.GenerateBookingExtensionOrderRequest{
+		BookingId:     int32(request.BookingID),
+		ServiceName:   request.ServiceName,
+		CurrentCode:   request.CurrentCode,
+		BookingTimeId: int32(request.BookingTimeID),
+		Amount:        request.Amount,
+		GatewayName:   request.GatewayName,
+		TraceId:       request.TraceID,
+	}
+}
+
+// ToBookingExtensionOrderDomain mapea la respuesta gRPC al modelo de dominio de orden de extensión
+func (m *PaymentInfraGRPCMapper) ToBookingExtensionOrderDomain(response *dto.GenerateBookingExtensionOrderResponse) *model.BookingExtensionOrder {
+	if response == nil {
+		return nil
+	}
+
+	order := &model.BookingExtensionOrder{
+		PurchaseOrder: response.PurchaseOrder,
+		URL:           response.Url,
+	}
+
+	if response.Response != nil {
+		order.TransactionID = response.Response.TransactionId
+		order.Message = response.Response.Message
+		order.Status = m.mapResponseStatus(response.Response.Status)
+		order.TraceID = response.Response.TraceId
+	}
+
+	return order
+}
+
+// ToUpdateBookingFinishRequest mapea a solicitud gRPC para actualizar el término de una reserva
+func (m *PaymentInfraGRPCMapper) ToUpdateBookingFinishRequest(serviceName string, currentCode string, finishBooking time.Time, purchaseOrder string, traceID string) *dto.UpdateBookingFinishRequest {
+	return &dto.UpdateBookingFinishRequest{
+		ServiceName:   serviceName,
+		CurrentCode:   currentCode,
+		FinishBooking: formatBackendTime(finishBooking),
+		PurchaseOrder: purchaseOrder,
+		TraceId:       traceID,
+	}
+}
+
+// ToGetBookingRequest mapea a solicitud gRPC para obtener una reserva por código u orden de compra
+func (m *PaymentInfraGRPCMapper) ToGetBookingRequest(currentCode string, purchaseOrder string, traceID string) *dto.GetBookingRequest {
+	return &dto.GetBookingRequest{
+		CurrentCode:   currentCode,
+		PurchaseOrder: purchaseOrder,
+		TraceId:       traceID,
+	}
+}
+
 // ToCheckBookingStatusRequest mapea a solicitud gRPC para verificar estado de booking
 func (m *PaymentInfraGRPCMapper) ToCheckBookingStatusRequest(serviceName string, currentCode string) *dto.CheckBookingStatusRequest {
 	return &dto.CheckBookingStatusRequest{
@@ -305,8 +470,8 @@ func (m *PaymentInfraGRPCMapper) ToBookingStatusDomain(response *dto.CheckBookin
 		bookingStatus.Booking = &model.BookingStatusData{
 			ID:                     int(response.Booking.Id),
 			ConfigurationBookingID: int(response.Booking.ConfigurationBookingId),
-			InitBooking:            response.Booking.InitBooking,
-			FinishBooking:          response.Booking.FinishBooking,
+			InitBooking:            parseBackendTime("init_booking", response.Booking.InitBooking),
+			FinishBooking:          parseBackendTime("finish_booking", response.Booking.FinishBooking),
 			InstallationName:       response.Booking.InstallationName,
 			NumberLocker:           int(response.Booking.NumberLocker),
 			DeviceID:               response.Booking.DeviceId,
@@ -314,8 +479,9 @@ func (m *PaymentInfraGRPCMapper) ToBookingStatusDomain(response *dto.CheckBookin
 			Openings:               int(response.Booking.Openings),
 			ServiceName:            response.Booking.ServiceName,
 			EmailRecipient:         response.Booking.EmailRecipient,
-			CreatedAt:              response.Booking.CreatedAt,
-			UpdatedAt:              response.Booking.UpdatedAt,
+			CreatedAt:              parseBackendTime("created_at", response.Booking.CreatedAt),
+			UpdatedAt:              parseBackendTime("updated_at", response.Booking.UpdatedAt),
+			PurchaseOrder:          response.Booking.PurchaseOrder,
 		}
 	}
 
@@ -340,6 +506,13 @@ func (m *PaymentInfraGRPCMapper) ToExecuteOpenDomain(response *dto.ExecuteOpenRe
 		OpenStatus: m.mapOpenStatus(response.Status),
 	}
 
+	for _, status := range response.ReceivedStatuses {
+		openResult.ReceivedStatuses = append(openResult.ReceivedStatuses, m.mapOpenStatus(status))
+	}
+	if len(openResult.ReceivedStatuses) == 0 {
+		openResult.ReceivedStatuses = []model.OpenStatus{openResult.OpenStatus}
+	}
+
 	if response.Response != nil {
 		openResult.TransactionID = response.Response.TransactionId
 		openResult.Message = response.Response.Message
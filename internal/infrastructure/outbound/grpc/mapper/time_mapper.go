@@ -0,0 +1,55 @@
+package mapper
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"log/slog"
+	"strings"
+	"time"
+
+	// Embebe la base de datos de zonas horarias para no depender de la imagen del contenedor
+	_ "time/tzdata"
+)
+
+// backendLocation es la zona horaria de las fechas sin offset; tzdata embebido garantiza que exista
+var backendLocation = mustLoadLocation(model.DefaultTimezone)
+
+// backendTimeLayouts son los formatos de fecha que devuelve el backend
+var backendTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02",
+}
+
+// parseBackendTime interpreta una fecha del backend. Las fechas sin offset se
+// consideran en model.DefaultTimezone. Una fecha vacía o inválida se mapea al valor cero.
+func parseBackendTime(field string, value string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range backendTimeLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, backendLocation); err == nil {
+			return parsed
+		}
+	}
+
+	slog.Warn("⚠️ Unable to parse backend timestamp", "field", field, "value", value)
+	return time.Time{}
+}
+
+// formatBackendTime formatea una fecha en BackendTimezone con el formato sin offset del backend
+func formatBackendTime(value time.Time) string {
+	return value.In(backendLocation).Format("2006-01-02 15:04:05")
+}
+
+// mustLoadLocation carga una zona horaria o aborta si no existe
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
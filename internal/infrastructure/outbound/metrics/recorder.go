@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+	"time"
+)
+
+// counters agrupa todos los contadores del BFF bajo la variable expvar "bff"
+var counters = expvar.NewMap("bff")
+
+// Recorder registra métricas operacionales usando expvar
+type Recorder struct{}
+
+// NewRecorder crea un nuevo registrador de métricas
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// IncCounter incrementa en uno el contador indicado
+func (r *Recorder) IncCounter(name string) {
+	counters.Add(name, 1)
+}
+
+// AddCounter suma delta al contador indicado
+func (r *Recorder) AddCounter(name string, delta int64) {
+	counters.Add(name, delta)
+}
+
+// ObserveDuration acumula la duración (en milisegundos) y el número de observaciones
+func (r *Recorder) ObserveDuration(name string, d time.Duration) {
+	counters.Add(name+"_count", 1)
+	counters.Add(name+"_ms_total", d.Milliseconds())
+}
+
+// Handler expone las métricas en formato JSON
+func Handler() http.Handler {
+	return expvar.Handler()
+}
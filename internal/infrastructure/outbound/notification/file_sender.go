@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileRecord es una notificación guardada como línea JSON
+type fileRecord struct {
+	SentAt    time.Time `json:"sentAt"`
+	Channel   string    `json:"channel"`
+	Event     string    `json:"event"`
+	Recipient string    `json:"recipient"`
+	Subject   string    `json:"subject,omitempty"`
+	Body      string    `json:"body"`
+	TraceID   string    `json:"traceId,omitempty"`
+}
+
+// FileSender guarda las notificaciones de un canal en un archivo JSONL en lugar de enviarlas.
+// Pensado para desarrollo local y pruebas.
+type FileSender struct {
+	channel model.NotificationChannel
+	path    string
+	mu      sync.Mutex
+}
+
+// NewFileSender crea un sender que agrega las notificaciones del canal al archivo indicado
+func NewFileSender(channel model.NotificationChannel, path string) *FileSender {
+	return &FileSender{channel: channel, path: path}
+}
+
+// Channel implementa ports.NotificationSender
+func (s *FileSender) Channel() model.NotificationChannel {
+	return s.channel
+}
+
+// Send implementa ports.NotificationSender
+func (s *FileSender) Send(ctx context.Context, notification model.Notification) error {
+	line, err := json.Marshal(fileRecord{
+		SentAt:    time.Now(),
+		Channel:   string(notification.Channel),
+		Event:     string(notification.Event),
+		Recipient: notification.Recipient,
+		Subject:   notification.Subject,
+		Body:      notification.Body,
+		TraceID:   notification.TraceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open notifications file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"sync"
+)
+
+// MemorySender guarda en memoria las notificaciones de un canal. Pensado para pruebas.
+type MemorySender struct {
+	channel model.NotificationChannel
+	mu      sync.Mutex
+	sent    []model.Notification
+}
+
+// NewMemorySender crea un sender en memoria para el canal indicado
+func NewMemorySender(channel model.NotificationChannel) *MemorySender {
+	return &MemorySender{channel: channel}
+}
+
+// Channel implementa ports.NotificationSender
+func (s *MemorySender) Channel() model.NotificationChannel {
+	return s.channel
+}
+
+// Send implementa ports.NotificationSender
+func (s *MemorySender) Send(ctx context.Context, notification model.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, notification)
+	return nil
+}
+
+// Sent devuelve una copia de las notificaciones recibidas
+func (s *MemorySender) Sent() []model.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]model.Notification(nil), s.sent...)
+}
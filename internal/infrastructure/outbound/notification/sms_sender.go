@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SMSConfig contiene los datos del proveedor HTTP de SMS
+type SMSConfig struct {
+	// URL recibe un POST JSON {"from","to","message"}
+	URL string
+	// Token se envía como "Authorization: Bearer <token>"
+	Token string
+	From  string
+}
+
+// smsRequest es el cuerpo enviado al proveedor de SMS
+type smsRequest struct {
+	From    string `json:"from,omitempty"`
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+// HTTPSMSSender envía notificaciones por SMS a través de un proveedor HTTP
+type HTTPSMSSender struct {
+	config SMSConfig
+	client *http.Client
+}
+
+// NewHTTPSMSSender crea un sender de SMS; el límite de tiempo lo define el contexto de cada envío
+func NewHTTPSMSSender(config SMSConfig) *HTTPSMSSender {
+	return &HTTPSMSSender{
+		config: config,
+		client: &http.Client{},
+	}
+}
+
+// Channel implementa ports.NotificationSender
+func (s *HTTPSMSSender) Channel() model.NotificationChannel {
+	return model.NotificationChannelSMS
+}
+
+// Send implementa ports.NotificationSender. Cualquier respuesta distinta de 2xx es un error reintentable.
+func (s *HTTPSMSSender) Send(ctx context.Context, notification model.Notification) error {
+	body, err := json.Marshal(smsRequest{
+		From:    s.config.From,
+		To:      notification.Recipient,
+		Message: notification.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode SMS request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create SMS request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if s.config.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.config.Token)
+	}
+	if notification.TraceID != "" {
+		request.Header.Set("X-Trace-Id", notification.TraceID)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send SMS: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("failed to send SMS: provider responded %d: %s", response.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
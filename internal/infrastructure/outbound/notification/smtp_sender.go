@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTPConfig contiene los datos de conexión al servidor SMTP
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	// From es la dirección del remitente, por ejemplo "Lockers <no-reply@odihnx.com>"
+	From string
+}
+
+// SMTPSender envía notificaciones por correo electrónico usando SMTP (STARTTLS cuando el servidor lo ofrece)
+type SMTPSender struct {
+	config SMTPConfig
+	auth   smtp.Auth
+}
+
+// NewSMTPSender crea un sender de correo; sin usuario se envía sin autenticación
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	sender := &SMTPSender{config: config}
+	if config.Username != "" {
+		sender.auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+	return sender
+}
+
+// Channel implementa ports.NotificationSender
+func (s *SMTPSender) Channel() model.NotificationChannel {
+	return model.NotificationChannelEmail
+}
+
+// Send implementa ports.NotificationSender. smtp.SendMail no admite contexto, por lo que el
+// límite de tiempo del contexto solo se respeta al esperar el resultado.
+func (s *SMTPSender) Send(ctx context.Context, notification model.Notification) error {
+	address := net.JoinHostPort(s.config.Host, s.config.Port)
+	message := s.message(notification)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(address, s.auth, envelopeAddress(s.config.From), []string{notification.Recipient}, message)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send email: %w", ctx.Err())
+	}
+}
+
+// message arma el mensaje MIME en UTF-8 con el cuerpo en base64
+func (s *SMTPSender) message(notification model.Notification) []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(&out, "To: %s\r\n", notification.Recipient)
+	fmt.Fprintf(&out, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	fmt.Fprintf(&out, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if notification.TraceID != "" {
+		fmt.Fprintf(&out, "X-Trace-Id: %s\r\n", notification.TraceID)
+	}
+	out.WriteString("MIME-Version: 1.0\r\n")
+	out.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	out.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(notification.Body))
+	for len(encoded) > 76 {
+		out.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	out.WriteString(encoded + "\r\n")
+
+	return out.Bytes()
+}
+
+// envelopeAddress extrae la dirección de un remitente con nombre ("Nombre <correo>")
+func envelopeAddress(from string) string {
+	if address, err := mail.ParseAddress(from); err == nil {
+		return address.Address
+	}
+	return from
+}
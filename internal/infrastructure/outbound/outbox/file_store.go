@@ -0,0 +1,300 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// compactThreshold es la cantidad de operaciones obsoletas tras la cual se reescribe el archivo
+const compactThreshold = 1000
+
+// Operaciones registradas en el archivo del outbox
+const (
+	opAppend     = "append"
+	opReschedule = "reschedule"
+	opDelivered  = "delivered"
+)
+
+// fileOperation es una línea del archivo del outbox
+type fileOperation struct {
+	Op             string       `json:"op"`
+	Event          *EventRecord `json:"event,omitempty"`
+	ID             string       `json:"id,omitempty"`
+	Attempts       int          `json:"attempts,omitempty"`
+	NextAttemptAt  *time.Time   `json:"nextAttemptAt,omitempty"`
+	LastError      string       `json:"lastError,omitempty"`
+	DeliveredSinks []string     `json:"deliveredSinks,omitempty"`
+}
+
+// FileStore guarda el outbox como un log JSONL de solo agregado. Cada operación se sincroniza a
+// disco antes de confirmarse, por lo que los eventos pendientes sobreviven a un reinicio. El
+// archivo se compacta al abrirlo y cada vez que acumula suficientes operaciones obsoletas.
+type FileStore struct {
+	path string
+	file *os.File
+
+	// El estado vigente se mantiene en memoria y se reconstruye desde el archivo al abrirlo
+	state    *MemoryStore
+	obsolete int
+}
+
+// OpenFileStore abre (o crea) el archivo del outbox y recupera los eventos pendientes
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{
+		path:  path,
+		state: NewMemoryStore(),
+	}
+
+	if err := store.replay(); err != nil {
+		return nil, err
+	}
+
+	if err := store.compact(); err != nil {
+		return nil, err
+	}
+
+	slog.Info("📤 Outbox opened", "file", path, "pending", store.state.Len())
+	return store, nil
+}
+
+// Append implementa ports.OutboxStore
+func (s *FileStore) Append(ctx context.Context, events ...model.DomainEvent) error {
+	operations := make([]fileOperation, 0, len(events))
+	for _, event := range events {
+		record := toEventRecord(event)
+		operations = append(operations, fileOperation{Op: opAppend, Event: &record})
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	if err := s.write(operations...); err != nil {
+		return err
+	}
+	for _, event := range events {
+		s.state.add(event)
+	}
+	return nil
+}
+
+// Pending implementa ports.OutboxStore
+func (s *FileStore) Pending(ctx context.Context, now time.Time, limit int) ([]model.OutboxEntry, error) {
+	return s.state.Pending(ctx, now, limit)
+}
+
+// MarkDelivered implementa ports.OutboxStore
+func (s *FileStore) MarkDelivered(ctx context.Context, eventID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	if err := s.write(fileOperation{Op: opDelivered, ID: eventID}); err != nil {
+		return err
+	}
+	s.state.remove(eventID)
+
+	// La línea append del evento y sus reprogramaciones quedan obsoletas
+	s.obsolete += 2
+	return s.compactIfNeeded()
+}
+
+// Reschedule implementa ports.OutboxStore
+func (s *FileStore) Reschedule(ctx context.Context, entry model.OutboxEntry) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	nextAttemptAt := entry.NextAttemptAt
+	if err := s.write(fileOperation{
+		Op:             opReschedule,
+		ID:             entry.Event.ID,
+		Attempts:       entry.Attempts,
+		NextAttemptAt:  &nextAttemptAt,
+		LastError:      entry.LastError,
+		DeliveredSinks: entry.DeliveredSinks,
+	}); err != nil {
+		return err
+	}
+	s.state.update(entry)
+
+	s.obsolete++
+	return s.compactIfNeeded()
+}
+
+// Close cierra el archivo del outbox
+func (s *FileStore) Close() error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// replay reconstruye el estado desde el archivo. Una última línea incompleta (escritura
+// interrumpida) se descarta.
+func (s *FileStore) replay() error {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open outbox file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		var operation fileOperation
+		if err := json.Unmarshal(scanner.Bytes(), &operation); err != nil {
+			slog.Warn("⚠️ Skipping invalid outbox line", "line", line, "error", err)
+			continue
+		}
+		s.apply(operation)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read outbox file: %w", err)
+	}
+	return nil
+}
+
+// apply aplica una operación del archivo al estado en memoria
+func (s *FileStore) apply(operation fileOperation) {
+	switch operation.Op {
+	case opAppend:
+		if operation.Event != nil {
+			s.state.add(toDomainEvent(*operation.Event))
+		}
+	case opReschedule:
+		entry, ok := s.state.entries[operation.ID]
+		if !ok {
+			return
+		}
+		entry.Attempts = operation.Attempts
+		entry.LastError = operation.LastError
+		entry.DeliveredSinks = operation.DeliveredSinks
+		if operation.NextAttemptAt != nil {
+			entry.NextAttemptAt = *operation.NextAttemptAt
+		}
+		s.state.update(entry)
+	case opDelivered:
+		s.state.remove(operation.ID)
+	}
+}
+
+// compactIfNeeded reescribe el archivo cuando acumula suficientes operaciones obsoletas. La
+// operación que la origina ya quedó en disco, así que un error al compactar solo se registra y
+// se reintenta con la siguiente operación.
+func (s *FileStore) compactIfNeeded() error {
+	if s.obsolete < compactThreshold {
+		return nil
+	}
+
+	if err := s.compact(); err != nil {
+		slog.Warn("⚠️ Outbox compaction failed, keeping current file", "file", s.path, "error", err)
+	}
+	return nil
+}
+
+// compact reescribe el pendiente en un archivo temporal y lo reemplaza de forma atómica. El
+// archivo temporal queda abierto como el nuevo archivo del outbox, y el anterior se cierra solo
+// tras el reemplazo: ante cualquier error el outbox sigue escribiendo en el archivo vigente.
+func (s *FileStore) compact() error {
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create outbox file: %w", err)
+	}
+
+	replaced := false
+	defer func() {
+		if !replaced {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, id := range s.state.order {
+		entry := s.state.entries[id]
+		record := toEventRecord(entry.Event)
+		operations := []fileOperation{{Op: opAppend, Event: &record}}
+		if entry.Attempts > 0 {
+			nextAttemptAt := entry.NextAttemptAt
+			operations = append(operations, fileOperation{
+				Op:             opReschedule,
+				ID:             id,
+				Attempts:       entry.Attempts,
+				NextAttemptAt:  &nextAttemptAt,
+				LastError:      entry.LastError,
+				DeliveredSinks: entry.DeliveredSinks,
+			})
+		}
+		for _, operation := range operations {
+			if err := encoder.Encode(operation); err != nil {
+				return fmt.Errorf("failed to write outbox file: %w", err)
+			}
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write outbox file: %w", err)
+	}
+	if err := file.Chmod(0o644); err != nil {
+		return fmt.Errorf("failed to write outbox file: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync outbox file: %w", err)
+	}
+	if err := os.Rename(file.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace outbox file: %w", err)
+	}
+	replaced = true
+
+	// El descriptor sigue apuntando al archivo renombrado, así que las operaciones siguientes se
+	// agregan tras el pendiente recién escrito
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file = file
+	s.obsolete = 0
+	return nil
+}
+
+// write agrega operaciones al archivo y lo sincroniza a disco
+func (s *FileStore) write(operations ...fileOperation) error {
+	if s.file == nil {
+		return errors.New("outbox file is closed")
+	}
+
+	var data []byte
+	for _, operation := range operations {
+		line, err := json.Marshal(operation)
+		if err != nil {
+			return fmt.Errorf("failed to encode outbox operation: %w", err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	if _, err := s.file.Write(data); err != nil {
+		return fmt.Errorf("failed to write outbox file: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync outbox file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// LogSink escribe los eventos de dominio en el log estructurado
+type LogSink struct{}
+
+// NewLogSink crea un sink que registra los eventos en el log
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+// Name implementa ports.EventSink
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+// Deliver implementa ports.EventSink
+func (s *LogSink) Deliver(ctx context.Context, event model.DomainEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event data: %w", err)
+	}
+
+	slog.InfoContext(ctx, "📤 Domain event", "type", event.Type, "id", event.ID, "data", data)
+	return nil
+}
@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"sync"
+)
+
+// MemorySink guarda en memoria los eventos recibidos. Pensado para pruebas.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []model.DomainEvent
+}
+
+// NewMemorySink crea un sink en memoria
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// Name implementa ports.EventSink
+func (s *MemorySink) Name() string {
+	return "memory"
+}
+
+// Deliver implementa ports.EventSink
+func (s *MemorySink) Deliver(ctx context.Context, event model.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Events devuelve una copia de los eventos recibidos
+func (s *MemorySink) Events() []model.DomainEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]model.DomainEvent(nil), s.events...)
+}
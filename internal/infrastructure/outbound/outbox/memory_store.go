@@ -0,0 +1,110 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore guarda el outbox en memoria; los eventos pendientes se pierden al reiniciar.
+// Pensado para pruebas y desarrollo local.
+type MemoryStore struct {
+	mu      sync.Mutex
+	order   []string
+	entries map[string]model.OutboxEntry
+}
+
+// NewMemoryStore crea un outbox en memoria
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]model.OutboxEntry)}
+}
+
+// Append implementa ports.OutboxStore
+func (s *MemoryStore) Append(ctx context.Context, events ...model.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range events {
+		s.add(event)
+	}
+	return nil
+}
+
+// Pending implementa ports.OutboxStore
+func (s *MemoryStore) Pending(ctx context.Context, now time.Time, limit int) ([]model.OutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pending(now, limit), nil
+}
+
+// MarkDelivered implementa ports.OutboxStore
+func (s *MemoryStore) MarkDelivered(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(eventID)
+	return nil
+}
+
+// Reschedule implementa ports.OutboxStore
+func (s *MemoryStore) Reschedule(ctx context.Context, entry model.OutboxEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.update(entry)
+	return nil
+}
+
+// Len devuelve la cantidad de eventos pendientes
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.order)
+}
+
+// add agrega un evento al final de la cola; los IDs repetidos se ignoran
+func (s *MemoryStore) add(event model.DomainEvent) {
+	if _, ok := s.entries[event.ID]; ok {
+		return
+	}
+	s.order = append(s.order, event.ID)
+	s.entries[event.ID] = model.OutboxEntry{Event: event}
+}
+
+// update reemplaza el estado de entrega de un evento pendiente
+func (s *MemoryStore) update(entry model.OutboxEntry) {
+	if _, ok := s.entries[entry.Event.ID]; ok {
+		entry.DeliveredSinks = append([]string(nil), entry.DeliveredSinks...)
+		s.entries[entry.Event.ID] = entry
+	}
+}
+
+// remove quita un evento entregado
+func (s *MemoryStore) remove(eventID string) {
+	if _, ok := s.entries[eventID]; !ok {
+		return
+	}
+	delete(s.entries, eventID)
+	for i, id := range s.order {
+		if id == eventID {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// pending devuelve los eventos cuyo próximo intento ya venció, en orden de llegada
+func (s *MemoryStore) pending(now time.Time, limit int) []model.OutboxEntry {
+	entries := make([]model.OutboxEntry, 0, min(limit, len(s.order)))
+	for _, id := range s.order {
+		if len(entries) == limit {
+			break
+		}
+		entry := s.entries[id]
+		if entry.NextAttemptAt.After(now) {
+			continue
+		}
+		entry.DeliveredSinks = append([]string(nil), entry.DeliveredSinks...)
+		entries = append(entries, entry)
+	}
+	return entries
+}
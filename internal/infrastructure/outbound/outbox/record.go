@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"time"
+)
+
+// EventRecord es la representación JSON de un evento de dominio, usada en el archivo del outbox
+// y en el cuerpo enviado por el sink webhook
+type EventRecord struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	TraceID    string         `json:"traceId,omitempty"`
+	Data       map[string]any `json:"data"`
+}
+
+// toEventRecord mapea el evento de dominio a su representación JSON
+func toEventRecord(event model.DomainEvent) EventRecord {
+	return EventRecord{
+		ID:         event.ID,
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt,
+		TraceID:    event.TraceID,
+		Data:       event.Data,
+	}
+}
+
+// toDomainEvent mapea la representación JSON al evento de dominio
+func toDomainEvent(record EventRecord) model.DomainEvent {
+	return model.DomainEvent{
+		ID:         record.ID,
+		Type:       model.DomainEventType(record.Type),
+		OccurredAt: record.OccurredAt,
+		TraceID:    record.TraceID,
+		Data:       record.Data,
+	}
+}
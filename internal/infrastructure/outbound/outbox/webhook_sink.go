@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WebhookSink envía cada evento de dominio como POST JSON a una URL. Con secreto, el cuerpo se
+// firma con HMAC-SHA256 (hex) en el header X-Outbox-Signature.
+type WebhookSink struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhookSink crea un sink webhook; el límite de tiempo lo define el contexto de cada entrega
+func NewWebhookSink(url string, secret string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{},
+	}
+}
+
+// Name implementa ports.EventSink
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Deliver implementa ports.EventSink. Cualquier respuesta distinta de 2xx se reintenta.
+func (s *WebhookSink) Deliver(ctx context.Context, event model.DomainEvent) error {
+	body, err := json.Marshal(toEventRecord(event))
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Event-Id", event.ID)
+	request.Header.Set("X-Event-Type", string(event.Type))
+	if event.TraceID != "" {
+		request.Header.Set("X-Trace-Id", event.TraceID)
+	}
+	if len(s.secret) > 0 {
+		mac := hmac.New(sha256.New, s.secret)
+		mac.Write(body)
+		request.Header.Set("X-Outbox-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to deliver event: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("webhook responded %d: %s", response.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package qrcode
+
+import (
+	"bff-graphql-payment/internal/domain/model"
+	"bytes"
+	"fmt"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// Renderer dibuja códigos QR con un codificador en Go puro, sin servicios externos
+type Renderer struct {
+	level goqrcode.RecoveryLevel
+}
+
+// NewRenderer crea un renderer con corrección de errores media (~15%), suficiente para pantallas
+func NewRenderer() *Renderer {
+	return &Renderer{level: goqrcode.Medium}
+}
+
+// Render dibuja el contenido como PNG o SVG de size x size píxeles
+func (r *Renderer) Render(content string, format model.QRImageFormat, size int) ([]byte, error) {
+	qr, err := goqrcode.New(content, r.level)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case model.QRImageFormatPNG:
+		return qr.PNG(size)
+	case model.QRImageFormatSVG:
+		return svg(qr.Bitmap(), size), nil
+	default:
+		return nil, fmt.Errorf("unsupported QR image format %q", format)
+	}
+}
+
+// svg dibuja la matriz del QR (incluida su zona de silencio) como un único path escalable
+func svg(bitmap [][]bool, size int) []byte {
+	modules := len(bitmap)
+
+	var path bytes.Buffer
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&path, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, modules, modules)
+	fmt.Fprintf(&out, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="%s"/></svg>`, modules, modules, path.String())
+	return out.Bytes()
+}
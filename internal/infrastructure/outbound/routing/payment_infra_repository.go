@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"bff-graphql-payment/internal/application/ports"
+	"bff-graphql-payment/internal/application/tenancy"
+	"bff-graphql-payment/internal/domain/model"
+	domainService "bff-graphql-payment/internal/domain/service"
+	"context"
+	"time"
+)
+
+// PaymentInfraRepository envía cada llamada al backend del tenant de la solicitud. El tenant se
+// toma del contexto; las llamadas sin tenant (como las de los procesos en segundo plano) usan el
+// dueño del serviceName o el tenant por defecto. Los tenants sin backend propio usan fallback.
+type PaymentInfraRepository struct {
+	fallback ports.PaymentInfraRepository
+	byTenant map[string]ports.PaymentInfraRepository
+	tenants  *domainService.TenantRegistry
+}
+
+// NewPaymentInfraRepository crea el repositorio con los backends propios de cada tenant, por ID
+func NewPaymentInfraRepository(fallback ports.PaymentInfraRepository, byTenant map[string]ports.PaymentInfraRepository, tenants *domainService.TenantRegistry) *PaymentInfraRepository {
+	return &PaymentInfraRepository{
+		fallback: fallback,
+		byTenant: byTenant,
+		tenants:  tenants,
+	}
+}
+
+// route elige el repositorio del tenant de la llamada
+func (r *PaymentInfraRepository) route(ctx context.Context, serviceName string) ports.PaymentInfraRepository {
+	tenant := tenancy.FromContext(ctx)
+	if tenant == nil {
+		tenant, _ = r.tenants.Scope(nil, serviceName)
+	}
+
+	if tenant != nil {
+		if repo, ok := r.byTenant[tenant.ID]; ok {
+			return repo
+		}
+	}
+	return r.fallback
+}
+
+// GetPaymentInfraByQrValue implementa PaymentInfraRepository.GetPaymentInfraByQrValue
+func (r *PaymentInfraRepository) GetPaymentInfraByQrValue(ctx context.Context, qrValue string) (*model.PaymentInfra, error) {
+	return r.route(ctx, "").GetPaymentInfraByQrValue(ctx, qrValue)
+}
+
+// GetAvailableLockers implementa PaymentInfraRepository.GetAvailableLockers
+func (r *PaymentInfraRepository) GetAvailableLockers(ctx context.Context, paymentRackID int, bookingTimeID int, traceID string) (*model.AvailableLockers, error) {
+	return r.route(ctx, "").GetAvailableLockers(ctx, paymentRackID, bookingTimeID, traceID)
+}
+
+// ValidateDiscountCoupon implementa PaymentInfraRepository.ValidateDiscountCoupon
+func (r *PaymentInfraRepository) ValidateDiscountCoupon(ctx context.Context, couponCode string, rackID int, traceID string) (*model.DiscountCouponValidation, error) {
+	return r.route(ctx, "").ValidateDiscountCoupon(ctx, couponCode, rackID, traceID)
+}
+
+// GeneratePurchaseOrder implementa PaymentInfraRepository.GeneratePurchaseOrder
+func (r *PaymentInfraRepository) GeneratePurchaseOrder(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string, gatewayName string) (*model.PurchaseOrder, error) {
+	return r.route(ctx, "").GeneratePurchaseOrder(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID, gatewayName)
+}
+
+// GenerateBooking implementa PaymentInfraRepository.GenerateBooking
+func (r *PaymentInfraRepository) GenerateBooking(ctx context.Context, rackIdReference int, groupID int, couponCode *string, userEmail string, userPhone string, traceID string) (*model.Booking, error) {
+	return r.route(ctx, "").GenerateBooking(ctx, rackIdReference, groupID, couponCode, userEmail, userPhone, traceID)
+}
+
+// GetPurchaseOrderByPo implementa PaymentInfraRepository.GetPurchaseOrderByPo
+func (r *PaymentInfraRepository) GetPurchaseOrderByPo(ctx context.Context, purchaseOrder string, traceID string) (*model.PurchaseOrderData, error) {
+	return r.route(ctx, "").GetPurchaseOrderByPo(ctx, purchaseOrder, traceID)
+}
+
+// UpdatePurchaseOrderStatus implementa PaymentInfraRepository.UpdatePurchaseOrderStatus
+func (r *PaymentInfraRepository) UpdatePurchaseOrderStatus(ctx context.Context, purchaseOrder string, status model.PurchaseOrderStatus, traceID string) (*model.PurchaseOrderData, error) {
+	return r.route(ctx, "").UpdatePurchaseOrderStatus(ctx, purchaseOrder, status, traceID)
+}
+
+// CheckBookingStatus implementa PaymentInfraRepository.CheckBookingStatus
+func (r *PaymentInfraRepository) CheckBookingStatus(ctx context.Context, serviceName string, currentCode string) (*model.BookingStatusCheck, error) {
+	return r.route(ctx, serviceName).CheckBookingStatus(ctx, serviceName, currentCode)
+}
+
+// ExecuteOpen implementa PaymentInfraRepository.ExecuteOpen
+func (r *PaymentInfraRepository) ExecuteOpen(ctx context.Context, serviceName string, currentCode string) (*model.ExecuteOpenResult, error) {
+	return r.route(ctx, serviceName).ExecuteOpen(ctx, serviceName, currentCode)
+}
+
+// CancelBooking implementa PaymentInfraRepository.CancelBooking
+func (r *PaymentInfraRepository) CancelBooking(ctx context.Context, request model.BookingCancellationRequest) (*model.BookingCancellation, error) {
+	return r.route(ctx, request.ServiceName).CancelBooking(ctx, request)
+}
+
+// RequestRefund implementa PaymentInfraRepository.RequestRefund
+func (r *PaymentInfraRepository) RequestRefund(ctx context.Context, request model.RefundRequest) (*model.Refund, error) {
+	return r.route(ctx, "").RequestRefund(ctx, request)
+}
+
+// GetBookingExtensionOptions implementa PaymentInfraRepository.GetBookingExtensionOptions
+func (r *PaymentInfraRepository) GetBookingExtensionOptions(ctx context.Context, serviceName string, currentCode string, traceID string) (*model.BookingExtensionOptions, error) {
+	return r.route(ctx, serviceName).GetBookingExtensionOptions(ctx, serviceName, currentCode, traceID)
+}
+
+// GenerateBookingExtensionOrder implementa PaymentInfraRepository.GenerateBookingExtensionOrder
+func (r *PaymentInfraRepository) GenerateBookingExtensionOrder(ctx context.Context, request model.BookingExtensionOrderRequest) (*model.BookingExtensionOrder, error) {
+	return r.route(ctx, request.ServiceName).GenerateBookingExtensionOrder(ctx, request)
+}
+
+// GetBooking implementa PaymentInfraRepository.GetBooking
+func (r *PaymentInfraRepository) GetBooking(ctx context.Context, currentCode string, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error) {
+	return r.route(ctx, "").GetBooking(ctx, currentCode, purchaseOrder, traceID)
+}
+
+// UpdateBookingFinish implementa PaymentInfraRepository.UpdateBookingFinish
+func (r *PaymentInfraRepository) UpdateBookingFinish(ctx context.Context, serviceName string, currentCode string, finishBooking time.Time, purchaseOrder string, traceID string) (*model.BookingStatusCheck, error) {
+	return r.route(ctx, serviceName).UpdateBookingFinish(ctx, serviceName, currentCode, finishBooking, purchaseOrder, traceID)
+}
@@ -30,6 +30,15 @@ IF ERRORLEVEL 1 (
   exit /b 1
 )
 
+REM Ejecuta buf generate para el servicio gRPC propio del BFF (proto/bff/v1)
+echo.
+echo 📦 Generando archivos protobuf para BFF Service...
+buf generate --template buf.gen.bff.yaml
+IF ERRORLEVEL 1 (
+  echo Error al generar protos para BFF Service
+  exit /b 1
+)
+
 echo.
 echo === Protos generados exitosamente ===
 
@@ -43,6 +52,11 @@ IF EXIST gen\go\proto\booking\v1 (
   echo ✅ gen\go\proto\booking\v1
   dir gen\go\proto\booking\v1 /b
 )
+echo.
+IF EXIST gen\go\proto\bff\v1 (
+  echo ✅ gen\go\proto\bff\v1
+  dir gen\go\proto\bff\v1 /b
+)
 
 ENDLOCAL
 exit /b 0